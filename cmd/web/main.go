@@ -5,13 +5,21 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"time"
 	"travel-platform/internal/chat"
+	"travel-platform/internal/currency"
 	"travel-platform/internal/database"
+	"travel-platform/internal/geo"
 	grpcserver "travel-platform/internal/grpc"
 	"travel-platform/internal/handlers"
+	"travel-platform/internal/mailer"
 	"travel-platform/internal/middleware"
 	"travel-platform/internal/repository"
+	"travel-platform/internal/scheduler"
 	"travel-platform/internal/services"
+	"travel-platform/internal/storage"
+	"travel-platform/internal/vault"
 	pb "travel-platform/proto"
 
 	"github.com/gorilla/mux"
@@ -35,17 +43,112 @@ func main() {
 	// Repository layer
 	userRepo := repository.NewUserRepository(db)
 	tripRepo := repository.NewTripRepository(db)
+	chatRepo := repository.NewChatRepository(db)
+	auditRepo := repository.NewAuditRepository(db)
+	tripTemplateRepo := repository.NewTripTemplateRepository(db)
+	memberRepo := repository.NewMemberRepository(db)
+	checklistRepo := repository.NewChecklistRepository(db)
+	reservationRepo := repository.NewReservationRepository(db)
+	documentRepo := repository.NewDocumentRepository(db)
+	exchangeRateRepo := repository.NewExchangeRateRepository(db)
+	settlementRepo := repository.NewSettlementRepository(db)
+	receiptRepo := repository.NewReceiptRepository(db)
+	categoryBudgetRepo := repository.NewCategoryBudgetRepository(db)
+	expenseCategoryRepo := repository.NewExpenseCategoryRepository(db)
+	destinationRepo := repository.NewDestinationRepository(db)
+	notificationRepo := repository.NewNotificationRepository(db)
+
+	// Belge kasası anahtarı (VAULT_KEY veya vault.key dosyası)
+	vaultKey, err := vault.LoadKey("vault.key")
+	if err != nil {
+		log.Fatal("Vault key could not be loaded:", err)
+	}
+	documentCipher, err := vault.NewCipher(vaultKey)
+	if err != nil {
+		log.Fatal("Vault key is invalid:", err)
+	}
+
+	// Fiş dosyaları RECEIPTS_DIR (varsayılan uploads/) altında saklanır
+	receiptsDir := os.Getenv("RECEIPTS_DIR")
+	if receiptsDir == "" {
+		receiptsDir = "uploads"
+	}
+	receiptStore, err := storage.NewLocalStore(receiptsDir)
+	if err != nil {
+		log.Fatal("Receipt storage could not be opened:", err)
+	}
+
+	// Kur sağlayıcıları: EXCHANGE_RATES_URL verilmişse önce HTTP servisi, sonra offline tablo
+	// EXCHANGE_RATES_FILE ile gömülü tablo yerine başka bir CSV kullanılabilir
+	rateTable := currency.DefaultTable()
+	if path := os.Getenv("EXCHANGE_RATES_FILE"); path != "" {
+		rateTable, err = currency.LoadTableFile(path)
+		if err != nil {
+			log.Fatal("Exchange rate table could not be loaded:", err)
+		}
+	}
+	rateProviders := currency.Chain{}
+	if url := os.Getenv("EXCHANGE_RATES_URL"); url != "" {
+		rateProviders = append(rateProviders, currency.NewHTTPProvider(url))
+	}
+	rateProviders = append(rateProviders, rateTable)
 
 	// Service layer
 	userService := services.NewUserService(userRepo)
-	tripService := services.NewTripService(tripRepo)
+	currencyService := services.NewCurrencyService(exchangeRateRepo, rateProviders)
+	tripService := services.NewTripService(tripRepo, auditRepo, currencyService)
+	// Destinasyon kataloğu gazetteer'dan oluşturulur; eski geziler bulanık eşleştirme ile bağlanır
+	destinationService := services.NewDestinationService(destinationRepo)
+	if added, err := destinationService.Seed(geo.DefaultGazetteer().Locations()); err != nil {
+		log.Fatal("Destination catalog could not be seeded:", err)
+	} else if added > 0 {
+		log.Printf("🗺️ Added %d destinations to the catalog", added)
+	}
+	if linked, err := destinationService.LinkTrips(); err != nil {
+		log.Println("Trips could not be linked to the destination catalog:", err)
+	} else if linked > 0 {
+		log.Printf("🗺️ Linked %d trips to the destination catalog", linked)
+	}
+	geoService := services.NewGeoService(geo.DefaultGazetteer(), destinationService)
+	importService := services.NewImportService(tripService, geoService)
+	receiptService := services.NewReceiptService(receiptRepo, receiptStore)
+	backupService := services.NewBackupService(tripService, receiptService, chatRepo, userRepo)
+	tripTemplateService := services.NewTripTemplateService(tripTemplateRepo, tripService)
+	memberService := services.NewMemberService(memberRepo, userRepo)
+	checklistService := services.NewChecklistService(checklistRepo, memberService)
+	reservationService := services.NewReservationService(reservationRepo, tripService)
+	documentService := services.NewDocumentService(documentRepo, tripService, memberService, documentCipher)
+	splitService := services.NewSplitService(settlementRepo, tripService, memberService, currencyService)
+	reportService := services.NewReportService(tripService, currencyService)
+	budgetService := services.NewBudgetService(categoryBudgetRepo)
+	categoryService := services.NewCategoryService(expenseCategoryRepo)
+	// Bütçe uyarıları: uygulama içi, e-posta (SMTP_HOST yoksa loglanır) ve gezi sohbet odası
+	notificationService := services.NewNotificationService(notificationRepo, tripService, budgetService, memberService,
+		userRepo, chatRepo, chat.GetHub(), mailer.FromEnv())
 
 	// Handler layer
 	userHandler := handlers.NewUserHandler(userService)
-	tripHandler := handlers.NewTripHandler(tripService)
-	templateHandler := handlers.NewTemplateHandler(userService, tripService)
+	// Gezi yazma akışı HTTP ve gRPC'de ortak
+	tripWriteService := services.NewTripWriteService(tripService, geoService, categoryService, notificationService)
+	tripHandler := handlers.NewTripHandler(tripService, tripWriteService)
+	geoHandler := handlers.NewGeoHandler(geoService, tripService)
+	importHandler := handlers.NewImportHandler(importService, tripService, categoryService, notificationService)
+	backupHandler := handlers.NewBackupHandler(backupService, tripService)
+	tripTemplateHandler := handlers.NewTripTemplateHandler(tripTemplateService, tripService)
+	checklistHandler := handlers.NewChecklistHandler(checklistService, memberService, tripService)
+	reservationHandler := handlers.NewReservationHandler(reservationService, tripService, memberService)
+	documentHandler := handlers.NewDocumentHandler(documentService, tripService, memberService)
+	currencyHandler := handlers.NewCurrencyHandler(currencyService)
+	splitHandler := handlers.NewSplitHandler(splitService, tripService, memberService)
+	receiptHandler := handlers.NewReceiptHandler(receiptService, tripService, memberService)
+	reportHandler := handlers.NewReportHandler(reportService, tripService, memberService)
+	budgetHandler := handlers.NewBudgetHandler(budgetService, tripService, memberService)
+	categoryHandler := handlers.NewCategoryHandler(categoryService)
+	destinationHandler := handlers.NewDestinationHandler(destinationService)
+	notificationHandler := handlers.NewNotificationHandler(notificationService)
+	templateHandler := handlers.NewTemplateHandler(userService, tripService, checklistService, memberService, reservationService, documentService, currencyService, splitService, budgetService, categoryService, notificationService)
 	wsHandler := handlers.NewWebSocketHandler("localhost:9090", userService)
-	recHandler := handlers.NewRecommendationHandler(tripService)
+	recHandler := handlers.NewRecommendationHandler(tripService, budgetService)
 	// Router
 	r := mux.NewRouter()
 
@@ -74,6 +177,8 @@ func main() {
 		middleware.AuthMiddleware(templateHandler.Dashboard)).Methods("GET")
 	r.HandleFunc("/trips/new",
 		middleware.AuthMiddleware(templateHandler.CreateTripPage)).Methods("GET")
+	r.HandleFunc("/trips/trash",
+		middleware.AuthMiddleware(templateHandler.TrashPage)).Methods("GET")
 	r.HandleFunc("/trips/{id}",
 		middleware.OptionalAuthMiddleware(templateHandler.TripDetailPage)).Methods("GET")
 	r.HandleFunc("/trips/{id}/edit",
@@ -82,6 +187,12 @@ func main() {
 		middleware.AuthMiddleware(templateHandler.ProfilePage)).Methods("GET")
 	r.HandleFunc("/recommendations",
 		middleware.AuthMiddleware(templateHandler.RecommendationsPage)).Methods("GET")
+	r.HandleFunc("/documents",
+		middleware.AuthMiddleware(templateHandler.DocumentsPage)).Methods("GET")
+	r.HandleFunc("/categories",
+		middleware.AuthMiddleware(templateHandler.CategoriesPage)).Methods("GET")
+	r.HandleFunc("/notifications",
+		middleware.AuthMiddleware(templateHandler.NotificationsPage)).Methods("GET")
 	// ========== API ROUTES (JSON) ==========
 	api := r.PathPrefix("/api").Subrouter()
 
@@ -95,23 +206,216 @@ func main() {
 	api.HandleFunc("/users/profile",
 		middleware.AuthMiddleware(userHandler.UpdateProfile)).Methods("PUT")
 
+	// Report routes
+	api.HandleFunc("/reports/expenses",
+		middleware.AuthMiddleware(reportHandler.UserReport)).Methods("GET")
+
 	// Trip routes
-	api.HandleFunc("/trips",
-		middleware.AuthMiddleware(tripHandler.CreateTrip)).Methods("POST")
-	api.HandleFunc("/trips/{id}", tripHandler.GetTripByID).Methods("GET")
-	api.HandleFunc("/trips/my",
-		middleware.AuthMiddleware(tripHandler.GetMyTrips)).Methods("GET")
-	api.HandleFunc("/trips/public", tripHandler.GetPublicTrips).Methods("GET")
-	api.HandleFunc("/trips/search", tripHandler.SearchTrips).Methods("GET")
-	api.HandleFunc("/trips/{id}",
-		middleware.AuthMiddleware(tripHandler.UpdateTrip)).Methods("PUT")
-	api.HandleFunc("/trips/{id}",
-		middleware.AuthMiddleware(tripHandler.DeleteTrip)).Methods("DELETE")
+	api.HandleFunc("/trips/import",
+		middleware.AuthMiddleware(importHandler.ImportTrips)).Methods("POST")
+	api.HandleFunc("/trips/restore",
+		middleware.AuthMiddleware(backupHandler.RestoreTrips)).Methods("POST")
+	api.HandleFunc("/trips/trash",
+		middleware.AuthMiddleware(tripHandler.GetTrash)).Methods("GET")
+	api.HandleFunc("/trips/trash/{id}/restore",
+		middleware.AuthMiddleware(tripHandler.RestoreTrip)).Methods("POST")
+	api.HandleFunc("/trips/trash/{id}",
+		middleware.AuthMiddleware(tripHandler.PurgeTrip)).Methods("DELETE")
+	api.HandleFunc("/trips/export",
+		middleware.AuthMiddleware(backupHandler.ExportAllTrips)).Methods("GET")
+	api.HandleFunc("/trips/{id}/export",
+		middleware.AuthMiddleware(backupHandler.ExportTrip)).Methods("GET")
+	api.HandleFunc("/trips/{id}/map",
+		middleware.OptionalAuthMiddleware(geoHandler.ExportTripMap)).Methods("GET")
+	api.HandleFunc("/trips/{id}/geocode",
+		middleware.AuthMiddleware(geoHandler.GeocodeTrip)).Methods("POST")
+	api.HandleFunc("/trips/{id}/status",
+		middleware.AuthMiddleware(tripHandler.UpdateTripStatus)).Methods("PUT")
+	api.HandleFunc("/trips/{id}/duplicate",
+		middleware.AuthMiddleware(tripTemplateHandler.DuplicateTrip)).Methods("POST")
+	api.HandleFunc("/trips/{id}/clone",
+		middleware.AuthMiddleware(tripTemplateHandler.CloneTrip)).Methods("POST")
+	api.HandleFunc("/trips/{id}/template",
+		middleware.AuthMiddleware(tripTemplateHandler.PublishTemplate)).Methods("POST")
+	api.HandleFunc("/trips/{id}/history",
+		middleware.AuthMiddleware(tripHandler.GetTripHistory)).Methods("GET")
+	api.HandleFunc("/trips/{id}/history/{entryID}/revert",
+		middleware.AuthMiddleware(tripHandler.RevertTrip)).Methods("POST")
+	api.HandleFunc("/trips/{id}/activities",
+		middleware.AuthMiddleware(tripHandler.AddActivity)).Methods("POST")
+	api.HandleFunc("/trips/{id}/activities/{activityID}",
+		middleware.AuthMiddleware(tripHandler.UpdateActivity)).Methods("PUT")
+	api.HandleFunc("/trips/{id}/activities/{activityID}",
+		middleware.AuthMiddleware(tripHandler.DeleteActivity)).Methods("DELETE")
+	api.HandleFunc("/trips/{id}/expenses",
+		middleware.AuthMiddleware(tripHandler.AddExpense)).Methods("POST")
+	api.HandleFunc("/trips/{id}/expenses/import",
+		middleware.AuthMiddleware(importHandler.PreviewStatement)).Methods("POST")
+	api.HandleFunc("/trips/{id}/expenses/import/confirm",
+		middleware.AuthMiddleware(importHandler.ConfirmStatement)).Methods("POST")
+	api.HandleFunc("/trips/{id}/expenses/{expenseID}",
+		middleware.AuthMiddleware(tripHandler.UpdateExpense)).Methods("PUT")
+	api.HandleFunc("/trips/{id}/expenses/{expenseID}",
+		middleware.AuthMiddleware(tripHandler.DeleteExpense)).Methods("DELETE")
+	api.HandleFunc("/trips/{id}/expenses/{expenseID}/split",
+		middleware.AuthMiddleware(splitHandler.SetExpenseSplit)).Methods("PUT")
+	api.HandleFunc("/trips/{id}/expenses/{expenseID}/receipts",
+		middleware.AuthMiddleware(receiptHandler.GetReceipts)).Methods("GET")
+	api.HandleFunc("/trips/{id}/expenses/{expenseID}/receipts",
+		middleware.AuthMiddleware(receiptHandler.UploadReceipt)).Methods("POST")
+	api.HandleFunc("/trips/{id}/expenses/{expenseID}/receipts/{receiptID}",
+		middleware.AuthMiddleware(receiptHandler.DownloadReceipt)).Methods("GET")
+	api.HandleFunc("/trips/{id}/expenses/{expenseID}/receipts/{receiptID}",
+		middleware.AuthMiddleware(receiptHandler.DeleteReceipt)).Methods("DELETE")
+	api.HandleFunc("/trips/{id}/report",
+		middleware.AuthMiddleware(reportHandler.TripReport)).Methods("GET")
+	api.HandleFunc("/trips/{id}/budgets",
+		middleware.AuthMiddleware(budgetHandler.GetBudgets)).Methods("GET")
+	api.HandleFunc("/trips/{id}/budgets",
+		middleware.AuthMiddleware(budgetHandler.SetBudgets)).Methods("PUT")
+	api.HandleFunc("/trips/{id}/balances",
+		middleware.AuthMiddleware(splitHandler.GetBalances)).Methods("GET")
+	api.HandleFunc("/trips/{id}/settlements",
+		middleware.AuthMiddleware(splitHandler.GetSettlements)).Methods("GET")
+	api.HandleFunc("/trips/{id}/settlements",
+		middleware.AuthMiddleware(splitHandler.CreateSettlement)).Methods("POST")
+	api.HandleFunc("/trips/{id}/settlements/{settlementID}",
+		middleware.AuthMiddleware(splitHandler.DeleteSettlement)).Methods("DELETE")
+	api.HandleFunc("/trips/{id}/members",
+		middleware.AuthMiddleware(checklistHandler.GetMembers)).Methods("GET")
+	api.HandleFunc("/trips/{id}/members",
+		middleware.AuthMiddleware(checklistHandler.AddMember)).Methods("POST")
+	api.HandleFunc("/trips/{id}/members/{userID}",
+		middleware.AuthMiddleware(checklistHandler.RemoveMember)).Methods("DELETE")
+	api.HandleFunc("/trips/{id}/checklists",
+		middleware.AuthMiddleware(checklistHandler.GetChecklists)).Methods("GET")
+	api.HandleFunc("/trips/{id}/checklists",
+		middleware.AuthMiddleware(checklistHandler.CreateChecklist)).Methods("POST")
+	api.HandleFunc("/trips/{id}/checklists/{checklistID}",
+		middleware.AuthMiddleware(checklistHandler.DeleteChecklist)).Methods("DELETE")
+	api.HandleFunc("/trips/{id}/checklists/{checklistID}/template",
+		middleware.AuthMiddleware(checklistHandler.SaveAsTemplate)).Methods("POST")
+	api.HandleFunc("/trips/{id}/checklists/{checklistID}/items",
+		middleware.AuthMiddleware(checklistHandler.AddItem)).Methods("POST")
+	api.HandleFunc("/trips/{id}/checklists/{checklistID}/items/{itemID}",
+		middleware.AuthMiddleware(checklistHandler.UpdateItem)).Methods("PUT")
+	api.HandleFunc("/trips/{id}/checklists/{checklistID}/items/{itemID}",
+		middleware.AuthMiddleware(checklistHandler.DeleteItem)).Methods("DELETE")
+	api.HandleFunc("/trips/{id}/reservations",
+		middleware.AuthMiddleware(reservationHandler.GetReservations)).Methods("GET")
+	api.HandleFunc("/trips/{id}/reservations",
+		middleware.AuthMiddleware(reservationHandler.CreateReservation)).Methods("POST")
+	api.HandleFunc("/trips/{id}/reservations/{reservationID}",
+		middleware.AuthMiddleware(reservationHandler.UpdateReservation)).Methods("PUT")
+	api.HandleFunc("/trips/{id}/reservations/{reservationID}",
+		middleware.AuthMiddleware(reservationHandler.DeleteReservation)).Methods("DELETE")
+	api.HandleFunc("/trips/{id}/itinerary",
+		middleware.AuthMiddleware(reservationHandler.GetItinerary)).Methods("GET")
+	api.HandleFunc("/trips/{id}/calendar.ics",
+		middleware.AuthMiddleware(reservationHandler.ExportCalendar)).Methods("GET")
+	// /trips/{id} en sona; yukarıdaki /trips/trash, /trips/export gibi sabit yolları gölgelemesin
+	handlers.RegisterTripRoutes(api, tripHandler)
+
+	// Trip template routes
+	api.HandleFunc("/templates",
+		middleware.OptionalAuthMiddleware(tripTemplateHandler.GetTemplates)).Methods("GET")
+	api.HandleFunc("/templates/{id}",
+		middleware.OptionalAuthMiddleware(tripTemplateHandler.GetTemplateByID)).Methods("GET")
+	api.HandleFunc("/templates/{id}/use",
+		middleware.AuthMiddleware(tripTemplateHandler.UseTemplate)).Methods("POST")
+	api.HandleFunc("/templates/{id}",
+		middleware.AuthMiddleware(tripTemplateHandler.DeleteTemplate)).Methods("DELETE")
+
+	// Checklist template routes
+	api.HandleFunc("/checklist-templates",
+		middleware.AuthMiddleware(checklistHandler.GetTemplates)).Methods("GET")
+	api.HandleFunc("/checklist-templates/{id}",
+		middleware.AuthMiddleware(checklistHandler.DeleteTemplate)).Methods("DELETE")
+
+	// Expense category routes
+	api.HandleFunc("/categories",
+		middleware.AuthMiddleware(categoryHandler.GetCategories)).Methods("GET")
+	api.HandleFunc("/categories",
+		middleware.AuthMiddleware(categoryHandler.CreateCategory)).Methods("POST")
+	api.HandleFunc("/categories/merge",
+		middleware.AuthMiddleware(categoryHandler.MergeCategories)).Methods("POST")
+	api.HandleFunc("/categories/{id}",
+		middleware.AuthMiddleware(categoryHandler.UpdateCategory)).Methods("PUT")
+	api.HandleFunc("/categories/{id}",
+		middleware.AuthMiddleware(categoryHandler.DeleteCategory)).Methods("DELETE")
+
+	// Destination catalog routes
+	api.HandleFunc("/destinations", destinationHandler.Autocomplete).Methods("GET")
+	api.HandleFunc("/destinations/{id}", destinationHandler.GetDestination).Methods("GET")
+
+	// Notification routes
+	api.HandleFunc("/notifications",
+		middleware.AuthMiddleware(notificationHandler.GetNotifications)).Methods("GET")
+	api.HandleFunc("/notifications/read-all",
+		middleware.AuthMiddleware(notificationHandler.MarkAllRead)).Methods("POST")
+	api.HandleFunc("/notifications/settings",
+		middleware.AuthMiddleware(notificationHandler.GetSettings)).Methods("GET")
+	api.HandleFunc("/notifications/settings",
+		middleware.AuthMiddleware(notificationHandler.UpdateSettings)).Methods("PUT")
+	api.HandleFunc("/notifications/{id}/read",
+		middleware.AuthMiddleware(notificationHandler.MarkRead)).Methods("POST")
+
+	// Document vault routes
+	api.HandleFunc("/documents",
+		middleware.AuthMiddleware(documentHandler.GetDocuments)).Methods("GET")
+	api.HandleFunc("/documents",
+		middleware.AuthMiddleware(documentHandler.UploadDocument)).Methods("POST")
+	api.HandleFunc("/documents/reminders",
+		middleware.AuthMiddleware(documentHandler.GetReminders)).Methods("GET")
+	api.HandleFunc("/documents/{id}",
+		middleware.AuthMiddleware(documentHandler.GetDocument)).Methods("GET")
+	api.HandleFunc("/documents/{id}/download",
+		middleware.AuthMiddleware(documentHandler.DownloadDocument)).Methods("GET")
+	api.HandleFunc("/documents/{id}",
+		middleware.AuthMiddleware(documentHandler.UpdateDocument)).Methods("PUT")
+	api.HandleFunc("/documents/{id}",
+		middleware.AuthMiddleware(documentHandler.DeleteDocument)).Methods("DELETE")
 
 	// Recommendation routes
-	api.HandleFunc("/recommendations", recHandler.GetRecommendations).Methods("GET")
-	api.HandleFunc("/budget/analyze", recHandler.AnalyzeBudget).Methods("POST")
-	api.HandleFunc("/trips/{id}/budget/analyze", recHandler.AnalyzeBudgetByTripID).Methods("GET")
+	api.HandleFunc("/recommendations",
+		middleware.OptionalAuthMiddleware(recHandler.GetRecommendations)).Methods("GET")
+	api.HandleFunc("/budget/analyze",
+		middleware.OptionalAuthMiddleware(recHandler.AnalyzeBudget)).Methods("POST")
+
+	// Currency routes
+	api.HandleFunc("/currencies", currencyHandler.GetCurrencies).Methods("GET")
+	api.HandleFunc("/exchange-rates", currencyHandler.GetExchangeRate).Methods("GET")
+	api.HandleFunc("/trips/{id}/budget/analyze",
+		middleware.OptionalAuthMiddleware(recHandler.AnalyzeBudgetByTripID)).Methods("GET")
+
+	// İşbirlikçi filtreleme modeli arka plan işiyle yenilenir
+	recommendationServer := grpcserver.NewRecommendationServer(tripService, tripWriteService, categoryService, destinationService, currencyService)
+
+	// ========== BACKGROUND JOBS ==========
+	jobs := scheduler.New()
+	jobs.Every("recommendation-model", 6*time.Hour, recommendationServer.RefreshModel)
+	jobs.Every("trash-purge", 24*time.Hour, func() error {
+		purged, err := tripService.PurgeExpiredTrash(services.TrashRetention)
+		if purged > 0 {
+			log.Printf("🗑️ Purged %d trips from trash", purged)
+		}
+		return err
+	})
+	jobs.Every("trip-status", time.Hour, func() error {
+		changed, err := tripService.AdvanceTripStatuses(time.Now())
+		if changed > 0 {
+			log.Printf("🧭 %d trips changed status", changed)
+		}
+		return err
+	})
+	jobs.Every("receipt-cleanup", 24*time.Hour, func() error {
+		purged, err := receiptService.PurgeOrphans(services.ReceiptOrphanGrace)
+		if purged > 0 {
+			log.Printf("🧾 Removed %d orphaned receipt files", purged)
+		}
+		return err
+	})
+	jobs.Start()
 
 	// Sunucuyu başlat
 	// ========== TCP CHAT SERVER ==========
@@ -130,9 +434,14 @@ func main() {
 			log.Fatalf("❌ gRPC dinlenemedi %s: %v", GRPC_PORT, err)
 		}
 
-		grpcServer := grpc.NewServer()
-		recommendationServer := grpcserver.NewRecommendationServer(tripService)
+		// Tüm servisler HTTP oturum token'ını "authorization: Bearer" metadata'sı olarak bekler
+		grpcServer := grpc.NewServer(
+			grpc.UnaryInterceptor(grpcserver.AuthInterceptor),
+			grpc.StreamInterceptor(grpcserver.StreamAuthInterceptor),
+		)
 		pb.RegisterRecommendationServiceServer(grpcServer, recommendationServer)
+		pb.RegisterTripServiceServer(grpcServer, grpcserver.NewTripServer(tripService, tripWriteService))
+		pb.RegisterUserServiceServer(grpcServer, grpcserver.NewUserServer(userService))
 
 		fmt.Printf("🚀 gRPC Server: localhost%s\n", GRPC_PORT)
 		if err := grpcServer.Serve(lis); err != nil {
@@ -0,0 +1,192 @@
+package backup
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	Format  = "travelmate-backup"
+	Version = 1
+
+	manifestFile   = "manifest.json"
+	tripsFile      = "trips.json"
+	attachmentsDir = "attachments/"
+)
+
+// Manifest - Arşivin kökündeki manifest.json
+// Version arttığında Read eski sürümleri okumaya devam etmeli
+type Manifest struct {
+	Format      string           `json:"format"`
+	Version     int              `json:"version"`
+	ExportedAt  time.Time        `json:"exported_at"`
+	TripCount   int              `json:"trip_count"`
+	Attachments []AttachmentInfo `json:"attachments"`
+}
+
+// AttachmentInfo - attachments/ altındaki bir dosya ve ait olduğu kayıt
+type AttachmentInfo struct {
+	Path        string `json:"path"`       // arşiv içindeki yol, ör. attachments/expense-12/receipt.jpg
+	OwnerType   string `json:"owner_type"` // ör. "expense"
+	OwnerID     uint   `json:"owner_id"`   // kaynak sistemdeki ID
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+}
+
+// Archive - Bellekteki yedek: manifest + gezi kayıtları + ek dosyalar
+type Archive struct {
+	Manifest Manifest
+	Trips    []TripRecord
+	Files    map[string][]byte // Path -> içerik
+}
+
+func NewArchive() *Archive {
+	return &Archive{
+		Manifest: Manifest{
+			Format:      Format,
+			Version:     Version,
+			ExportedAt:  time.Now().UTC(),
+			Attachments: []AttachmentInfo{},
+		},
+		Trips: []TripRecord{},
+		Files: make(map[string][]byte),
+	}
+}
+
+// AddAttachment - Ek dosyayı arşive ekler ve manifest'e kaydeder
+// Aynı kayda aynı isimde ikinci dosya eklenirse yoluna sayı eklenir (receipt-2.jpg)
+func (a *Archive) AddAttachment(ownerType string, ownerID uint, fileName, contentType string, data []byte) AttachmentInfo {
+	dir := fmt.Sprintf("%s%s-%d/", attachmentsDir, ownerType, ownerID)
+	filePath := dir + path.Base(fileName)
+	ext := path.Ext(filePath)
+	for n := 2; a.hasFile(filePath); n++ {
+		filePath = fmt.Sprintf("%s%s-%d%s", dir, strings.TrimSuffix(path.Base(fileName), ext), n, ext)
+	}
+
+	info := AttachmentInfo{
+		Path:        filePath,
+		OwnerType:   ownerType,
+		OwnerID:     ownerID,
+		FileName:    path.Base(fileName),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+	}
+	a.Files[info.Path] = data
+	a.Manifest.Attachments = append(a.Manifest.Attachments, info)
+	return info
+}
+
+func (a *Archive) hasFile(filePath string) bool {
+	_, ok := a.Files[filePath]
+	return ok
+}
+
+// Write - Arşivi zip olarak yazar
+func Write(w io.Writer, a *Archive) error {
+	a.Manifest.TripCount = len(a.Trips)
+
+	zw := zip.NewWriter(w)
+	if err := writeJSON(zw, manifestFile, a.Manifest); err != nil {
+		return err
+	}
+	if err := writeJSON(zw, tripsFile, a.Trips); err != nil {
+		return err
+	}
+	for _, info := range a.Manifest.Attachments {
+		f, err := zw.Create(info.Path)
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write(a.Files[info.Path]); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// Read - Zip arşivini okur, format ve sürümü doğrular
+func Read(data []byte) (*Archive, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup archive: %v", err)
+	}
+
+	files := make(map[string]*zip.File)
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	a := &Archive{Files: make(map[string][]byte)}
+
+	if err := readJSON(files, manifestFile, &a.Manifest); err != nil {
+		return nil, err
+	}
+	if a.Manifest.Format != Format {
+		return nil, fmt.Errorf("unsupported backup format %q", a.Manifest.Format)
+	}
+	if a.Manifest.Version < 1 || a.Manifest.Version > Version {
+		return nil, fmt.Errorf("unsupported backup version %d", a.Manifest.Version)
+	}
+
+	if err := readJSON(files, tripsFile, &a.Trips); err != nil {
+		return nil, err
+	}
+
+	for _, info := range a.Manifest.Attachments {
+		if !strings.HasPrefix(info.Path, attachmentsDir) {
+			return nil, fmt.Errorf("invalid attachment path %q", info.Path)
+		}
+		f, ok := files[info.Path]
+		if !ok {
+			return nil, fmt.Errorf("attachment %q listed in manifest is missing", info.Path)
+		}
+		content, err := readFile(f)
+		if err != nil {
+			return nil, err
+		}
+		a.Files[info.Path] = content
+	}
+
+	return a, nil
+}
+
+func writeJSON(zw *zip.Writer, name string, v interface{}) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func readJSON(files map[string]*zip.File, name string, v interface{}) error {
+	f, ok := files[name]
+	if !ok {
+		return fmt.Errorf("backup archive is missing %s", name)
+	}
+	content, err := readFile(f)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(content, v); err != nil {
+		return fmt.Errorf("invalid %s: %v", name, err)
+	}
+	return nil
+}
+
+func readFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
@@ -0,0 +1,152 @@
+package backup
+
+import (
+	"time"
+	"travel-platform/internal/models"
+)
+
+// TripRecord - Arşivdeki bir gezi; ID'ler kaynak sistemdeki değerlerdir
+// Geri yüklemede yeni ID'ler verilir ve eşleme (IDMap) döndürülür
+type TripRecord struct {
+	ID          uint             `json:"id"`
+	Title       string           `json:"title"`
+	Destination string           `json:"destination"`
+	Latitude    *float64         `json:"latitude,omitempty"`
+	Longitude   *float64         `json:"longitude,omitempty"`
+	StartDate   time.Time        `json:"start_date"`
+	EndDate     time.Time        `json:"end_date"`
+	Description string           `json:"description"`
+	Budget      float64          `json:"budget"`
+	Currency    string           `json:"currency,omitempty"`
+	IsPublic    bool             `json:"is_public"`
+	Status      string           `json:"status,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+	Activities  []ActivityRecord `json:"activities"`
+	Expenses    []ExpenseRecord  `json:"expenses"`
+	Chat        []ChatRecord     `json:"chat"`
+}
+
+type ActivityRecord struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Location    string    `json:"location"`
+	Latitude    *float64  `json:"latitude,omitempty"`
+	Longitude   *float64  `json:"longitude,omitempty"`
+	Date        time.Time `json:"date"`
+}
+
+type ExpenseRecord struct {
+	ID          uint      `json:"id"`
+	Category    string    `json:"category"`
+	Amount      float64   `json:"amount"`
+	Currency    string    `json:"currency"`
+	ExpenseDate time.Time `json:"expense_date"`
+	Description string    `json:"description,omitempty"`
+}
+
+// ChatRecord - Gezi odasındaki bir mesaj; kullanıcılar başka sistemde olmayabileceği için isim saklanır
+type ChatRecord struct {
+	AuthorID   uint      `json:"author_id"`
+	AuthorName string    `json:"author_name"`
+	Message    string    `json:"message"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// NewTripRecord - Modeli (aktivite ve harcamalarıyla) arşiv kaydına çevirir
+func NewTripRecord(trip models.Trip, messages []models.ChatMessage) TripRecord {
+	record := TripRecord{
+		ID:          trip.ID,
+		Title:       trip.Title,
+		Destination: trip.Destination,
+		Latitude:    trip.Latitude,
+		Longitude:   trip.Longitude,
+		StartDate:   trip.StartDate,
+		EndDate:     trip.EndDate,
+		Description: trip.Description,
+		Budget:      trip.Budget,
+		Currency:    trip.Currency,
+		IsPublic:    trip.IsPublic,
+		Status:      trip.Status,
+		CreatedAt:   trip.CreatedAt,
+		Activities:  []ActivityRecord{},
+		Expenses:    []ExpenseRecord{},
+		Chat:        []ChatRecord{},
+	}
+
+	for _, a := range trip.Activities {
+		record.Activities = append(record.Activities, ActivityRecord{
+			ID:          a.ID,
+			Name:        a.Name,
+			Description: a.Description,
+			Location:    a.Location,
+			Latitude:    a.Latitude,
+			Longitude:   a.Longitude,
+			Date:        a.Date,
+		})
+	}
+
+	for _, e := range trip.Expenses {
+		record.Expenses = append(record.Expenses, ExpenseRecord{
+			ID:          e.ID,
+			Category:    e.Category,
+			Amount:      e.Amount,
+			Currency:    e.Currency,
+			ExpenseDate: e.ExpenseDate,
+			Description: e.Description,
+		})
+	}
+
+	for _, m := range messages {
+		record.Chat = append(record.Chat, ChatRecord{
+			AuthorID:   m.UserID,
+			AuthorName: m.SenderName(),
+			Message:    m.Message,
+			CreatedAt:  m.CreatedAt,
+		})
+	}
+
+	return record
+}
+
+// ToModel - Kaydı yeni kullanıcıya ait, ID'siz bir modele çevirir
+// Activities/Expenses sırası korunur, böylece eski -> yeni ID eşlemesi index ile yapılır
+func (r TripRecord) ToModel(userID uint) models.Trip {
+	trip := models.Trip{
+		UserID:      userID,
+		Title:       r.Title,
+		Destination: r.Destination,
+		Latitude:    r.Latitude,
+		Longitude:   r.Longitude,
+		StartDate:   r.StartDate,
+		EndDate:     r.EndDate,
+		Description: r.Description,
+		Budget:      r.Budget,
+		Currency:    r.Currency,
+		IsPublic:    r.IsPublic,
+		Status:      r.Status,
+	}
+
+	for _, a := range r.Activities {
+		trip.Activities = append(trip.Activities, models.Activity{
+			Name:        a.Name,
+			Description: a.Description,
+			Location:    a.Location,
+			Latitude:    a.Latitude,
+			Longitude:   a.Longitude,
+			Date:        a.Date,
+		})
+	}
+
+	for _, e := range r.Expenses {
+		trip.Expenses = append(trip.Expenses, models.Expense{
+			Category:    e.Category,
+			Amount:      e.Amount,
+			Currency:    e.Currency,
+			ExpenseDate: e.ExpenseDate,
+			Description: e.Description,
+		})
+	}
+
+	return trip
+}
@@ -1,8 +1,12 @@
 package chat
 
 import (
+	"bufio"
 	"fmt"
+	"net"
 	"sync"
+	"time"
+	"travel-platform/internal/models"
 )
 
 type Client struct {
@@ -85,3 +89,28 @@ func (h *Hub) GetRoomCount(roomID uint) int {
 	defer h.mu.RUnlock()
 	return len(h.rooms[roomID])
 }
+
+// Broadcast - Mesajı odadaki client'lara yazar
+// Sistem mesajları herkese, normal mesajlar gönderen hariç gider
+func (h *Hub) Broadcast(roomID uint, message, sender string, senderID uint) {
+	clients := h.GetRoomClients(roomID)
+	timestamp := time.Now().Format("15:04:05")
+	formattedMsg := fmt.Sprintf("[%s] %s: %s\n", timestamp, sender, message)
+
+	for _, client := range clients {
+		if client.ID == senderID && sender != models.SystemSenderName {
+			continue
+		}
+
+		go func(c *Client, msg string) {
+			conn, ok := c.Conn.(net.Conn)
+			if !ok {
+				return
+			}
+
+			writer := bufio.NewWriter(conn)
+			writer.WriteString(msg)
+			writer.Flush()
+		}(client, formattedMsg)
+	}
+}
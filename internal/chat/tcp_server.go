@@ -130,6 +130,10 @@ func (s *Server) handleConnection(conn net.Conn) {
 
 	if result.Error != nil {
 		room = models.ChatRoom{Name: roomName}
+		// "trip-<id>" odaları geziye bağlanır (export ve bildirimler için)
+		if tripID, ok := models.ParseTripRoomName(roomName); ok {
+			room.TripID = &tripID
+		}
 		if err := db.Create(&room).Error; err != nil {
 			writer.WriteString(fmt.Sprintf("❌ Error creating room: %v\n", err))
 			writer.Flush()
@@ -169,11 +173,12 @@ func (s *Server) handleConnection(conn net.Conn) {
 		writer.WriteString("─────────────────────────────\n")
 
 		for _, msg := range previousMessages {
-			// Kullanıcı bilgisini al
-			var user models.User
-			db.First(&user, msg.UserID)
+			// Kullanıcı bilgisini al (sistem mesajlarının kullanıcısı yok)
+			if msg.UserID != models.SystemUserID {
+				db.First(&msg.User, msg.UserID)
+			}
 
-			senderName := fmt.Sprintf("%s %s", user.FirstName, user.LastName)
+			senderName := msg.SenderName()
 			timestamp := msg.CreatedAt.Format("15:04:05")
 
 			writer.WriteString(fmt.Sprintf("[%s] %s: %s\n",
@@ -234,25 +239,5 @@ func (s *Server) handleConnection(conn net.Conn) {
 }
 
 func (s *Server) broadcastMessage(roomID uint, message, sender string, senderID uint) {
-	clients := s.hub.GetRoomClients(roomID)
-	timestamp := time.Now().Format("15:04:05")
-	formattedMsg := fmt.Sprintf("[%s] %s: %s\n", timestamp, sender, message)
-
-	for _, client := range clients {
-		// Sistem mesajları herkese, normal mesajlar kendisi hariç
-		if client.ID == senderID && sender != "System" {
-			continue
-		}
-
-		go func(c *Client, msg string) {
-			conn, ok := c.Conn.(net.Conn)
-			if !ok {
-				return
-			}
-
-			writer := bufio.NewWriter(conn)
-			writer.WriteString(msg)
-			writer.Flush()
-		}(client, formattedMsg)
-	}
+	s.hub.Broadcast(roomID, message, sender, senderID)
 }
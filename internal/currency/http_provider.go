@@ -0,0 +1,59 @@
+package currency
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// HTTPProvider - Frankfurter uyumlu bir kur servisinden (ör. https://api.frankfurter.app) günlük kur alır
+// GET {BaseURL}/2025-01-02?from=USD&to=EUR -> {"rates": {"EUR": 0.97}}
+type HTTPProvider struct {
+	BaseURL string
+	Client  *http.Client
+}
+
+func NewHTTPProvider(baseURL string) *HTTPProvider {
+	return &HTTPProvider{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Client:  &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+func (p *HTTPProvider) Rate(from, to string, date time.Time) (float64, error) {
+	from, to = Normalize(from), Normalize(to)
+	if from == to {
+		return 1, nil
+	}
+
+	query := url.Values{"from": {from}, "to": {to}}
+	endpoint := fmt.Sprintf("%s/%s?%s", p.BaseURL, date.Format("2006-01-02"), query.Encode())
+
+	resp, err := p.Client.Get(endpoint)
+	if err != nil {
+		return 0, fmt.Errorf("exchange rate request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusUnprocessableEntity {
+		return 0, fmt.Errorf("%w: %s/%s", ErrRateNotFound, from, to)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("exchange rate service returned %s", resp.Status)
+	}
+
+	var body struct {
+		Rates map[string]float64 `json:"rates"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return 0, fmt.Errorf("invalid exchange rate response: %w", err)
+	}
+	rate, ok := body.Rates[to]
+	if !ok || rate <= 0 {
+		return 0, fmt.Errorf("%w: %s/%s", ErrRateNotFound, from, to)
+	}
+	return rate, nil
+}
@@ -0,0 +1,123 @@
+package currency
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Base - Uygulamanın varsayılan para birimi (eski kayıtlar ve boş alanlar için)
+const Base = "EUR"
+
+// ErrRateNotFound - Sağlayıcı istenen para birimi çifti için kur bulamadı
+var ErrRateNotFound = errors.New("exchange rate not found")
+
+// RateProvider - Belirli bir tarihte 1 birim "from" için kaç birim "to" ödendiğini döndürür
+// Farklı kaynaklar (offline tablo, veritabanı, HTTP servisleri) bu interface'i uygular
+type RateProvider interface {
+	Rate(from, to string, date time.Time) (float64, error)
+}
+
+// Chain - Sağlayıcıları sırayla dener, ilk başarılı cevabı döndürür
+type Chain []RateProvider
+
+func (c Chain) Rate(from, to string, date time.Time) (float64, error) {
+	err := fmt.Errorf("%w: %s/%s", ErrRateNotFound, from, to)
+	for _, provider := range c {
+		rate, providerErr := provider.Rate(from, to, date)
+		if providerErr == nil {
+			return rate, nil
+		}
+		err = providerErr
+	}
+	return 0, err
+}
+
+// Normalize - "usd " -> "USD"; boş kod varsayılan para birimine düşer
+func Normalize(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return Base
+	}
+	return code
+}
+
+// IsValidCode - ISO 4217 biçiminde üç harfli kod mu
+func IsValidCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// Convert - Tutarı verilen tarihteki kurla çevirir, kullanılan kuru da döndürür
+func Convert(provider RateProvider, amount float64, from, to string, date time.Time) (float64, float64, error) {
+	from, to = Normalize(from), Normalize(to)
+	if from == to {
+		return amount, 1, nil
+	}
+	rate, err := provider.Rate(from, to, date)
+	if err != nil {
+		return 0, 0, err
+	}
+	return Round(amount * rate), rate, nil
+}
+
+// Round - Para tutarlarını kuruşa yuvarlar
+func Round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// Lister - Desteklediği para birimlerini listeleyebilen sağlayıcılar
+type Lister interface {
+	Currencies() []string
+}
+
+// Currencies - Zincirdeki listelenebilir sağlayıcıların para birimleri, tekrarsız
+func (c Chain) Currencies() []string {
+	seen := make(map[string]bool)
+	var codes []string
+	for _, provider := range c {
+		lister, ok := provider.(Lister)
+		if !ok {
+			continue
+		}
+		for _, code := range lister.Currencies() {
+			if !seen[code] {
+				seen[code] = true
+				codes = append(codes, code)
+			}
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// symbols - Arayüzde kod yerine gösterilen semboller
+var symbols = map[string]string{
+	"EUR": "€",
+	"USD": "$",
+	"GBP": "£",
+	"JPY": "¥",
+	"TRY": "₺",
+	"CHF": "CHF ",
+	"THB": "฿",
+	"PLN": "zł ",
+}
+
+// Symbol - "EUR" -> "€"; sembolü bilinmeyen kodlar "SEK " gibi gösterilir
+func Symbol(code string) string {
+	code = Normalize(code)
+	if symbol, ok := symbols[code]; ok {
+		return symbol
+	}
+	return code + " "
+}
@@ -0,0 +1,137 @@
+package currency
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed rates.csv
+var ratesCSV string
+
+// Table - Ağ bağlantısı gerektirmeyen, EUR bazlı günlük kur tablosu
+// İstenen tarihte kur yoksa o tarihten önceki en yakın kur kullanılır
+type Table struct {
+	rates map[string][]tableRate // para birimi -> tarihe göre sıralı kurlar
+}
+
+type tableRate struct {
+	date time.Time
+	rate float64 // 1 EUR = rate birim
+}
+
+var (
+	defaultTable *Table
+	tableOnce    sync.Once
+)
+
+// DefaultTable - Pakete gömülü rates.csv'den oluşturulan tablo (yaklaşık ECB referans kurları)
+func DefaultTable() *Table {
+	tableOnce.Do(func() {
+		t, err := LoadTable(strings.NewReader(ratesCSV))
+		if err != nil {
+			panic(fmt.Sprintf("embedded rate table is invalid: %v", err))
+		}
+		defaultTable = t
+	})
+	return defaultTable
+}
+
+// LoadTableFile - Diskteki CSV kur tablosunu okur
+func LoadTableFile(path string) (*Table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadTable(f)
+}
+
+// LoadTable - "date,currency,rate" başlıklı CSV okur; rate 1 EUR karşılığıdır
+func LoadTable(r io.Reader) (*Table, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("rate table is empty")
+	}
+
+	t := &Table{rates: make(map[string][]tableRate)}
+	for i, rec := range records[1:] {
+		if len(rec) < 3 {
+			return nil, fmt.Errorf("line %d: expected 3 columns", i+2)
+		}
+		date, err := time.Parse("2006-01-02", strings.TrimSpace(rec[0]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid date", i+2)
+		}
+		code := Normalize(rec[1])
+		if !IsValidCode(code) {
+			return nil, fmt.Errorf("line %d: invalid currency %q", i+2, rec[1])
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(rec[2]), 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("line %d: invalid rate", i+2)
+		}
+		t.rates[code] = append(t.rates[code], tableRate{date: date, rate: rate})
+	}
+
+	for code := range t.rates {
+		rates := t.rates[code]
+		sort.Slice(rates, func(i, j int) bool { return rates[i].date.Before(rates[j].date) })
+	}
+	return t, nil
+}
+
+// Rate - Çapraz kur EUR üzerinden hesaplanır (USD -> GBP = GBP/EUR ÷ USD/EUR)
+func (t *Table) Rate(from, to string, date time.Time) (float64, error) {
+	from, to = Normalize(from), Normalize(to)
+	if from == to {
+		return 1, nil
+	}
+	fromRate, ok := t.perEuro(from, date)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrRateNotFound, from)
+	}
+	toRate, ok := t.perEuro(to, date)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrRateNotFound, to)
+	}
+	return toRate / fromRate, nil
+}
+
+// Currencies - Tablodaki para birimleri (EUR dahil), alfabetik
+func (t *Table) Currencies() []string {
+	codes := []string{Base}
+	for code := range t.rates {
+		if code != Base {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// perEuro - Tarihte geçerli kur; tablo başlangıcından önceki tarihler ilk kuru kullanır
+func (t *Table) perEuro(code string, date time.Time) (float64, bool) {
+	if code == Base {
+		return 1, true
+	}
+	rates, ok := t.rates[code]
+	if !ok || len(rates) == 0 {
+		return 0, false
+	}
+	i := sort.Search(len(rates), func(i int) bool { return rates[i].date.After(date) })
+	if i == 0 {
+		return rates[0].rate, true
+	}
+	return rates[i-1].rate, true
+}
@@ -34,7 +34,28 @@ func ConnectDatabase() error {
 		&models.Trip{},
 		&models.Expense{},
 		&models.Activity{},
-		&models.ChatMessage{})
+		&models.ChatRoom{},
+		&models.ChatMessage{},
+		&models.TripAuditEntry{},
+		&models.TripTemplate{},
+		&models.TemplateActivity{},
+		&models.TripMember{},
+		&models.Checklist{},
+		&models.ChecklistItem{},
+		&models.ChecklistTemplate{},
+		&models.ChecklistTemplateItem{},
+		&models.Reservation{},
+		&models.TravelDocument{},
+		&models.ExchangeRate{},
+		&models.ExpenseSplit{},
+		&models.Settlement{},
+		&models.Receipt{},
+		&models.CategoryBudget{},
+		&models.ExpenseCategory{},
+		&models.Notification{},
+		&models.NotificationSettings{},
+		&models.BudgetAlert{},
+		&models.Destination{})
 	if error != nil {
 		log.Fatal("Failed to migrate database:", error)
 	}
@@ -0,0 +1,277 @@
+package geo
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+	"travel-platform/internal/models"
+)
+
+// Point - Haritada gösterilecek tek bir nokta (destinasyon veya aktivite)
+type Point struct {
+	Name        string
+	Description string
+	Kind        string // "destination" veya "activity"
+	Latitude    float64
+	Longitude   float64
+	Time        time.Time
+}
+
+// TripPoints - Koordinatı olan noktaları döndürür; aktiviteler tarih sırasındadır
+// Rota (route) aktivitelerin bu sırayla birleştirilmesidir
+func TripPoints(trip *models.Trip) (destination *Point, activities []Point) {
+	if trip.Latitude != nil && trip.Longitude != nil {
+		destination = &Point{
+			Name:        trip.Destination,
+			Description: trip.Title,
+			Kind:        "destination",
+			Latitude:    *trip.Latitude,
+			Longitude:   *trip.Longitude,
+			Time:        trip.StartDate,
+		}
+	}
+
+	for _, a := range trip.Activities {
+		if a.Latitude == nil || a.Longitude == nil {
+			continue
+		}
+		activities = append(activities, Point{
+			Name:        a.Name,
+			Description: a.Description,
+			Kind:        "activity",
+			Latitude:    *a.Latitude,
+			Longitude:   *a.Longitude,
+			Time:        a.Date,
+		})
+	}
+
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].Time.Before(activities[j].Time)
+	})
+	return destination, activities
+}
+
+// ========== GeoJSON (RFC 7946) ==========
+
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+type geoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   geoJSONGeometry        `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type geoJSONGeometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+// WriteGeoJSON - Noktalar Point, rota LineString feature olarak yazılır ([lon, lat] sırası)
+func WriteGeoJSON(w io.Writer, trip *models.Trip) error {
+	destination, activities := TripPoints(trip)
+
+	collection := geoJSONFeatureCollection{Type: "FeatureCollection", Features: []geoJSONFeature{}}
+
+	points := activities
+	if destination != nil {
+		points = append([]Point{*destination}, activities...)
+	}
+	for _, p := range points {
+		collection.Features = append(collection.Features, geoJSONFeature{
+			Type:     "Feature",
+			Geometry: geoJSONGeometry{Type: "Point", Coordinates: []float64{p.Longitude, p.Latitude}},
+			Properties: map[string]interface{}{
+				"name":        p.Name,
+				"description": p.Description,
+				"kind":        p.Kind,
+				"date":        p.Time.Format("2006-01-02"),
+			},
+		})
+	}
+
+	if len(activities) >= 2 {
+		var line [][]float64
+		for _, p := range activities {
+			line = append(line, []float64{p.Longitude, p.Latitude})
+		}
+		collection.Features = append(collection.Features, geoJSONFeature{
+			Type:       "Feature",
+			Geometry:   geoJSONGeometry{Type: "LineString", Coordinates: line},
+			Properties: map[string]interface{}{"name": trip.Title, "kind": "route"},
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(collection)
+}
+
+// ========== GPX 1.1 ==========
+
+type gpxDocument struct {
+	XMLName  xml.Name      `xml:"gpx"`
+	Xmlns    string        `xml:"xmlns,attr"`
+	Version  string        `xml:"version,attr"`
+	Creator  string        `xml:"creator,attr"`
+	Metadata gpxMetadata   `xml:"metadata"`
+	Points   []gpxWaypoint `xml:"wpt"`
+	Route    *gpxRoute     `xml:"rte,omitempty"`
+}
+
+type gpxMetadata struct {
+	Name string `xml:"name"`
+	Desc string `xml:"desc,omitempty"`
+}
+
+type gpxWaypoint struct {
+	Lat  float64 `xml:"lat,attr"`
+	Lon  float64 `xml:"lon,attr"`
+	Time string  `xml:"time,omitempty"`
+	Name string  `xml:"name"`
+	Desc string  `xml:"desc,omitempty"`
+	Type string  `xml:"type,omitempty"`
+}
+
+type gpxRoute struct {
+	Name   string        `xml:"name"`
+	Points []gpxWaypoint `xml:"rtept"`
+}
+
+// WriteGPX - Noktalar wpt, rota rte olarak yazılır
+func WriteGPX(w io.Writer, trip *models.Trip) error {
+	destination, activities := TripPoints(trip)
+
+	doc := gpxDocument{
+		Xmlns:    "http://www.topografix.com/GPX/1/1",
+		Version:  "1.1",
+		Creator:  "TravelMate",
+		Metadata: gpxMetadata{Name: trip.Title, Desc: trip.Description},
+	}
+
+	toWaypoint := func(p Point) gpxWaypoint {
+		return gpxWaypoint{
+			Lat:  p.Latitude,
+			Lon:  p.Longitude,
+			Time: p.Time.UTC().Format(time.RFC3339),
+			Name: p.Name,
+			Desc: p.Description,
+			Type: p.Kind,
+		}
+	}
+
+	if destination != nil {
+		doc.Points = append(doc.Points, toWaypoint(*destination))
+	}
+	for _, p := range activities {
+		doc.Points = append(doc.Points, toWaypoint(p))
+	}
+
+	if len(activities) >= 2 {
+		doc.Route = &gpxRoute{Name: trip.Title}
+		for _, p := range activities {
+			doc.Route.Points = append(doc.Route.Points, toWaypoint(p))
+		}
+	}
+
+	return writeXML(w, doc)
+}
+
+// ========== KML 2.2 ==========
+
+type kmlDocument struct {
+	XMLName  xml.Name     `xml:"kml"`
+	Xmlns    string       `xml:"xmlns,attr"`
+	Document kmlContainer `xml:"Document"`
+}
+
+type kmlContainer struct {
+	Name       string         `xml:"name"`
+	Desc       string         `xml:"description,omitempty"`
+	Placemarks []kmlPlacemark `xml:"Placemark"`
+}
+
+type kmlPlacemark struct {
+	Name       string         `xml:"name"`
+	Desc       string         `xml:"description,omitempty"`
+	TimeStamp  *kmlTimeStamp  `xml:"TimeStamp,omitempty"`
+	Point      *kmlPoint      `xml:"Point,omitempty"`
+	LineString *kmlLineString `xml:"LineString,omitempty"`
+}
+
+type kmlTimeStamp struct {
+	When string `xml:"when"`
+}
+
+type kmlPoint struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+type kmlLineString struct {
+	Tessellate  int    `xml:"tessellate"`
+	Coordinates string `xml:"coordinates"`
+}
+
+// WriteKML - Noktalar Placemark/Point, rota Placemark/LineString olarak yazılır
+func WriteKML(w io.Writer, trip *models.Trip) error {
+	destination, activities := TripPoints(trip)
+
+	doc := kmlDocument{
+		Xmlns:    "http://www.opengis.net/kml/2.2",
+		Document: kmlContainer{Name: trip.Title, Desc: trip.Description},
+	}
+
+	toPlacemark := func(p Point) kmlPlacemark {
+		return kmlPlacemark{
+			Name:      p.Name,
+			Desc:      p.Description,
+			TimeStamp: &kmlTimeStamp{When: p.Time.Format("2006-01-02")},
+			Point:     &kmlPoint{Coordinates: kmlCoordinate(p)},
+		}
+	}
+
+	if destination != nil {
+		doc.Document.Placemarks = append(doc.Document.Placemarks, toPlacemark(*destination))
+	}
+	for _, p := range activities {
+		doc.Document.Placemarks = append(doc.Document.Placemarks, toPlacemark(p))
+	}
+
+	if len(activities) >= 2 {
+		coords := ""
+		for i, p := range activities {
+			if i > 0 {
+				coords += " "
+			}
+			coords += kmlCoordinate(p)
+		}
+		doc.Document.Placemarks = append(doc.Document.Placemarks, kmlPlacemark{
+			Name:       trip.Title + " route",
+			LineString: &kmlLineString{Tessellate: 1, Coordinates: coords},
+		})
+	}
+
+	return writeXML(w, doc)
+}
+
+func kmlCoordinate(p Point) string {
+	return fmt.Sprintf("%g,%g,0", p.Longitude, p.Latitude)
+}
+
+func writeXML(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
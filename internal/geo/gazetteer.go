@@ -0,0 +1,107 @@
+package geo
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:embed gazetteer.csv
+var gazetteerCSV string
+
+// Gazetteer - Ağ bağlantısı gerektirmeyen, bellek içi şehir listesi ile geocoder
+type Gazetteer struct {
+	entries []Location
+	index   map[string]int // normalize edilmiş isim/alias -> entries index
+}
+
+var (
+	defaultGazetteer *Gazetteer
+	gazetteerOnce    sync.Once
+)
+
+// DefaultGazetteer - Pakete gömülü gazetteer.csv'den oluşturulan gazetteer
+func DefaultGazetteer() *Gazetteer {
+	gazetteerOnce.Do(func() {
+		g, err := LoadGazetteer(strings.NewReader(gazetteerCSV))
+		if err != nil {
+			panic(fmt.Sprintf("embedded gazetteer is invalid: %v", err))
+		}
+		defaultGazetteer = g
+	})
+	return defaultGazetteer
+}
+
+// LoadGazetteer - "name,country,latitude,longitude,aliases" başlıklı CSV okur
+// aliases alanı "|" ile ayrılmış alternatif isimlerdir
+func LoadGazetteer(r io.Reader) (*Gazetteer, error) {
+	reader := csv.NewReader(r)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("gazetteer is empty")
+	}
+
+	g := &Gazetteer{index: make(map[string]int)}
+	for i, rec := range records[1:] {
+		if len(rec) < 4 {
+			return nil, fmt.Errorf("line %d: expected at least 4 columns", i+2)
+		}
+		lat, err := strconv.ParseFloat(rec[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid latitude", i+2)
+		}
+		lon, err := strconv.ParseFloat(rec[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid longitude", i+2)
+		}
+
+		loc := Location{Name: rec[0], Country: rec[1], Latitude: lat, Longitude: lon}
+		if len(rec) > 4 && rec[4] != "" {
+			loc.Aliases = strings.Split(rec[4], "|")
+		}
+		g.entries = append(g.entries, loc)
+		pos := len(g.entries) - 1
+
+		g.index[normalize(rec[0])] = pos
+		g.index[normalize(rec[0]+", "+rec[1])] = pos
+		for _, alias := range loc.Aliases {
+			g.index[normalize(alias)] = pos
+		}
+	}
+	return g, nil
+}
+
+// Locations - Gazetteer'daki tüm yerler (destinasyon kataloğunun başlangıç verisi)
+func (g *Gazetteer) Locations() []Location {
+	return append([]Location(nil), g.entries...)
+}
+
+// Geocode - Önce tam eşleşme, sonra virgülle ayrılmış parçaları (ör. "Eiffel Tower, Paris") dener
+func (g *Gazetteer) Geocode(query string) (*Location, error) {
+	q := normalize(query)
+	if q == "" {
+		return nil, ErrNotFound
+	}
+
+	if pos, ok := g.index[q]; ok {
+		loc := g.entries[pos]
+		return &loc, nil
+	}
+
+	parts := strings.Split(q, ",")
+	for i := len(parts) - 1; i >= 0; i-- {
+		if pos, ok := g.index[strings.TrimSpace(parts[i])]; ok {
+			loc := g.entries[pos]
+			return &loc, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
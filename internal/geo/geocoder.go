@@ -0,0 +1,29 @@
+package geo
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrNotFound - Geocoder verilen yer için koordinat bulamadı
+var ErrNotFound = errors.New("location not found")
+
+// Location - Bir yer adının çözümlenmiş hali
+type Location struct {
+	Name      string   `json:"name"`
+	Country   string   `json:"country"`
+	Latitude  float64  `json:"latitude"`
+	Longitude float64  `json:"longitude"`
+	Aliases   []string `json:"aliases,omitempty"` // Gazetteer'daki alternatif isimler
+}
+
+// Geocoder - Serbest metin yer adını koordinata çevirir
+// Farklı sağlayıcılar (offline gazetteer, HTTP servisleri) bu interface'i uygular
+type Geocoder interface {
+	Geocode(query string) (*Location, error)
+}
+
+// normalize - Karşılaştırma için küçük harf ve tek boşluk
+func normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
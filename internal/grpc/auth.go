@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"travel-platform/internal/middleware"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// userIDKey - Doğrulanmış kullanıcının context anahtarı
+type userIDKey struct{}
+
+// sessionKey - Doğrulamada kullanılan token (Logout için)
+type sessionKey struct{}
+
+// AuthInterceptor - "authorization: Bearer <token>" metadata'sını HTTP oturumlarıyla doğrular
+// Token yoksa istek kullanıcısız devam eder; token gerektiren metotlar requireUser ile kontrol eder
+func AuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamAuthInterceptor - AuthInterceptor'ın stream RPC'ler için karşılığı
+func StreamAuthInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := authenticate(stream.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+}
+
+// authenticatedStream - Doğrulanmış kullanıcıyı taşıyan context'i stream'e verir
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate - Token varsa oturumu doğrulayıp kullanıcıyı context'e ekler
+func authenticate(ctx context.Context) (context.Context, error) {
+	token, ok := bearerToken(ctx)
+	if !ok {
+		return ctx, nil
+	}
+
+	session, exists := middleware.GetSession(token)
+	if !exists {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
+	}
+	ctx = context.WithValue(ctx, userIDKey{}, session.UserID)
+	ctx = context.WithValue(ctx, sessionKey{}, token)
+	return ctx, nil
+}
+
+// UserIDFromContext - AuthInterceptor'ın doğruladığı kullanıcı
+func UserIDFromContext(ctx context.Context) (uint, bool) {
+	userID, ok := ctx.Value(userIDKey{}).(uint)
+	return userID, ok
+}
+
+// requireUser - Token gerektiren metotlar için
+func requireUser(ctx context.Context) (uint, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return 0, status.Error(codes.Unauthenticated, "authorization token is required")
+	}
+	return userID, nil
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, value := range md.Get("authorization") {
+		if token, found := strings.CutPrefix(value, "Bearer "); found && strings.TrimSpace(token) != "" {
+			return strings.TrimSpace(token), true
+		}
+	}
+	return "", false
+}
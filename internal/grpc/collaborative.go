@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"travel-platform/internal/models"
+	"travel-platform/internal/recommender"
+	pb "travel-platform/proto"
+)
+
+const (
+	// CollaborativeWeight - İşbirlikçi puanın içerik kurallarıyla karışımdaki payı
+	CollaborativeWeight = 0.3
+	// EvaluationK - Çevrimdışı değerlendirmede precision@k için k
+	EvaluationK = 3
+)
+
+// RefreshModel - Public gezilerden işbirlikçi filtreleme modelini yeniden oluşturur ve değerlendirir
+// Arka plan işi olarak periyodik çalıştırılır; model hazır olana kadar sadece içerik kuralları kullanılır
+func (s *RecommendationServer) RefreshModel() error {
+	trips, err := s.tripService.GetPublicTrips()
+	if err != nil {
+		return err
+	}
+
+	interactions := s.interactions(trips)
+	model := recommender.Build(interactions)
+	evaluation := recommender.Evaluate(interactions, EvaluationK)
+
+	s.mu.Lock()
+	s.model = model
+	s.evaluation = evaluation
+	s.mu.Unlock()
+
+	log.Printf("🤝 Recommendation model: %d users, %d destinations, precision@%d=%.2f, hit rate=%.2f (%d held-out trips)",
+		model.Users(), model.Destinations(), evaluation.K, evaluation.PrecisionAtK, evaluation.HitRate, evaluation.Users)
+	return nil
+}
+
+// Evaluation - Son model yenilemesindeki çevrimdışı değerlendirme sonucu
+func (s *RecommendationServer) Evaluation() recommender.Evaluation {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.evaluation
+}
+
+func (s *RecommendationServer) currentModel() *recommender.Model {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.model
+}
+
+// interactions - Her gezi, sahibinin destinasyon ve aktivite kelimeleriyle etkileşimidir
+func (s *RecommendationServer) interactions(trips []models.Trip) []recommender.Interaction {
+	var interactions []recommender.Interaction
+	for _, trip := range trips {
+		if trip.Destination == "" {
+			continue
+		}
+		p := s.resolvePlace(trip.Destination)
+		interactions = append(interactions, recommender.Interaction{
+			UserID: trip.UserID,
+			TripID: trip.ID,
+			Item:   recommender.DestinationItem(p.key),
+			Label:  trip.Destination,
+			Date:   trip.StartDate,
+		})
+		for _, activity := range trip.Activities {
+			for _, keyword := range activityKeywords(activity.Name) {
+				interactions = append(interactions, recommender.Interaction{
+					UserID: trip.UserID,
+					TripID: trip.ID,
+					Item:   recommender.ActivityItem(keyword),
+					Label:  keyword,
+					Date:   trip.StartDate,
+				})
+			}
+		}
+	}
+	return interactions
+}
+
+// blendCollaborative - Model adayı tanıyorsa puan içerik puanıyla ağırlıklı karıştırılır
+func (s *RecommendationServer) blendCollaborative(model *recommender.Model, profile *travelProfile, rec *pb.Recommendation) {
+	if model == nil || profile == nil || len(profile.items) == 0 {
+		return
+	}
+	item := recommender.DestinationItem(s.resolvePlace(rec.Destination).key)
+	if !model.Knows(item) {
+		return
+	}
+
+	match := model.Score(profile.items, item)
+	rec.MatchScore = math.Min(rec.MatchScore*(1-CollaborativeWeight)+match.Score*100*CollaborativeWeight, 100.0)
+	if match.Because == "" {
+		return
+	}
+	if recommender.IsDestination(match.Item) {
+		rec.Reasons = append(rec.Reasons, fmt.Sprintf("Travelers who went to %s also went here", match.Because))
+	} else {
+		rec.Reasons = append(rec.Reasons, fmt.Sprintf("Popular with travelers who enjoy %s", match.Because))
+	}
+}
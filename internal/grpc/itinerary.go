@@ -0,0 +1,316 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+	pb "travel-platform/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Gün planı sınırları
+const (
+	DefaultActivitiesPerDay = 3
+	MaxActivitiesPerDay     = 6
+	MaxItineraryDays        = 60
+)
+
+// itineraryActivity - Public gezilerde görülen aktivite ve kaç gezide yapıldığı
+type itineraryActivity struct {
+	name       string
+	location   string
+	popularity int
+}
+
+// destinationCosts - Topluluk gezilerinden EUR cinsinden maliyet tahminleri (0 = veri yok)
+type destinationCosts struct {
+	daily    float64 // Gezi başına günlük ortalama harcama
+	activity float64 // "activities" harcamalarından aktivite başına ortalama
+	trips    int
+}
+
+// GenerateItinerary - Destinasyon, tarih ve bütçeden gün gün plan
+// Aktiviteler popülerliğe göre günlere dağıtılır, bütçe günlük giderler ve aktiviteler arasında paylaştırılır
+func (s *RecommendationServer) GenerateItinerary(ctx context.Context, req *pb.ItineraryRequest) (*pb.ItineraryResponse, error) {
+	if strings.TrimSpace(req.Destination) == "" {
+		return nil, status.Error(codes.InvalidArgument, "destination is required")
+	}
+
+	start, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start_date %q, use YYYY-MM-DD", req.StartDate)
+	}
+	end, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid end_date %q, use YYYY-MM-DD", req.EndDate)
+	}
+	if end.Before(start) {
+		return nil, status.Error(codes.InvalidArgument, "end_date must be after start_date")
+	}
+	days := int(end.Sub(start).Hours()/24) + 1
+	if days > MaxItineraryDays {
+		return nil, status.Errorf(codes.InvalidArgument, "itineraries are limited to %d days", MaxItineraryDays)
+	}
+
+	if req.Budget < 0 {
+		return nil, status.Error(codes.InvalidArgument, "budget must not be negative")
+	}
+	planCurrency := currency.Normalize(req.Currency)
+	if !currency.IsValidCode(planCurrency) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid currency %q", req.Currency)
+	}
+
+	perDay := int(req.ActivitiesPerDay)
+	if perDay == 0 {
+		perDay = DefaultActivitiesPerDay
+	}
+	if perDay < 1 || perDay > MaxActivitiesPerDay {
+		return nil, status.Errorf(codes.InvalidArgument, "activities_per_day must be between 1 and %d", MaxActivitiesPerDay)
+	}
+
+	// Kaydetme token gerektirir; gezi her zaman token'ın kullanıcısına kaydedilir
+	var ownerID uint
+	if req.Save {
+		userID, err := requireUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if req.UserId != 0 && uint(req.UserId) != userID {
+			return nil, status.Error(codes.PermissionDenied, "user_id does not match the authorization token")
+		}
+		ownerID = userID
+	}
+
+	// Katalogdaki kayıt varsa ad ve öne çıkanlar oradan gelir
+	destination := strings.TrimSpace(req.Destination)
+	var highlights []string
+	var destinationID *uint
+	if match, ok := s.destinations.Match(destination); ok {
+		destination = match.DisplayName()
+		highlights = match.HighlightList()
+		destinationID = &match.ID
+	}
+
+	trips, err := s.tripService.GetPublicTrips()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	key := s.resolvePlace(req.Destination).key
+	var matching []models.Trip
+	for _, trip := range trips {
+		if s.resolvePlace(trip.Destination).key == key {
+			matching = append(matching, trip)
+		}
+	}
+
+	activities := itineraryActivities(matching, highlights)
+	if len(activities) > days*perDay {
+		activities = activities[:days*perDay]
+	}
+
+	// Tahminler EUR'dan planın para birimine çevrilir
+	costs := s.destinationCosts(matching)
+	toPlan := func(amount float64) float64 {
+		converted, _, err := currency.Convert(s.rates, amount, currency.Base, planCurrency, start)
+		if err != nil {
+			return 0
+		}
+		return converted
+	}
+	activityCost := toPlan(costs.activity)
+
+	var warnings []string
+	totalBudget := req.Budget
+	if totalBudget == 0 {
+		totalBudget = currency.Round(toPlan(costs.daily) * float64(days))
+	} else if usual := toPlan(costs.daily); usual*float64(days) > totalBudget {
+		warnings = append(warnings, fmt.Sprintf("Travelers usually spend about %.2f %s per day here, your budget allows %.2f %s.",
+			usual, planCurrency, totalBudget/float64(days), planCurrency))
+	}
+	if costs.trips == 0 {
+		warnings = append(warnings, fmt.Sprintf("No public trips to %s yet, so cost estimates are limited.", destination))
+	}
+
+	// Aktiviteler sırayla günlere dağıtılır: en popülerler farklı günlere düşer
+	plan := make([]*pb.ItineraryDayPlan, days)
+	for i := range plan {
+		plan[i] = &pb.ItineraryDayPlan{Day: int32(i + 1), Date: start.AddDate(0, 0, i).Format("2006-01-02")}
+	}
+	for i, activity := range activities {
+		day := plan[i%days]
+		day.Items = append(day.Items, &pb.ItineraryItem{
+			Name:          activity.name,
+			Location:      activity.location,
+			EstimatedCost: currency.Round(activityCost),
+			Popularity:    int32(activity.popularity),
+		})
+	}
+
+	// Aktiviteler düşüldükten sonra kalan bütçe günlük giderlere eşit bölünür
+	activityTotal := activityCost * float64(len(activities))
+	dailyBase := 0.0
+	if activityTotal > totalBudget && totalBudget > 0 {
+		warnings = append(warnings, fmt.Sprintf("Planned activities are estimated at %.2f %s, over the budget of %.2f %s.",
+			activityTotal, planCurrency, totalBudget, planCurrency))
+	} else {
+		dailyBase = (totalBudget - activityTotal) / float64(days)
+	}
+
+	var estimatedTotal float64
+	for _, day := range plan {
+		day.EstimatedCost = currency.Round(dailyBase + activityCost*float64(len(day.Items)))
+		estimatedTotal += day.EstimatedCost
+	}
+
+	response := &pb.ItineraryResponse{
+		Destination:    destination,
+		Currency:       planCurrency,
+		TotalBudget:    totalBudget,
+		EstimatedTotal: currency.Round(estimatedTotal),
+		Days:           plan,
+		Warnings:       warnings,
+		Message:        fmt.Sprintf("Planned %d activities over %d days", len(activities), days),
+	}
+
+	if req.Save {
+		// Diğer gezi yazmaları gibi doğrulanır ve aktiviteler geocode edilir
+		input := itineraryTrip(req, response)
+		input.DestinationID = destinationID
+		trip, _, err := s.writeService.CreateTrip(ownerID, input)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		response.TripId = uint32(trip.ID)
+	}
+	return response, nil
+}
+
+// itineraryActivities - Gezilerdeki aktiviteler popülerliğe göre, ardından katalogdaki öne çıkanlar
+// Aynı aktivite bir gezide birden fazla kez geçse de bir kez sayılır
+func itineraryActivities(trips []models.Trip, highlights []string) []itineraryActivity {
+	byName := make(map[string]*itineraryActivity)
+	for _, trip := range trips {
+		seen := make(map[string]bool)
+		for _, a := range trip.Activities {
+			key := strings.ToLower(strings.TrimSpace(a.Name))
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			activity, exists := byName[key]
+			if !exists {
+				activity = &itineraryActivity{name: strings.TrimSpace(a.Name)}
+				byName[key] = activity
+			}
+			activity.popularity++
+			if activity.location == "" {
+				activity.location = a.Location
+			}
+		}
+	}
+
+	activities := make([]itineraryActivity, 0, len(byName)+len(highlights))
+	for _, activity := range byName {
+		activities = append(activities, *activity)
+	}
+	sort.Slice(activities, func(i, j int) bool {
+		if activities[i].popularity != activities[j].popularity {
+			return activities[i].popularity > activities[j].popularity
+		}
+		return activities[i].name < activities[j].name
+	})
+
+	for _, highlight := range highlights {
+		if _, exists := byName[strings.ToLower(highlight)]; !exists {
+			activities = append(activities, itineraryActivity{name: highlight})
+		}
+	}
+	return activities
+}
+
+// destinationCosts - Harcaması olan gezilerde harcamalar, olmayanlarda bütçe kullanılır
+func (s *RecommendationServer) destinationCosts(trips []models.Trip) destinationCosts {
+	var costs destinationCosts
+	var dailyTotal, activityTotal float64
+	var activitySamples int
+
+	for _, trip := range trips {
+		var spent, activitySpent float64
+		for _, expense := range trip.Expenses {
+			amount, _, err := currency.Convert(s.rates, expense.Amount, expense.Currency, currency.Base, expense.ExpenseDate)
+			if err != nil {
+				continue
+			}
+			spent += amount
+			if expense.Category == "activities" {
+				activitySpent += amount
+			}
+		}
+		if spent == 0 && trip.Budget > 0 {
+			if converted, _, err := currency.Convert(s.rates, trip.Budget, trip.Currency, currency.Base, trip.StartDate); err == nil {
+				spent = converted
+			}
+		}
+		if spent == 0 {
+			continue
+		}
+
+		tripDays := math.Max(1, math.Floor(trip.EndDate.Sub(trip.StartDate).Hours()/24)+1)
+		dailyTotal += spent / tripDays
+		costs.trips++
+
+		if activitySpent > 0 && len(trip.Activities) > 0 {
+			activityTotal += activitySpent / float64(len(trip.Activities))
+			activitySamples++
+		}
+	}
+
+	if costs.trips > 0 {
+		costs.daily = dailyTotal / float64(costs.trips)
+	}
+	if activitySamples > 0 {
+		costs.activity = activityTotal / float64(activitySamples)
+	}
+	return costs
+}
+
+// itineraryTrip - Planı aktiviteleriyle kaydedilecek geziye çevirir
+func itineraryTrip(req *pb.ItineraryRequest, plan *pb.ItineraryResponse) services.TripInput {
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		title = "Trip to " + plan.Destination
+	}
+
+	input := services.TripInput{
+		Title:       title,
+		Destination: plan.Destination,
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+		Description: fmt.Sprintf("Generated itinerary, estimated at %.2f %s", plan.EstimatedTotal, plan.Currency),
+		Budget:      plan.TotalBudget,
+		Currency:    plan.Currency,
+	}
+	for _, day := range plan.Days {
+		for _, item := range day.Items {
+			// Konumu bilinmeyen aktiviteler destinasyonda geocode edilir
+			activity := services.ActivityInput{Name: item.Name, Location: item.Location, Date: day.Date}
+			if activity.Location == "" {
+				activity.Location = plan.Destination
+			}
+			if item.EstimatedCost > 0 {
+				activity.Description = fmt.Sprintf("Estimated cost: %.2f %s", item.EstimatedCost, plan.Currency)
+			}
+			input.Activities = append(input.Activities, activity)
+		}
+	}
+	return input
+}
@@ -0,0 +1,230 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/models"
+	"travel-platform/internal/recommender"
+	pb "travel-platform/proto"
+)
+
+// activityStopWords - Aktivite adlarında tercih belirtmeyen kelimeler
+var activityStopWords = map[string]bool{"with": true, "from": true, "into": true, "visit": true, "trip": true}
+
+// travelProfile - Kullanıcının geçmiş gezilerinden çıkarılan tercihler
+type travelProfile struct {
+	trips      int
+	visited    map[string]string // yer anahtarı -> geçmiş gezinin destinasyonu
+	countries  map[string]string // ülke (küçük harf) -> o ülkedeki geçmiş gezinin destinasyonu
+	avgBudget  float64           // EUR, bütçeli gezi yoksa 0
+	activities map[string]bool   // aktivite adlarından anahtar kelimeler
+	season     string            // En çok gezilen mevsim
+	items      []string          // İşbirlikçi filtreleme için destinasyon ve aktivite öğeleri
+}
+
+// place - Destinasyonun karşılaştırma anahtarı (küçük harf) ve ülkesi
+type place struct {
+	key     string
+	country string
+}
+
+// tripHistory - İstekte geçmiş verilmediyse kullanıcının gezilerinden oluşturur
+// Gizli geziler sadece kullanıcı kendi token'ıyla istediğinde kullanılır; aksi halde sadece public geziler
+func (s *RecommendationServer) tripHistory(ctx context.Context, req *pb.RecommendationRequest) (*pb.UserTripHistory, error) {
+	if req.History != nil && len(req.History.PastTrips) > 0 {
+		return req.History, nil
+	}
+
+	trips, err := s.tripService.GetTripByUserID(uint(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	callerID, authenticated := UserIDFromContext(ctx)
+	own := authenticated && callerID == uint(req.UserId)
+
+	history := &pb.UserTripHistory{UserId: req.UserId}
+	for _, trip := range trips {
+		if !own && !trip.IsPublic {
+			continue
+		}
+		history.PastTrips = append(history.PastTrips, tripInfo(trip))
+	}
+	return history, nil
+}
+
+// tripInfo - Veritabanındaki geziyi proto mesajına çevirir
+func tripInfo(trip models.Trip) *pb.TripInfo {
+	info := &pb.TripInfo{
+		TripId:      uint32(trip.ID),
+		Destination: trip.Destination,
+		Budget:      trip.Budget,
+		Currency:    trip.Currency,
+		StartDate:   trip.StartDate.Format("2006-01-02"),
+		EndDate:     trip.EndDate.Format("2006-01-02"),
+	}
+	for _, activity := range trip.Activities {
+		info.Activities = append(info.Activities, activity.Name)
+	}
+	return info
+}
+
+// buildProfile - Destinasyonlar, EUR bütçe ortalaması, aktivite kelimeleri ve mevsimlerden profil çıkarır
+func (s *RecommendationServer) buildProfile(history *pb.UserTripHistory) *travelProfile {
+	profile := &travelProfile{
+		visited:    make(map[string]string),
+		countries:  make(map[string]string),
+		activities: make(map[string]bool),
+	}
+	if history == nil {
+		return profile
+	}
+
+	var budgetTotal float64
+	var budgetCount int
+	seasons := make(map[string]int)
+
+	for _, trip := range history.PastTrips {
+		if strings.TrimSpace(trip.Destination) == "" {
+			continue
+		}
+		profile.trips++
+
+		p := s.resolvePlace(trip.Destination)
+		if _, exists := profile.visited[p.key]; !exists {
+			profile.visited[p.key] = trip.Destination
+			profile.items = append(profile.items, recommender.DestinationItem(p.key))
+		}
+		country := strings.ToLower(p.country)
+		if _, exists := profile.countries[country]; country != "" && !exists {
+			profile.countries[country] = trip.Destination
+		}
+
+		start, err := time.Parse("2006-01-02", trip.StartDate)
+		if err == nil {
+			seasons[recommender.SeasonOf(start.Month())]++
+		} else {
+			start = time.Now()
+		}
+
+		if trip.Budget > 0 {
+			from := trip.Currency
+			if from == "" {
+				from = currency.Base
+			}
+			if budget, _, err := currency.Convert(s.rates, trip.Budget, from, currency.Base, start); err == nil {
+				budgetTotal += budget
+				budgetCount++
+			}
+		}
+
+		for _, activity := range trip.Activities {
+			for _, keyword := range activityKeywords(activity) {
+				if !profile.activities[keyword] {
+					profile.activities[keyword] = true
+					profile.items = append(profile.items, recommender.ActivityItem(keyword))
+				}
+			}
+		}
+	}
+
+	if budgetCount > 0 {
+		profile.avgBudget = budgetTotal / float64(budgetCount)
+	}
+	for _, season := range recommender.Seasons { // Eşit sayıda gezide sabit sıra
+		if seasons[season] > seasons[profile.season] {
+			profile.season = season
+		}
+	}
+	return profile
+}
+
+// resolvePlace - Gazetteer'da bulunan yer kanonik adıyla, bulunmayan "Şehir, Ülke" parçalarıyla eşleşir
+func (s *RecommendationServer) resolvePlace(destination string) place {
+	if s.places != nil {
+		if loc, err := s.places.Geocode(destination); err == nil {
+			return place{key: strings.ToLower(loc.Name), country: loc.Country}
+		}
+	}
+
+	parts := strings.Split(destination, ",")
+	p := place{key: strings.ToLower(strings.TrimSpace(parts[0]))}
+	if len(parts) > 1 {
+		p.country = strings.TrimSpace(parts[len(parts)-1])
+	}
+	return p
+}
+
+// personalize - Profile göre puan ve neden ekler; daha önce gidilmiş yerler için false döner
+func (s *RecommendationServer) personalize(profile *travelProfile, rec *pb.Recommendation) bool {
+	if profile == nil || profile.trips == 0 {
+		return true
+	}
+
+	p := s.resolvePlace(rec.Destination)
+	if _, visited := profile.visited[p.key]; visited {
+		return false
+	}
+
+	bonus := 0.0
+	if past, exists := profile.countries[strings.ToLower(p.country)]; p.country != "" && exists {
+		bonus += 10
+		rec.Reasons = append(rec.Reasons, fmt.Sprintf("In %s, like your trip to %s", p.country, past))
+	}
+
+	if profile.avgBudget > 0 && math.Abs(rec.EstimatedBudget-profile.avgBudget) <= profile.avgBudget*0.25 {
+		bonus += 10
+		rec.Reasons = append(rec.Reasons, fmt.Sprintf("Close to your usual budget of %.0f %s", profile.avgBudget, currency.Base))
+	}
+
+	var shared []string
+	for _, activity := range rec.SuggestedActivities {
+		for _, keyword := range activityKeywords(activity) {
+			if profile.activities[keyword] && !containsString(shared, keyword) {
+				shared = append(shared, keyword)
+			}
+		}
+	}
+	if len(shared) > 0 {
+		sort.Strings(shared)
+		bonus += math.Min(float64(len(shared))*3, 10)
+		rec.Reasons = append(rec.Reasons, fmt.Sprintf("Activities you enjoyed before: %s", strings.Join(shared, ", ")))
+	}
+
+	if profile.season != "" && strings.Contains(rec.BestSeason, profile.season) {
+		bonus += 5
+		rec.Reasons = append(rec.Reasons, fmt.Sprintf("Best in %s, when you usually travel", profile.season))
+	}
+
+	rec.MatchScore = math.Min(rec.MatchScore+bonus, 100.0)
+	return true
+}
+
+// activityKeywords - "Louvre Museum Tour" -> [louvre, museum, tour]; çoğul eki atılır
+func activityKeywords(activity string) []string {
+	var keywords []string
+	words := strings.FieldsFunc(strings.ToLower(activity), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r > 127)
+	})
+	for _, word := range words {
+		word = strings.TrimSuffix(word, "s")
+		if len([]rune(word)) < 4 || activityStopWords[word] {
+			continue
+		}
+		keywords = append(keywords, word)
+	}
+	return keywords
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -4,7 +4,14 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
 	"strings"
+	"sync"
+	"time"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/geo"
+	"travel-platform/internal/models"
+	"travel-platform/internal/recommender"
 	"travel-platform/internal/services"
 	pb "travel-platform/proto"
 
@@ -14,12 +21,26 @@ import (
 
 type RecommendationServer struct {
 	pb.UnimplementedRecommendationServiceServer
-	tripService services.TripService // 👈 Ekle
+	tripService  services.TripService        // 👈 Ekle
+	writeService services.TripWriteService   // Üretilen planı gezi olarak kaydetmek için
+	categories   services.CategoryService    // Bütçe analizindeki ideal oranlar ve öneriler
+	rates        currency.RateProvider       // Farklı para birimindeki harcama ve bütçeleri çevirmek için
+	destinations services.DestinationService // Öne çıkan destinasyonlar ve yer eşleştirme
+	places       geo.Geocoder                // Kişisel önerilerde şehir ve ülke eşleştirmesi için
+
+	mu         sync.RWMutex
+	model      *recommender.Model // RefreshModel ile arka planda yenilenir
+	evaluation recommender.Evaluation
 }
 
-func NewRecommendationServer(tripService services.TripService) *RecommendationServer {
+func NewRecommendationServer(tripService services.TripService, writeService services.TripWriteService, categories services.CategoryService, destinations services.DestinationService, rates currency.RateProvider) *RecommendationServer {
 	return &RecommendationServer{
-		tripService: tripService, // 👈 Ekle
+		tripService:  tripService, // 👈 Ekle
+		writeService: writeService,
+		categories:   categories,
+		destinations: destinations,
+		rates:        rates,
+		places:       destinations, // "paris", "Paris, France" ve "Pariss" aynı yer
 	}
 }
 
@@ -28,11 +49,16 @@ func (s *RecommendationServer) GetRecommendations(
 	req *pb.RecommendationRequest,
 ) (*pb.RecommendationResponse, error) {
 
-	if req.UserId == 0 {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	if err := validateRecommendationRequest(req); err != nil {
+		return nil, err
 	}
 
-	recommendations := s.generateRecommendations(req)
+	// Kullanıcının geçmiş gezileri (istekte yoksa veritabanından) öneriyi kişiselleştirir
+	history, err := s.tripHistory(ctx, req)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	recommendations := s.generateRecommendations(req, s.buildProfile(history))
 
 	return &pb.RecommendationResponse{
 		Recommendations: recommendations,
@@ -40,11 +66,48 @@ func (s *RecommendationServer) GetRecommendations(
 	}, nil
 }
 
+// validateRecommendationRequest - Unary, stream ve refine önerilerinde ortak kontroller
+func validateRecommendationRequest(req *pb.RecommendationRequest) error {
+	if req.UserId == 0 {
+		return status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.TravelMonth < 0 || req.TravelMonth > 12 {
+		return status.Error(codes.InvalidArgument, "travel_month must be between 1 and 12")
+	}
+	return nil
+}
+
 func (s *RecommendationServer) AnalyzeBudget(
 	ctx context.Context,
 	req *pb.BudgetAnalysisRequest,
 ) (*pb.BudgetAnalysisResponse, error) {
 
+	analysis, err := s.startBudgetAnalysis(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, expense := range req.Expenses {
+		if err := s.addExpense(analysis, expense); err != nil {
+			return nil, err
+		}
+	}
+	return s.finishBudgetAnalysis(analysis)
+}
+
+// budgetAnalysis - Harcamalar geldikçe toplanır; AnalyzeBudget ve AnalyzeExpensesStream ortak kullanır
+type budgetAnalysis struct {
+	req             *pb.BudgetAnalysisRequest // Gezi ve bütçe bilgisi; expenses burada okunmaz
+	currency        string
+	categories      []models.ExpenseCategory
+	categoryBudgets map[string]float64
+	totalSpent      float64
+	categoryTotals  map[string]float64
+	spends          []services.Spend
+	expenses        int // Eklenen harcama sayısı (hata mesajlarındaki index)
+}
+
+// startBudgetAnalysis - Gezi ve bütçe bilgisini doğrular
+func (s *RecommendationServer) startBudgetAnalysis(ctx context.Context, req *pb.BudgetAnalysisRequest) (*budgetAnalysis, error) {
 	if req.TripId == 0 {
 		return nil, status.Error(codes.InvalidArgument, "trip_id is required")
 	}
@@ -53,67 +116,251 @@ func (s *RecommendationServer) AnalyzeBudget(
 		return nil, status.Error(codes.InvalidArgument, "total_budget must be greater than 0")
 	}
 
-	var totalSpent float64
-	categoryTotals := make(map[string]float64)
+	// Tüm tutarlar bütçenin para birimine çevrilerek toplanır
+	budgetCurrency := currency.Normalize(req.Currency)
+	if !currency.IsValidCode(budgetCurrency) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid currency %q", req.Currency)
+	}
 
-	for _, expense := range req.Expenses {
-		totalSpent += expense.Amount
-		categoryTotals[expense.Category] += expense.Amount
+	// Sistem kategorileri ve token varsa sahibinin özel kategorileri; token yoksa user_id yok sayılır
+	var userID uint
+	if callerID, ok := UserIDFromContext(ctx); ok {
+		if req.UserId != 0 && uint(req.UserId) != callerID {
+			return nil, status.Error(codes.PermissionDenied, "user_id does not match the authorization token")
+		}
+		userID = callerID
+	}
+	categories, err := s.categories.GetCategories(userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	categoryBudgets := make(map[string]float64, len(req.CategoryBudgets))
+	for _, budget := range req.CategoryBudgets {
+		if budget.Amount < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "budget for %s must not be negative", budget.Category)
+		}
+		categoryBudgets[budget.Category] = budget.Amount
+	}
+
+	return &budgetAnalysis{
+		req:             req,
+		currency:        budgetCurrency,
+		categories:      categories,
+		categoryBudgets: categoryBudgets,
+		categoryTotals:  make(map[string]float64),
+	}, nil
+}
+
+// addExpense - Harcamayı bütçe para birimine çevirip toplamlara ekler
+func (s *RecommendationServer) addExpense(analysis *budgetAnalysis, expense *pb.Expense) error {
+	i := analysis.expenses
+	analysis.expenses++
+
+	amount, err := s.convertExpense(expense, analysis.currency)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "expenses[%d]: %v", i, err)
+	}
+
+	// Tarihsiz harcama harcanmış sayılır ama günlük hıza girmez
+	var date time.Time
+	if expense.ExpenseDate != "" {
+		if date, err = time.Parse("2006-01-02", expense.ExpenseDate); err != nil {
+			return status.Errorf(codes.InvalidArgument, "expenses[%d]: invalid expense_date %q, use YYYY-MM-DD", i, expense.ExpenseDate)
+		}
+	}
+
+	analysis.totalSpent += amount
+	analysis.categoryTotals[expense.Category] += amount
+	analysis.spends = append(analysis.spends, services.Spend{Category: expense.Category, Date: date, Amount: amount})
+	return nil
+}
+
+// finishBudgetAnalysis - Kategori durumları, tahmin, uyarı ve önerilerle yanıtı oluşturur
+func (s *RecommendationServer) finishBudgetAnalysis(analysis *budgetAnalysis) (*pb.BudgetAnalysisResponse, error) {
+	req := analysis.req
+	categories := analysis.categories
+	categoryBudgets := analysis.categoryBudgets
+	categoryTotals := analysis.categoryTotals
+	totalSpent := currency.Round(analysis.totalSpent)
+
+	// Gezi tarihleri verildiyse günlük harcama hızından gezi sonu tahmini
+	forecast, err := s.forecast(req, categoryBudgets, analysis.spends)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	categoryForecasts := make(map[string]services.CategoryForecast)
+	if forecast != nil {
+		for _, c := range forecast.Categories {
+			categoryForecasts[c.Category] = c
+		}
 	}
 
 	var categoryBreakdown []*pb.CategoryAnalysis
 	for category, amount := range categoryTotals {
 		percentage := (amount / totalSpent) * 100
-		status := s.getCategoryStatus(category, percentage)
+		analysis := &pb.CategoryAnalysis{
+			Category:         category,
+			TotalSpent:       amount,
+			Percentage:       percentage,
+			Budget:           categoryBudgets[category],
+			Projected:        amount,
+			DaysOfBudgetLeft: -1,
+		}
+		if c, exists := categoryForecasts[category]; exists {
+			analysis.Projected = c.Projected
+			analysis.DaysOfBudgetLeft = c.DaysOfBudgetLeft
+		}
 
-		categoryBreakdown = append(categoryBreakdown, &pb.CategoryAnalysis{
-			Category:   category,
-			TotalSpent: amount,
-			Percentage: percentage,
-			Status:     status,
-		})
+		if analysis.Budget > 0 {
+			analysis.Status = s.getCategoryBudgetStatus(analysis.Budget, amount, analysis.Projected)
+		} else {
+			analysis.Status = s.getCategoryStatus(categories, category, percentage)
+		}
+		categoryBreakdown = append(categoryBreakdown, analysis)
 	}
 
 	warnings := s.generateWarnings(req.TotalBudget, totalSpent)
-	suggestions := s.generateSuggestions(categoryTotals, totalSpent)
+	warnings = append(warnings, s.generateForecastWarnings(req.TotalBudget, categoryBudgets, categoryTotals, forecast)...)
+	suggestions := s.generateSuggestions(categories, categoryTotals, totalSpent)
 
-	return &pb.BudgetAnalysisResponse{
+	response := &pb.BudgetAnalysisResponse{
 		TotalBudget:       req.TotalBudget,
 		TotalSpent:        totalSpent,
 		Remaining:         req.TotalBudget - totalSpent,
 		CategoryBreakdown: categoryBreakdown,
 		Warnings:          warnings,
 		Suggestions:       suggestions,
-	}, nil
+		Currency:          analysis.currency,
+	}
+	if forecast != nil {
+		response.Forecast = &pb.BudgetForecast{
+			TripDays:         int32(forecast.TripDays),
+			DaysElapsed:      int32(forecast.DaysElapsed),
+			DaysRemaining:    int32(forecast.DaysRemaining),
+			DailyBudget:      forecast.DailyBudget,
+			DailyBurn:        forecast.DailyBurn,
+			ProjectedTotal:   forecast.Projected,
+			DaysOfBudgetLeft: forecast.DaysOfBudgetLeft,
+		}
+		for _, d := range forecast.Daily {
+			response.Forecast.Daily = append(response.Forecast.Daily, &pb.DailySpend{Date: d.Date, Amount: d.Amount})
+		}
+	}
+	return response, nil
 }
 
-func (s *RecommendationServer) generateRecommendations(req *pb.RecommendationRequest) []*pb.Recommendation {
-	var recommendations []*pb.Recommendation
+// forecast - start_date ve end_date verilmediyse nil döner
+func (s *RecommendationServer) forecast(req *pb.BudgetAnalysisRequest, categoryBudgets map[string]float64, spends []services.Spend) (*services.BudgetForecast, error) {
+	if req.StartDate == "" && req.EndDate == "" {
+		return nil, nil
+	}
+
+	dates := map[string]string{"start_date": req.StartDate, "end_date": req.EndDate, "today": req.Today}
+	parsed := make(map[string]time.Time, len(dates))
+	for name, value := range dates {
+		if value == "" {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q, use YYYY-MM-DD", name, value)
+		}
+		parsed[name] = date
+	}
+	if parsed["start_date"].IsZero() || parsed["end_date"].IsZero() {
+		return nil, fmt.Errorf("start_date and end_date must be given together")
+	}
+	if parsed["end_date"].Before(parsed["start_date"]) {
+		return nil, fmt.Errorf("end_date must be after start_date")
+	}
+	today, exists := parsed["today"]
+	if !exists {
+		today = time.Now()
+	}
+
+	forecast := services.ForecastBudget(parsed["start_date"], parsed["end_date"], today, req.TotalBudget, categoryBudgets, spends)
+	return &forecast, nil
+}
+
+// convertExpense - Yakalanmış kur varsa onu, yoksa harcama tarihindeki kuru kullanır
+func (s *RecommendationServer) convertExpense(expense *pb.Expense, to string) (float64, error) {
+	from := currency.Normalize(expense.Currency)
+	if from == to {
+		return expense.Amount, nil
+	}
+	if expense.ExchangeRate > 0 {
+		return currency.Round(expense.Amount * expense.ExchangeRate), nil
+	}
+
+	date := time.Now()
+	if expense.ExpenseDate != "" {
+		parsed, err := time.Parse("2006-01-02", expense.ExpenseDate)
+		if err != nil {
+			return 0, fmt.Errorf("invalid expense_date %q, use YYYY-MM-DD", expense.ExpenseDate)
+		}
+		date = parsed
+	}
+
+	amount, _, err := currency.Convert(s.rates, expense.Amount, from, to, date)
+	return amount, err
+}
+
+func (s *RecommendationServer) generateRecommendations(req *pb.RecommendationRequest, profile *travelProfile) []*pb.Recommendation {
+	var community, catalog []*pb.Recommendation
+	s.scoreRecommendations(req, profile, func(rec *pb.Recommendation, fromCatalog bool) error {
+		if fromCatalog {
+			catalog = append(catalog, rec)
+		} else {
+			community = append(community, rec)
+		}
+		return nil
+	})
+
+	// Match score'a göre sırala (en yüksek önce); katalog önerileri topluluk önerilerinin arkasına gelir
+	s.sortRecommendationsByScore(community)
+	return append(community, catalog...)
+}
+
+// scoreRecommendations - Her öneri puanlanır puanlanmaz emit'e verilir; emit hata dönerse durur
+// Katalogdan gelen öneriler fromCatalog ile işaretlenir ve kendi aralarında sıralı gelir
+func (s *RecommendationServer) scoreRecommendations(req *pb.RecommendationRequest, profile *travelProfile, emit func(rec *pb.Recommendation, fromCatalog bool) error) error {
+	model := s.currentModel()
 
 	// 1️⃣ VERİTABANINDAN TÜM PUBLIC TRİPLERİ AL
 	allTrips, err := s.tripService.GetPublicTrips()
 	if err != nil || len(allTrips) == 0 {
 		// Veritabanında trip yoksa, fallback olarak statik destinasyonları kullan
-		return s.generateStaticRecommendations(req)
+		return s.emitStaticRecommendations(req, profile, model, nil, emit)
 	}
 
-	// 2️⃣ TRİPLERİ DESTİNASYONA GÖRE GRUPLA
+	// 2️⃣ TRİPLERİ DESTİNASYONA GÖRE GRUPLA ("Rome" ve "Rome, Italy" aynı yer)
 	destinationMap := make(map[string]*destinationInfo)
 
 	for _, trip := range allTrips {
-		dest := trip.Destination
+		dest := s.resolvePlace(trip.Destination).key
 
 		if _, exists := destinationMap[dest]; !exists {
 			destinationMap[dest] = &destinationInfo{
-				destination: dest,
+				destination: trip.Destination,
 				budgets:     []float64{},
 				activities:  make(map[string]bool),
 			}
 		}
 
-		// Bütçe ekle
+		// Bütçe ekle (max_budget EUR olduğu için bütçeler EUR'ya çevrilir)
+		var budget float64
 		if trip.Budget > 0 {
-			destinationMap[dest].budgets = append(destinationMap[dest].budgets, trip.Budget)
+			converted, _, err := currency.Convert(s.rates, trip.Budget, trip.Currency, currency.Base, trip.StartDate)
+			if err == nil {
+				budget = converted
+				destinationMap[dest].budgets = append(destinationMap[dest].budgets, budget)
+			}
+		}
+
+		// Başlangıç ayı en iyi mevsimin çıkarımında kullanılır
+		if !trip.StartDate.IsZero() {
+			destinationMap[dest].seasons = append(destinationMap[dest].seasons, recommender.SeasonSample{Month: trip.StartDate.Month(), Budget: budget})
 		}
 
 		// Aktiviteleri ekle
@@ -123,7 +370,10 @@ func (s *RecommendationServer) generateRecommendations(req *pb.RecommendationReq
 	}
 
 	// 3️⃣ HER DESTİNASYON İÇİN ÖNERİ OLUŞTUR
-	for dest, info := range destinationMap {
+	listed := make(map[string]bool, len(destinationMap))
+	for key, info := range destinationMap {
+		dest := info.destination
+
 		// Ortalama bütçe hesapla
 		avgBudget := s.calculateAverageBudget(info.budgets)
 
@@ -132,39 +382,66 @@ func (s *RecommendationServer) generateRecommendations(req *pb.RecommendationReq
 			continue
 		}
 
+		// Seyahat ayı filtreleme
+		season := s.guessBestSeason(dest, info.seasons)
+		if req.TravelMonth > 0 && !season.Fits(time.Month(req.TravelMonth)) {
+			continue
+		}
+
 		// Aktiviteleri listeye çevir
 		var activityList []string
 		for activity := range info.activities {
 			activityList = append(activityList, activity)
-			if len(activityList) >= 5 { // Max 5 aktivite göster
-				break
-			}
+		}
+		sort.Strings(activityList)
+		if len(activityList) > 5 { // Max 5 aktivite göster
+			activityList = activityList[:5]
 		}
 
 		// Match score hesapla
 		matchScore := s.calculateMatchScore(avgBudget, req.MaxBudget, req.PreferredDestination, dest)
 
 		// Öneri oluştur
-		recommendations = append(recommendations, &pb.Recommendation{
+		rec := &pb.Recommendation{
 			Destination:         dest,
 			Description:         s.generateDescription(dest, len(info.budgets)),
 			EstimatedBudget:     avgBudget,
 			SuggestedActivities: activityList,
-			BestSeason:          s.guessBestSeason(dest),
+			BestSeason:          season.Season,
 			MatchScore:          matchScore,
-		})
+			Reasons:             s.baseReasons(req, dest, season),
+		}
+		if !s.personalize(profile, rec) {
+			continue // Kullanıcı buraya zaten gitti
+		}
+		s.blendCollaborative(model, profile, rec)
+		if err := emit(rec, false); err != nil {
+			return err
+		}
+		listed[key] = true
 	}
 
-	// Match score'a göre sırala (en yüksek önce)
-	s.sortRecommendationsByScore(recommendations)
-
 	// Eğer veritabanından yeterli öneri bulunamadıysa, statik olanları ekle
-	if len(recommendations) < 3 {
-		staticRecs := s.generateStaticRecommendations(req)
-		recommendations = append(recommendations, staticRecs...)
+	if len(listed) < 3 {
+		return s.emitStaticRecommendations(req, profile, model, listed, emit)
 	}
+	return nil
+}
 
-	return recommendations
+// emitStaticRecommendations - Katalog önerileri skora göre sıralı; topluluk gezilerinden zaten önerilen yerler tekrar eklenmez
+func (s *RecommendationServer) emitStaticRecommendations(req *pb.RecommendationRequest, profile *travelProfile, model *recommender.Model, listed map[string]bool, emit func(rec *pb.Recommendation, fromCatalog bool) error) error {
+	staticRecs := s.generateStaticRecommendations(req, profile, model)
+	s.sortRecommendationsByScore(staticRecs)
+
+	for _, rec := range staticRecs {
+		if listed[s.resolvePlace(rec.Destination).key] {
+			continue
+		}
+		if err := emit(rec, true); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // 🆕 Yardımcı struct
@@ -172,6 +449,7 @@ type destinationInfo struct {
 	destination string
 	budgets     []float64
 	activities  map[string]bool
+	seasons     []recommender.SeasonSample
 }
 
 // 🆕 Ortalama bütçe hesaplama
@@ -192,10 +470,9 @@ func (s *RecommendationServer) generateDescription(destination string, tripCount
 	return fmt.Sprintf("Popular destination with %d trips planned by our community", tripCount)
 }
 
-// 🆕 Mevsim tahmini (şimdilik basit)
-func (s *RecommendationServer) guessBestSeason(destination string) string {
-	// Gelişmiş bir sistemde, geçmiş triplerin tarihlerine bakabilirsiniz
-	return "All Year"
+// guessBestSeason - Public gezilerin başlangıç aylarından, yeterli gezi yoksa iklim verisinden
+func (s *RecommendationServer) guessBestSeason(destination string, samples []recommender.SeasonSample) recommender.SeasonInfo {
+	return recommender.BestSeason(s.resolvePlace(destination).key, samples)
 }
 
 // 🆕 Önerileri sırala
@@ -210,60 +487,67 @@ func (s *RecommendationServer) sortRecommendationsByScore(recommendations []*pb.
 	}
 }
 
-// 🆕 Statik öneriler (fallback)
-func (s *RecommendationServer) generateStaticRecommendations(req *pb.RecommendationRequest) []*pb.Recommendation {
-	staticDestinations := []struct {
-		name        string
-		description string
-		budget      float64
-		activities  []string
-		season      string
-	}{
-		{
-			name:        "Paris, France",
-			description: "City of lights, perfect for romantic getaways",
-			budget:      1500,
-			activities:  []string{"Eiffel Tower", "Louvre Museum", "Seine River Cruise"},
-			season:      "Spring/Fall",
-		},
-		{
-			name:        "Istanbul, Turkey",
-			description: "Historic city where East meets West",
-			budget:      900,
-			activities:  []string{"Hagia Sophia", "Bosphorus Cruise", "Grand Bazaar"},
-			season:      "Spring/Fall",
-		},
-		{
-			name:        "Barcelona, Spain",
-			description: "Mediterranean paradise with stunning architecture",
-			budget:      1200,
-			activities:  []string{"Sagrada Familia", "Park Güell", "Beach Time"},
-			season:      "Summer",
-		},
+// Statik öneriler (fallback) - katalogda öne çıkan destinasyonlar
+func (s *RecommendationServer) generateStaticRecommendations(req *pb.RecommendationRequest, profile *travelProfile, model *recommender.Model) []*pb.Recommendation {
+	// Katalogdaki öne çıkan destinasyonlar
+	featured, err := s.destinations.GetFeatured()
+	if err != nil {
+		return nil
 	}
 
 	var recommendations []*pb.Recommendation
 
-	for _, dest := range staticDestinations {
-		if req.MaxBudget > 0 && dest.budget > req.MaxBudget {
+	for _, destination := range featured {
+		name := destination.DisplayName()
+		if req.MaxBudget > 0 && destination.EstimatedBudget > req.MaxBudget {
 			continue
 		}
 
-		matchScore := s.calculateMatchScore(dest.budget, req.MaxBudget, req.PreferredDestination, dest.name)
+		season := s.guessBestSeason(name, nil)
+		if req.TravelMonth > 0 && !season.Fits(time.Month(req.TravelMonth)) {
+			continue
+		}
 
-		recommendations = append(recommendations, &pb.Recommendation{
-			Destination:         dest.name,
-			Description:         dest.description,
-			EstimatedBudget:     dest.budget,
-			SuggestedActivities: dest.activities,
-			BestSeason:          dest.season,
+		matchScore := s.calculateMatchScore(destination.EstimatedBudget, req.MaxBudget, req.PreferredDestination, name)
+
+		rec := &pb.Recommendation{
+			Destination:         name,
+			Description:         destination.Description,
+			EstimatedBudget:     destination.EstimatedBudget,
+			SuggestedActivities: destination.HighlightList(),
+			BestSeason:          season.Season,
 			MatchScore:          matchScore,
-		})
+			Reasons:             s.baseReasons(req, name, season),
+		}
+		if !s.personalize(profile, rec) {
+			continue
+		}
+		s.blendCollaborative(model, profile, rec)
+		recommendations = append(recommendations, rec)
 	}
 
 	return recommendations
 }
 
+// baseReasons - Kişisel geçmişten bağımsız eşleşme nedenleri (bütçe, tercih edilen destinasyon ve seyahat ayı)
+func (s *RecommendationServer) baseReasons(req *pb.RecommendationRequest, destination string, season recommender.SeasonInfo) []string {
+	var reasons []string
+	if req.MaxBudget > 0 {
+		reasons = append(reasons, fmt.Sprintf("Fits your budget of %.0f %s", req.MaxBudget, currency.Base))
+	}
+	if req.PreferredDestination != "" && s.calculateDestinationMatch(req.PreferredDestination, destination) > 0 {
+		reasons = append(reasons, fmt.Sprintf("Matches your search for %q", req.PreferredDestination))
+	}
+	if req.TravelMonth > 0 && season.Source != "" {
+		reason := fmt.Sprintf("Good time to visit in %s", time.Month(req.TravelMonth))
+		if season.Source == recommender.SeasonFromTrips {
+			reason += fmt.Sprintf(" (based on %d trips)", season.Trips)
+		}
+		reasons = append(reasons, reason)
+	}
+	return reasons
+}
+
 func (s *RecommendationServer) calculateMatchScore(destBudget, maxBudget float64, preferredDest, actualDest string) float64 {
 	score := 50.0
 
@@ -339,24 +623,19 @@ func (s *RecommendationServer) calculateSimilarity(s1, s2 string) float64 {
 	return float64(matchCount) / totalWords
 }
 
-func (s *RecommendationServer) getCategoryStatus(category string, percentage float64) string {
-	idealRanges := map[string][2]float64{
-		"accommodation": {30, 40},
-		"food":          {25, 35},
-		"transport":     {15, 25},
-		"activities":    {15, 25},
+// getCategoryStatus - Kategorinin payını katalogdaki ideal aralıkla karşılaştırır; aralığı olmayan kategori "good"
+func (s *RecommendationServer) getCategoryStatus(categories []models.ExpenseCategory, category string, percentage float64) string {
+	rule, exists := services.FindCategory(categories, category)
+	if !exists || rule.IdealMax == 0 {
+		return "good"
 	}
 
-	if ranges, exists := idealRanges[category]; exists {
-		if percentage < ranges[0] {
-			return "good"
-		} else if percentage <= ranges[1] {
-			return "optimal"
-		} else {
-			return "warning"
-		}
+	if percentage < rule.IdealMin {
+		return "good"
+	} else if percentage <= rule.IdealMax {
+		return "optimal"
 	}
-	return "good"
+	return "warning"
 }
 
 func (s *RecommendationServer) generateWarnings(totalBudget, totalSpent float64) []string {
@@ -375,20 +654,78 @@ func (s *RecommendationServer) generateWarnings(totalBudget, totalSpent float64)
 	return warnings
 }
 
-func (s *RecommendationServer) generateSuggestions(categoryTotals map[string]float64, totalSpent float64) []string {
-	var suggestions []string
+// getCategoryBudgetStatus - Bütçesi olan kategoride durum sabit oranlar yerine bütçeye göre belirlenir
+func (s *RecommendationServer) getCategoryBudgetStatus(budget, spent, projected float64) string {
+	switch services.CategoryBudgetStatus(budget, spent, projected) {
+	case services.BudgetExceeded:
+		return "exceeded"
+	case services.BudgetAtRisk:
+		return "warning"
+	}
+	return "good"
+}
 
-	for category, amount := range categoryTotals {
-		percentage := (amount / totalSpent) * 100
+// generateForecastWarnings - Tahmini aşım, bütçenin gezi bitmeden tükenmesi ve kategori bütçesi uyarıları
+func (s *RecommendationServer) generateForecastWarnings(totalBudget float64, categoryBudgets, categoryTotals map[string]float64, forecast *services.BudgetForecast) []string {
+	var warnings []string
+
+	if forecast != nil && forecast.DaysRemaining > 0 {
+		if forecast.Projected > totalBudget && forecast.Spent <= totalBudget {
+			warnings = append(warnings, fmt.Sprintf("📈 At %.2f per day you're on track to spend %.2f, over your budget of %.2f.",
+				forecast.DailyBurn, forecast.Projected, totalBudget))
+		}
+		if forecast.DaysOfBudgetLeft >= 0 && forecast.DaysOfBudgetLeft < float64(forecast.DaysRemaining) {
+			warnings = append(warnings, fmt.Sprintf("⏳ Your budget will last about %.1f more days, but %d days of the trip remain.",
+				forecast.DaysOfBudgetLeft, forecast.DaysRemaining))
+		}
+	}
+
+	categories := make([]string, 0, len(categoryBudgets))
+	for category := range categoryBudgets {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
 
-		if category == "food" && percentage > 35 {
-			suggestions = append(suggestions, "💡 Food expenses are high. Try local cuisine.")
+	for _, category := range categories {
+		budget := categoryBudgets[category]
+		if budget <= 0 {
+			continue
 		}
-		if category == "transport" && percentage > 30 {
-			suggestions = append(suggestions, "💡 Consider using public transportation.")
+		spent := categoryTotals[category]
+		if spent > budget {
+			warnings = append(warnings, fmt.Sprintf("🚨 %s spending (%.2f) is over its budget of %.2f.", category, spent, budget))
+			continue
+		}
+		if forecast == nil {
+			continue
+		}
+		for _, c := range forecast.Categories {
+			if c.Category == category && c.Status == services.BudgetAtRisk {
+				warnings = append(warnings, fmt.Sprintf("⚠️ %s is on track to reach %.2f, over its budget of %.2f.", category, c.Projected, budget))
+			}
+		}
+	}
+	return warnings
+}
+
+// generateSuggestions - Payı katalogdaki eşiği geçen kategoriler için önerileri döner
+func (s *RecommendationServer) generateSuggestions(categories []models.ExpenseCategory, categoryTotals map[string]float64, totalSpent float64) []string {
+	var suggestions []string
+
+	names := make([]string, 0, len(categoryTotals))
+	for category := range categoryTotals {
+		names = append(names, category)
+	}
+	sort.Strings(names)
+
+	for _, category := range names {
+		rule, exists := services.FindCategory(categories, category)
+		if !exists || rule.SuggestAbove == 0 {
+			continue
 		}
-		if category == "accommodation" && percentage > 45 {
-			suggestions = append(suggestions, "💡 Look for more affordable accommodation options.")
+		percentage := (categoryTotals[category] / totalSpent) * 100
+		if percentage > rule.SuggestAbove {
+			suggestions = append(suggestions, rule.Suggestion)
 		}
 	}
 
@@ -0,0 +1,134 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	pb "travel-platform/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamRecommendations - Öneriler puanlandıkça gönderilir; istemci ilk sonucu hepsini beklemeden alır
+// Topluluk önerileri sırasız gelir, katalog önerileri en sonda ve kendi aralarında sıralıdır
+func (s *RecommendationServer) StreamRecommendations(req *pb.RecommendationRequest, stream pb.RecommendationService_StreamRecommendationsServer) error {
+	if err := validateRecommendationRequest(req); err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	history, err := s.tripHistory(ctx, req)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return s.scoreRecommendations(req, s.buildProfile(history), func(rec *pb.Recommendation, fromCatalog bool) error {
+		// İstemci bağlantıyı kestiyse puanlamaya devam etme
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		return stream.Send(rec)
+	})
+}
+
+// AnalyzeExpensesStream - Harcamalar parça parça gelir ve geldikçe toplanır; tek mesaj boyut sınırına takılmaz
+// İlk mesaj gezi ve bütçe bilgisini taşır, sonraki mesajlardan sadece expenses okunur
+func (s *RecommendationServer) AnalyzeExpensesStream(stream pb.RecommendationService_AnalyzeExpensesStreamServer) error {
+	var analysis *budgetAnalysis
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if analysis == nil {
+			if analysis, err = s.startBudgetAnalysis(stream.Context(), chunk); err != nil {
+				return err
+			}
+		}
+		for _, expense := range chunk.Expenses {
+			if err := s.addExpense(analysis, expense); err != nil {
+				return err
+			}
+		}
+	}
+
+	if analysis == nil {
+		return status.Error(codes.InvalidArgument, "no budget analysis request received")
+	}
+	response, err := s.finishBudgetAnalysis(analysis)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(response)
+}
+
+// RefineRecommendations - Etkileşimli oturum: her mesaj tercihleri günceller ve yeni sıralama döner
+// Geçmiş gezilerden profil oturum başında bir kez oluşturulur; token'la açılan oturumda user_id verilmezse token'ın kullanıcısıdır
+func (s *RecommendationServer) RefineRecommendations(stream pb.RecommendationService_RefineRecommendationsServer) error {
+	var (
+		req     *pb.RecommendationRequest
+		profile *travelProfile
+	)
+	callerID, authenticated := UserIDFromContext(stream.Context())
+	for {
+		refine, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if req == nil {
+			req = &pb.RecommendationRequest{UserId: refine.UserId, History: refine.History}
+			if req.UserId == 0 && authenticated {
+				req.UserId = uint32(callerID)
+			}
+		} else if refine.UserId != 0 && refine.UserId != req.UserId {
+			return status.Error(codes.InvalidArgument, "user_id cannot change during a refine session")
+		}
+		applyRefinement(req, refine)
+
+		if err := validateRecommendationRequest(req); err != nil {
+			return err
+		}
+		if req.MaxBudget < 0 {
+			return status.Error(codes.InvalidArgument, "max_budget must not be negative")
+		}
+
+		if profile == nil {
+			history, err := s.tripHistory(stream.Context(), req)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			profile = s.buildProfile(history)
+		}
+
+		recommendations := s.generateRecommendations(req, profile)
+		err = stream.Send(&pb.RecommendationResponse{
+			Recommendations: recommendations,
+			Message:         fmt.Sprintf("Found %d recommendations for you!", len(recommendations)),
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// applyRefinement - Sadece mesajda verilen tercihler değişir
+func applyRefinement(req *pb.RecommendationRequest, refine *pb.RefineRequest) {
+	if refine.MaxBudget != nil {
+		req.MaxBudget = refine.GetMaxBudget()
+	}
+	if refine.PreferredDestination != nil {
+		req.PreferredDestination = refine.GetPreferredDestination()
+	}
+	if refine.TravelMonth != nil {
+		req.TravelMonth = refine.GetTravelMonth()
+	}
+}
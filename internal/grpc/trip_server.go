@@ -0,0 +1,373 @@
+package grpc
+
+import (
+	"context"
+	"time"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+	pb "travel-platform/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TripServer - HTTP gezi API'sinin gRPC karşılığı; yazma akışı ve sahiplik kuralları HTTP ile aynıdır
+type TripServer struct {
+	pb.UnimplementedTripServiceServer
+	tripService  services.TripService
+	writeService services.TripWriteService
+}
+
+func NewTripServer(tripService services.TripService, writeService services.TripWriteService) *TripServer {
+	return &TripServer{tripService: tripService, writeService: writeService}
+}
+
+// CreateTrip - Aktivite ve harcamalarla birlikte gezi oluşturur (🔒 Token)
+func (s *TripServer) CreateTrip(ctx context.Context, req *pb.CreateTripRequest) (*pb.Trip, error) {
+	userID, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Trip == nil {
+		return nil, status.Error(codes.InvalidArgument, "trip is required")
+	}
+
+	input := tripInput(req.Trip)
+	for _, activity := range req.Activities {
+		input.Activities = append(input.Activities, activityInput(activity))
+	}
+	for _, expense := range req.Expenses {
+		input.Expenses = append(input.Expenses, expenseInput(expense))
+	}
+
+	trip, _, err := s.writeService.CreateTrip(userID, input)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return tripMessage(trip), nil
+}
+
+// GetTrip - Public geziler herkese, özel geziler sadece sahibine açıktır
+func (s *TripServer) GetTrip(ctx context.Context, req *pb.TripRequest) (*pb.Trip, error) {
+	trip, err := s.tripService.GetTripByID(uint(req.TripId))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "trip not found")
+	}
+
+	if !trip.IsPublic {
+		userID, ok := UserIDFromContext(ctx)
+		if !ok || trip.UserID != userID {
+			// Başkasının özel gezisinin varlığı da gizlenir
+			return nil, status.Error(codes.NotFound, "trip not found")
+		}
+	}
+	return tripMessage(trip), nil
+}
+
+// ListMyTrips - Kullanıcının gezileri, statuses verilmişse filtrelenir (🔒 Token)
+func (s *TripServer) ListMyTrips(ctx context.Context, req *pb.ListTripsRequest) (*pb.TripList, error) {
+	userID, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var trips []models.Trip
+	if len(req.Statuses) > 0 {
+		if trips, err = s.tripService.GetTripsByStatus(userID, req.Statuses); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	} else if trips, err = s.tripService.GetTripByUserID(userID); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return tripList(trips), nil
+}
+
+// ListPublicTrips - Herkese açık geziler, destination verilmişse arama
+func (s *TripServer) ListPublicTrips(ctx context.Context, req *pb.ListPublicTripsRequest) (*pb.TripList, error) {
+	var trips []models.Trip
+	var err error
+	if req.Destination != "" {
+		trips, err = s.tripService.SearchByDestination(req.Destination)
+	} else {
+		trips, err = s.tripService.GetPublicTrips()
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return tripList(trips), nil
+}
+
+// UpdateTrip - Gezi alanlarını değiştirir; boş tarih ve para birimi korunur (🔒 Token + Ownership)
+func (s *TripServer) UpdateTrip(ctx context.Context, req *pb.UpdateTripRequest) (*pb.Trip, error) {
+	trip, userID, err := s.ownedTrip(ctx, req.TripId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Trip == nil {
+		return nil, status.Error(codes.InvalidArgument, "trip is required")
+	}
+
+	if _, err := s.writeService.UpdateTrip(trip, userID, tripInput(req.Trip)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return tripMessage(trip), nil
+}
+
+// DeleteTrip - Geziyi çöp kutusuna taşır (🔒 Token + Ownership)
+func (s *TripServer) DeleteTrip(ctx context.Context, req *pb.TripRequest) (*pb.MessageResponse, error) {
+	trip, userID, err := s.ownedTrip(ctx, req.TripId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.tripService.DeleteTrip(trip.ID, userID); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.MessageResponse{Message: "Trip moved to trash"}, nil
+}
+
+// AddActivity - Geziye aktivite ekler (🔒 Token + Ownership)
+func (s *TripServer) AddActivity(ctx context.Context, req *pb.ActivityRequest) (*pb.TripActivity, error) {
+	trip, userID, err := s.ownedTrip(ctx, req.TripId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Activity == nil {
+		return nil, status.Error(codes.InvalidArgument, "activity is required")
+	}
+
+	activity, err := s.writeService.AddActivity(trip, userID, activityInput(req.Activity))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return activityMessage(activity), nil
+}
+
+// UpdateActivity - Aktiviteyi günceller; boş tarih korunur (🔒 Token + Ownership)
+func (s *TripServer) UpdateActivity(ctx context.Context, req *pb.ActivityRequest) (*pb.TripActivity, error) {
+	trip, userID, err := s.ownedTrip(ctx, req.TripId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Activity == nil {
+		return nil, status.Error(codes.InvalidArgument, "activity is required")
+	}
+
+	activity, err := findActivity(trip, req.ActivityId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeService.UpdateActivity(activity, userID, activityInput(req.Activity)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return activityMessage(activity), nil
+}
+
+// DeleteActivity - Aktiviteyi siler (🔒 Token + Ownership)
+func (s *TripServer) DeleteActivity(ctx context.Context, req *pb.TripItemRequest) (*pb.MessageResponse, error) {
+	trip, userID, err := s.ownedTrip(ctx, req.TripId)
+	if err != nil {
+		return nil, err
+	}
+	activity, err := findActivity(trip, req.ItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.tripService.DeleteActivity(activity.ID, userID); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.MessageResponse{Message: "Activity deleted successfully"}, nil
+}
+
+// AddExpense - Geziye harcama ekler, bütçe uyarılarını kontrol eder (🔒 Token + Ownership)
+func (s *TripServer) AddExpense(ctx context.Context, req *pb.ExpenseRequest) (*pb.TripExpense, error) {
+	trip, userID, err := s.ownedTrip(ctx, req.TripId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Expense == nil {
+		return nil, status.Error(codes.InvalidArgument, "expense is required")
+	}
+
+	expense, _, err := s.writeService.AddExpense(trip, userID, expenseInput(req.Expense))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return expenseMessage(expense), nil
+}
+
+// UpdateExpense - Harcamayı günceller; boş tarih, para birimi ve açıklama korunur (🔒 Token + Ownership)
+func (s *TripServer) UpdateExpense(ctx context.Context, req *pb.ExpenseRequest) (*pb.TripExpense, error) {
+	trip, userID, err := s.ownedTrip(ctx, req.TripId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Expense == nil {
+		return nil, status.Error(codes.InvalidArgument, "expense is required")
+	}
+
+	expense, err := findExpense(trip, req.ExpenseId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.writeService.UpdateExpense(trip, expense, userID, expenseInput(req.Expense)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return expenseMessage(expense), nil
+}
+
+// DeleteExpense - Harcamayı siler (🔒 Token + Ownership)
+func (s *TripServer) DeleteExpense(ctx context.Context, req *pb.TripItemRequest) (*pb.MessageResponse, error) {
+	trip, userID, err := s.ownedTrip(ctx, req.TripId)
+	if err != nil {
+		return nil, err
+	}
+	expense, err := findExpense(trip, req.ItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.writeService.DeleteExpense(trip, expense, userID); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.MessageResponse{Message: "Expense deleted successfully"}, nil
+}
+
+// ownedTrip - Geziyi yükler ve token sahibinin gezinin sahibi olduğunu doğrular
+func (s *TripServer) ownedTrip(ctx context.Context, tripID uint32) (*models.Trip, uint, error) {
+	userID, err := requireUser(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	trip, err := s.tripService.GetTripByID(uint(tripID))
+	if err != nil {
+		return nil, 0, status.Error(codes.NotFound, "trip not found")
+	}
+	if trip.UserID != userID {
+		return nil, 0, status.Error(codes.PermissionDenied, "you can only modify your own trips")
+	}
+	return trip, userID, nil
+}
+
+func findActivity(trip *models.Trip, id uint32) (*models.Activity, error) {
+	for i := range trip.Activities {
+		if trip.Activities[i].ID == uint(id) {
+			return &trip.Activities[i], nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "activity not found")
+}
+
+func findExpense(trip *models.Trip, id uint32) (*models.Expense, error) {
+	for i := range trip.Expenses {
+		if trip.Expenses[i].ID == uint(id) {
+			return &trip.Expenses[i], nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "expense not found")
+}
+
+// tripInput - Proto mesajındaki alanlar HTTP body'siyle aynıdır
+func tripInput(input *pb.TripInput) services.TripInput {
+	return services.TripInput{
+		Title:       input.Title,
+		Destination: input.Destination,
+		StartDate:   input.StartDate,
+		EndDate:     input.EndDate,
+		Description: input.Description,
+		Budget:      input.Budget,
+		Currency:    input.Currency,
+		IsPublic:    input.IsPublic,
+		Latitude:    input.Latitude,
+		Longitude:   input.Longitude,
+	}
+}
+
+func activityInput(input *pb.ActivityInput) services.ActivityInput {
+	return services.ActivityInput{
+		Name:        input.Name,
+		Description: input.Description,
+		Location:    input.Location,
+		Latitude:    input.Latitude,
+		Longitude:   input.Longitude,
+		Date:        input.Date,
+	}
+}
+
+func expenseInput(input *pb.ExpenseInput) services.ExpenseInput {
+	return services.ExpenseInput{
+		Category:    input.Category,
+		Amount:      input.Amount,
+		Currency:    input.Currency,
+		ExpenseDate: input.ExpenseDate,
+		Description: input.Description,
+	}
+}
+
+func tripList(trips []models.Trip) *pb.TripList {
+	list := &pb.TripList{}
+	for i := range trips {
+		list.Trips = append(list.Trips, tripMessage(&trips[i]))
+	}
+	return list
+}
+
+// tripMessage - Veritabanındaki geziyi aktivite ve harcamalarıyla proto mesajına çevirir
+func tripMessage(trip *models.Trip) *pb.Trip {
+	message := &pb.Trip{
+		Id:          uint32(trip.ID),
+		UserId:      uint32(trip.UserID),
+		Title:       trip.Title,
+		Destination: trip.Destination,
+		StartDate:   trip.StartDate.Format("2006-01-02"),
+		EndDate:     trip.EndDate.Format("2006-01-02"),
+		Description: trip.Description,
+		Budget:      trip.Budget,
+		Currency:    trip.Currency,
+		Status:      trip.Status,
+		IsPublic:    trip.IsPublic,
+		Latitude:    trip.Latitude,
+		Longitude:   trip.Longitude,
+		CreatedAt:   trip.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   trip.UpdatedAt.Format(time.RFC3339),
+	}
+	if trip.DestinationID != nil {
+		message.DestinationId = uint32(*trip.DestinationID)
+	}
+	for i := range trip.Activities {
+		message.Activities = append(message.Activities, activityMessage(&trip.Activities[i]))
+	}
+	for i := range trip.Expenses {
+		message.Expenses = append(message.Expenses, expenseMessage(&trip.Expenses[i]))
+	}
+	return message
+}
+
+func activityMessage(activity *models.Activity) *pb.TripActivity {
+	return &pb.TripActivity{
+		Id:          uint32(activity.ID),
+		TripId:      uint32(activity.TripID),
+		Name:        activity.Name,
+		Description: activity.Description,
+		Location:    activity.Location,
+		Latitude:    activity.Latitude,
+		Longitude:   activity.Longitude,
+		Date:        activity.Date.Format("2006-01-02"),
+	}
+}
+
+func expenseMessage(expense *models.Expense) *pb.TripExpense {
+	return &pb.TripExpense{
+		Id:           uint32(expense.ID),
+		TripId:       uint32(expense.TripID),
+		Category:     expense.Category,
+		Amount:       expense.Amount,
+		Currency:     expense.Currency,
+		ExpenseDate:  expense.ExpenseDate.Format("2006-01-02"),
+		Description:  expense.Description,
+		ExchangeRate: expense.ExchangeRate,
+		HomeAmount:   expense.HomeAmount,
+	}
+}
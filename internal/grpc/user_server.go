@@ -0,0 +1,114 @@
+package grpc
+
+import (
+	"context"
+	"time"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+	pb "travel-platform/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UserServer - services.UserService'in gRPC karşılığı; oturumlar HTTP ile ortaktır
+type UserServer struct {
+	pb.UnimplementedUserServiceServer
+	userService services.UserService
+}
+
+func NewUserServer(userService services.UserService) *UserServer {
+	return &UserServer{userService: userService}
+}
+
+func (s *UserServer) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.User, error) {
+	if req.Email == "" || req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "email and password are required")
+	}
+
+	user, err := s.userService.Register(req.Email, req.Password, req.FirstName, req.LastName)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return userMessage(user), nil
+}
+
+// Login - Dönen token HTTP'deki session_id ile aynıdır
+func (s *UserServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	user, err := s.userService.Login(req.Email, req.Password)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	token := middleware.CreateSession(user.ID, user.Email)
+	response := &pb.LoginResponse{Token: token, User: userMessage(user)}
+	if session, exists := middleware.GetSession(token); exists {
+		response.ExpiresAt = session.ExpiresAt.Format(time.RFC3339)
+	}
+	return response, nil
+}
+
+// Logout - İstekteki token'ın oturumunu kapatır
+func (s *UserServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.MessageResponse, error) {
+	if _, err := requireUser(ctx); err != nil {
+		return nil, err
+	}
+	if token, ok := ctx.Value(sessionKey{}).(string); ok {
+		middleware.DeleteSession(token)
+	}
+	return &pb.MessageResponse{Message: "Logged out successfully"}, nil
+}
+
+func (s *UserServer) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.User, error) {
+	userID, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userService.GetProfile(userID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return userMessage(user), nil
+}
+
+func (s *UserServer) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.User, error) {
+	userID, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userService.UpdateProfile(userID, req.FirstName, req.LastName)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return userMessage(user), nil
+}
+
+func (s *UserServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.UserList, error) {
+	if _, err := requireUser(ctx); err != nil {
+		return nil, err
+	}
+
+	users, err := s.userService.GetAllUsers()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	list := &pb.UserList{}
+	for i := range users {
+		list.Users = append(list.Users, userMessage(&users[i]))
+	}
+	return list, nil
+}
+
+// userMessage - Şifre hash'i hiçbir zaman gönderilmez
+func userMessage(user *models.User) *pb.User {
+	return &pb.User{
+		Id:        uint32(user.ID),
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+	}
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"travel-platform/internal/backup"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// maxBackupSize - Geri yüklenebilecek en büyük arşiv (50 MB, ekler dahil)
+const maxBackupSize = 50 << 20
+
+type BackupHandler interface {
+	ExportTrip(w http.ResponseWriter, r *http.Request)
+	ExportAllTrips(w http.ResponseWriter, r *http.Request)
+	RestoreTrips(w http.ResponseWriter, r *http.Request)
+}
+
+type backupHandler struct {
+	service     services.BackupService
+	tripService services.TripService
+}
+
+func NewBackupHandler(service services.BackupService, tripService services.TripService) BackupHandler {
+	return &backupHandler{service: service, tripService: tripService}
+}
+
+// ExportTrip - Tek bir geziyi zip arşivi olarak indir (🔒 Protected + Ownership kontrolü)
+func (h *backupHandler) ExportTrip(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid trip ID", http.StatusBadRequest)
+		return
+	}
+
+	trip, err := h.tripService.GetTripByID(uint(id))
+	if err != nil {
+		http.Error(w, "Trip not found", http.StatusNotFound)
+		return
+	}
+
+	if trip.UserID != userID {
+		http.Error(w, "Forbidden - You can only export your own trips", http.StatusForbidden)
+		return
+	}
+
+	h.writeArchive(w, []models.Trip{*trip}, fmt.Sprintf("travelmate-trip-%d", trip.ID))
+}
+
+// ExportAllTrips - Kullanıcının tüm gezilerini zip arşivi olarak indir (🔒 Protected)
+func (h *backupHandler) ExportAllTrips(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	trips, err := h.tripService.GetTripByUserID(userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.writeArchive(w, trips, "travelmate-backup")
+}
+
+// RestoreTrips - Export edilmiş arşivi bu hesaba geri yükle (🔒 Protected)
+// Multipart "file" alanı veya ham zip body kabul edilir
+func (h *backupHandler) RestoreTrips(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxBackupSize)
+
+	data, _, err := readUpload(r)
+	if err != nil {
+		http.Error(w, "Invalid upload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	archive, err := backup.Read(data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.service.Restore(userID, archive)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Backup restored successfully",
+		"result":  result,
+	})
+}
+
+func (h *backupHandler) writeArchive(w http.ResponseWriter, trips []models.Trip, name string) {
+	archive, err := h.service.Export(trips)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Önce belleğe yaz; hata olursa yarım zip yerine düzgün bir HTTP hatası dönebilelim
+	var buf bytes.Buffer
+	if err := backup.Write(&buf, archive); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	filename := fmt.Sprintf("%s-%s.zip", name, time.Now().Format("20060102"))
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+type BudgetHandler interface {
+	GetBudgets(w http.ResponseWriter, r *http.Request)
+	SetBudgets(w http.ResponseWriter, r *http.Request)
+}
+
+type budgetHandler struct {
+	service       services.BudgetService
+	tripService   services.TripService
+	memberService services.MemberService
+}
+
+func NewBudgetHandler(service services.BudgetService, tripService services.TripService, memberService services.MemberService) BudgetHandler {
+	return &budgetHandler{service: service, tripService: tripService, memberService: memberService}
+}
+
+// GetBudgets - Kategori bütçeleri ve harcama hızından gezi sonu tahmini (🔒 Protected + Sahip veya üye)
+// Örnek: /api/trips/5/budgets?today=2025-06-02 (varsayılan bugün)
+func (h *budgetHandler) GetBudgets(w http.ResponseWriter, r *http.Request) {
+	trip, ok := h.loadTrip(w, r, false)
+	if !ok {
+		return
+	}
+
+	today := time.Now()
+	if value := r.URL.Query().Get("today"); value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			http.Error(w, "Invalid today date. Use YYYY-MM-DD", http.StatusBadRequest)
+			return
+		}
+		today = parsed
+	}
+
+	budgets, err := h.service.GetCategoryBudgets(trip.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	forecast, err := h.service.Forecast(trip, today)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"budgets":  budgets,
+		"forecast": forecast,
+	})
+}
+
+// SetBudgets - Kategori bütçelerini değiştir; boş liste hepsini kaldırır (🔒 Protected + Ownership kontrolü)
+func (h *budgetHandler) SetBudgets(w http.ResponseWriter, r *http.Request) {
+	trip, ok := h.loadTrip(w, r, true)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Budgets []struct {
+			Category string  `json:"category"`
+			Amount   float64 `json:"amount"`
+		} `json:"budgets"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	budgets := make([]models.CategoryBudget, 0, len(req.Budgets))
+	for _, budget := range req.Budgets {
+		budgets = append(budgets, models.CategoryBudget{Category: budget.Category, Amount: budget.Amount})
+	}
+
+	saved, err := h.service.SetCategoryBudgets(trip, budgets)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Category budgets updated successfully",
+		"budgets": saved,
+	})
+}
+
+func (h *budgetHandler) loadTrip(w http.ResponseWriter, r *http.Request, ownerOnly bool) (*models.Trip, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return nil, false
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid trip ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	trip, err := h.tripService.GetTripByID(uint(id))
+	if err != nil {
+		http.Error(w, "Trip not found", http.StatusNotFound)
+		return nil, false
+	}
+
+	if ownerOnly && trip.UserID != userID {
+		http.Error(w, "Forbidden - You can only modify your own trips", http.StatusForbidden)
+		return nil, false
+	}
+	if !h.memberService.IsParticipant(trip, userID) {
+		http.Error(w, "Forbidden - You are not a member of this trip", http.StatusForbidden)
+		return nil, false
+	}
+
+	return trip, true
+}
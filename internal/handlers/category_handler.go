@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+type CategoryHandler interface {
+	GetCategories(w http.ResponseWriter, r *http.Request)
+	CreateCategory(w http.ResponseWriter, r *http.Request)
+	UpdateCategory(w http.ResponseWriter, r *http.Request)
+	DeleteCategory(w http.ResponseWriter, r *http.Request)
+	MergeCategories(w http.ResponseWriter, r *http.Request)
+}
+
+type categoryHandler struct {
+	service services.CategoryService
+}
+
+func NewCategoryHandler(service services.CategoryService) CategoryHandler {
+	return &categoryHandler{service: service}
+}
+
+// categoryRequest - Özel kategori oluşturma ve güncelleme gövdesi
+type categoryRequest struct {
+	Name         string  `json:"name"`
+	Color        string  `json:"color"`
+	Icon         string  `json:"icon"`
+	IdealMin     float64 `json:"ideal_min"`
+	IdealMax     float64 `json:"ideal_max"`
+	SuggestAbove float64 `json:"suggest_above"`
+	Suggestion   string  `json:"suggestion"`
+}
+
+func (req categoryRequest) toModel() *models.ExpenseCategory {
+	return &models.ExpenseCategory{
+		Name:         req.Name,
+		Color:        req.Color,
+		Icon:         req.Icon,
+		IdealMin:     req.IdealMin,
+		IdealMax:     req.IdealMax,
+		SuggestAbove: req.SuggestAbove,
+		Suggestion:   req.Suggestion,
+	}
+}
+
+// GetCategories - Sistem kategorileri ve kullanıcının özel kategorileri (🔒 Protected)
+func (h *categoryHandler) GetCategories(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	categories, err := h.service.GetCategories(userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(categories)
+}
+
+// CreateCategory - Özel kategori ekle (🔒 Protected)
+func (h *categoryHandler) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req categoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	category := req.toModel()
+	if err := h.service.CreateCategory(userID, category); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":  "Category created successfully",
+		"category": category,
+	})
+}
+
+// UpdateCategory - Özel kategoriyi güncelle; ad değişirse harcamalar da yeni ada taşınır (🔒 Protected + Ownership kontrolü)
+func (h *categoryHandler) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+	userID, id, ok := categoryParams(w, r)
+	if !ok {
+		return
+	}
+
+	var req categoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	category, err := h.service.UpdateCategory(userID, id, req.toModel())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":  "Category updated successfully",
+		"category": category,
+	})
+}
+
+// DeleteCategory - Kullanılmayan özel kategoriyi sil (🔒 Protected + Ownership kontrolü)
+func (h *categoryHandler) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+	userID, id, ok := categoryParams(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteCategory(userID, id); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Category deleted successfully",
+	})
+}
+
+// MergeCategories - Bir kategorideki harcamaları başka bir kategoriye taşı (🔒 Protected)
+// from katalogda olmayan eski bir kategori adı da olabilir, ör. {"from": "entertainment", "to": "activities"}
+func (h *categoryHandler) MergeCategories(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	moved, err := h.service.MergeCategories(userID, req.From, req.To)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Categories merged successfully",
+		"moved":   moved,
+	})
+}
+
+func categoryParams(w http.ResponseWriter, r *http.Request) (uint, uint, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return 0, 0, false
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid category ID", http.StatusBadRequest)
+		return 0, 0, false
+	}
+	return userID, uint(id), true
+}
@@ -0,0 +1,473 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+type ChecklistHandler interface {
+	GetMembers(w http.ResponseWriter, r *http.Request)
+	AddMember(w http.ResponseWriter, r *http.Request)
+	RemoveMember(w http.ResponseWriter, r *http.Request)
+	GetChecklists(w http.ResponseWriter, r *http.Request)
+	CreateChecklist(w http.ResponseWriter, r *http.Request)
+	DeleteChecklist(w http.ResponseWriter, r *http.Request)
+	AddItem(w http.ResponseWriter, r *http.Request)
+	UpdateItem(w http.ResponseWriter, r *http.Request)
+	DeleteItem(w http.ResponseWriter, r *http.Request)
+	SaveAsTemplate(w http.ResponseWriter, r *http.Request)
+	GetTemplates(w http.ResponseWriter, r *http.Request)
+	DeleteTemplate(w http.ResponseWriter, r *http.Request)
+}
+
+type checklistHandler struct {
+	service       services.ChecklistService
+	memberService services.MemberService
+	tripService   services.TripService
+}
+
+func NewChecklistHandler(service services.ChecklistService, memberService services.MemberService, tripService services.TripService) ChecklistHandler {
+	return &checklistHandler{service: service, memberService: memberService, tripService: tripService}
+}
+
+// checklistRequest - Liste oluşturma body'si; template_id verilirse şablondan oluşturulur
+type checklistRequest struct {
+	Title      string   `json:"title"`
+	Kind       string   `json:"kind"`
+	Items      []string `json:"items"`
+	TemplateID uint     `json:"template_id"`
+}
+
+// itemRequest - Madde ekleme/güncelleme body'si; güncellemede boş alanlar değiştirilmez
+type itemRequest struct {
+	Text       *string `json:"text"`
+	AssigneeID *uint   `json:"assignee_id"`
+	Unassign   bool    `json:"unassign"`
+	Done       *bool   `json:"done"`
+}
+
+// GetMembers - Gezinin üyeleri (🔒 Protected + Sahip veya üye)
+func (h *checklistHandler) GetMembers(w http.ResponseWriter, r *http.Request) {
+	trip, _, ok := h.participantTrip(w, r)
+	if !ok {
+		return
+	}
+
+	members, err := h.memberService.GetMembers(trip.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"owner":   trip.User,
+		"members": members,
+	})
+}
+
+// AddMember - Kayıtlı bir kullanıcıyı e-posta ile geziye ekle (🔒 Protected + Ownership kontrolü)
+func (h *checklistHandler) AddMember(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.participantTrip(w, r)
+	if !ok {
+		return
+	}
+	if trip.UserID != userID {
+		http.Error(w, "Forbidden - Only the trip owner can add members", http.StatusForbidden)
+		return
+	}
+
+	var req struct {
+		Email string `json:"email"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Email == "" {
+		http.Error(w, "Email is required", http.StatusBadRequest)
+		return
+	}
+
+	member, err := h.memberService.AddMember(trip, req.Email)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Member added successfully",
+		"member":  member,
+	})
+}
+
+// RemoveMember - Üyeyi geziden çıkar; sahip herkesi, üye sadece kendini çıkarabilir (🔒 Protected)
+func (h *checklistHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.participantTrip(w, r)
+	if !ok {
+		return
+	}
+
+	memberID, err := strconv.ParseUint(mux.Vars(r)["userID"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		return
+	}
+	if trip.UserID != userID && uint(memberID) != userID {
+		http.Error(w, "Forbidden - Only the trip owner can remove other members", http.StatusForbidden)
+		return
+	}
+
+	if err := h.memberService.RemoveMember(trip.ID, uint(memberID)); err != nil {
+		http.Error(w, "Member not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Member removed successfully",
+	})
+}
+
+// GetChecklists - Gezinin listeleri ve toplam ilerleme (🔒 Protected + Sahip veya üye)
+func (h *checklistHandler) GetChecklists(w http.ResponseWriter, r *http.Request) {
+	trip, _, ok := h.participantTrip(w, r)
+	if !ok {
+		return
+	}
+
+	checklists, err := h.service.GetChecklists(trip.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"checklists": checklists,
+		"progress":   services.Progress(checklists),
+	})
+}
+
+// CreateChecklist - Yeni liste oluştur, boş veya şablondan (🔒 Protected + Sahip veya üye)
+func (h *checklistHandler) CreateChecklist(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.participantTrip(w, r)
+	if !ok {
+		return
+	}
+
+	var req checklistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	var checklist *models.Checklist
+	if req.TemplateID != 0 {
+		template, err := h.service.GetTemplateByID(req.TemplateID)
+		if err != nil || template.UserID != userID {
+			http.Error(w, "Template not found", http.StatusNotFound)
+			return
+		}
+		checklist, err = h.service.CreateFromTemplate(template, trip.ID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	} else {
+		checklist = &models.Checklist{TripID: trip.ID, Title: req.Title, Kind: req.Kind}
+		for _, text := range req.Items {
+			checklist.Items = append(checklist.Items, models.ChecklistItem{Text: text})
+		}
+		if err := h.service.CreateChecklist(checklist); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":   "Checklist created successfully",
+		"checklist": checklist,
+	})
+}
+
+// DeleteChecklist - Listeyi maddeleriyle sil (🔒 Protected + Sahip veya üye)
+func (h *checklistHandler) DeleteChecklist(w http.ResponseWriter, r *http.Request) {
+	trip, _, ok := h.participantTrip(w, r)
+	if !ok {
+		return
+	}
+
+	checklist, ok := h.findChecklist(w, r, trip)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteChecklist(checklist.ID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Checklist deleted successfully",
+	})
+}
+
+// AddItem - Listeye madde ekle (🔒 Protected + Sahip veya üye)
+func (h *checklistHandler) AddItem(w http.ResponseWriter, r *http.Request) {
+	trip, _, ok := h.participantTrip(w, r)
+	if !ok {
+		return
+	}
+
+	checklist, ok := h.findChecklist(w, r, trip)
+	if !ok {
+		return
+	}
+
+	var req itemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Text == nil {
+		http.Error(w, "Item text is required", http.StatusBadRequest)
+		return
+	}
+
+	item := &models.ChecklistItem{
+		ChecklistID: checklist.ID,
+		Text:        *req.Text,
+		AssigneeID:  req.AssigneeID,
+	}
+
+	if err := h.service.AddItem(trip, item); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Item added successfully",
+		"item":    item,
+	})
+}
+
+// UpdateItem - Maddeyi düzenle, ata veya tamamlandı işaretle (🔒 Protected + Sahip veya üye)
+func (h *checklistHandler) UpdateItem(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.participantTrip(w, r)
+	if !ok {
+		return
+	}
+
+	item, ok := h.findItem(w, r, trip)
+	if !ok {
+		return
+	}
+
+	var req itemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.Text != nil {
+		item.Text = *req.Text
+	}
+	if req.AssigneeID != nil {
+		item.AssigneeID = req.AssigneeID
+	}
+	if req.Unassign {
+		item.AssigneeID = nil
+	}
+	item.Assignee = nil
+	if req.Done != nil {
+		item.Done = *req.Done
+	}
+
+	if err := h.service.UpdateItem(trip, item, userID); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Item updated successfully",
+		"item":    item,
+	})
+}
+
+// DeleteItem - Maddeyi sil (🔒 Protected + Sahip veya üye)
+func (h *checklistHandler) DeleteItem(w http.ResponseWriter, r *http.Request) {
+	trip, _, ok := h.participantTrip(w, r)
+	if !ok {
+		return
+	}
+
+	item, ok := h.findItem(w, r, trip)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteItem(item.ID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Item deleted successfully",
+	})
+}
+
+// SaveAsTemplate - Listeyi kullanıcının şablonlarına kaydet (🔒 Protected + Sahip veya üye)
+func (h *checklistHandler) SaveAsTemplate(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.participantTrip(w, r)
+	if !ok {
+		return
+	}
+
+	checklist, ok := h.findChecklist(w, r, trip)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Title string `json:"title"`
+	}
+	json.NewDecoder(r.Body).Decode(&req)
+
+	template, err := h.service.SaveAsTemplate(checklist, userID, req.Title)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":  "Checklist saved as template",
+		"template": template,
+	})
+}
+
+// GetTemplates - Kullanıcının liste şablonları (🔒 Protected)
+func (h *checklistHandler) GetTemplates(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	templates, err := h.service.GetTemplates(userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(templates)
+}
+
+// DeleteTemplate - Liste şablonunu sil (🔒 Protected + Ownership kontrolü)
+func (h *checklistHandler) DeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid template ID", http.StatusBadRequest)
+		return
+	}
+
+	template, err := h.service.GetTemplateByID(uint(id))
+	if err != nil || template.UserID != userID {
+		http.Error(w, "Template not found", http.StatusNotFound)
+		return
+	}
+
+	if err := h.service.DeleteTemplate(template.ID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Template deleted successfully",
+	})
+}
+
+// participantTrip - URL'deki geziyi yükler; kullanıcı sahibi veya üyesi olmalı
+func (h *checklistHandler) participantTrip(w http.ResponseWriter, r *http.Request) (*models.Trip, uint, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return nil, 0, false
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid trip ID", http.StatusBadRequest)
+		return nil, 0, false
+	}
+
+	trip, err := h.tripService.GetTripByID(uint(id))
+	if err != nil {
+		http.Error(w, "Trip not found", http.StatusNotFound)
+		return nil, 0, false
+	}
+
+	if !h.memberService.IsParticipant(trip, userID) {
+		http.Error(w, "Forbidden - You are not a member of this trip", http.StatusForbidden)
+		return nil, 0, false
+	}
+
+	return trip, userID, true
+}
+
+// findChecklist - URL'deki listeyi yükler ve geziye ait olduğunu doğrular
+func (h *checklistHandler) findChecklist(w http.ResponseWriter, r *http.Request, trip *models.Trip) (*models.Checklist, bool) {
+	id, err := strconv.ParseUint(mux.Vars(r)["checklistID"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid checklist ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	checklist, err := h.service.GetChecklistByID(uint(id))
+	if err != nil || checklist.TripID != trip.ID {
+		http.Error(w, "Checklist not found", http.StatusNotFound)
+		return nil, false
+	}
+
+	return checklist, true
+}
+
+// findItem - URL'deki maddeyi listenin maddeleri arasında bulur
+func (h *checklistHandler) findItem(w http.ResponseWriter, r *http.Request, trip *models.Trip) (*models.ChecklistItem, bool) {
+	checklist, ok := h.findChecklist(w, r, trip)
+	if !ok {
+		return nil, false
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["itemID"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid item ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	for i := range checklist.Items {
+		if checklist.Items[i].ID == uint(id) {
+			return &checklist.Items[i], true
+		}
+	}
+
+	http.Error(w, "Item not found", http.StatusNotFound)
+	return nil, false
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/services"
+)
+
+type CurrencyHandler interface {
+	GetCurrencies(w http.ResponseWriter, r *http.Request)
+	GetExchangeRate(w http.ResponseWriter, r *http.Request)
+}
+
+type currencyHandler struct {
+	service services.CurrencyService
+}
+
+func NewCurrencyHandler(service services.CurrencyService) CurrencyHandler {
+	return &currencyHandler{service: service}
+}
+
+// GetCurrencies - Desteklenen para birimleri
+func (h *currencyHandler) GetCurrencies(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"base":       currency.Base,
+		"currencies": h.service.Currencies(),
+	})
+}
+
+// GetExchangeRate - Belirli tarihteki kur, amount verilirse çevrilmiş tutar
+// Örnek: /api/exchange-rates?from=USD&to=EUR&date=2025-01-02&amount=120
+func (h *currencyHandler) GetExchangeRate(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	from, to := currency.Normalize(query.Get("from")), currency.Normalize(query.Get("to"))
+	if !currency.IsValidCode(from) || !currency.IsValidCode(to) {
+		http.Error(w, "from and to must be 3-letter currency codes", http.StatusBadRequest)
+		return
+	}
+
+	date := time.Now()
+	if raw := query.Get("date"); raw != "" {
+		parsed, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			http.Error(w, "Invalid date format. Use YYYY-MM-DD", http.StatusBadRequest)
+			return
+		}
+		date = parsed
+	}
+
+	amount := 1.0
+	if raw := query.Get("amount"); raw != "" {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			http.Error(w, "Invalid amount", http.StatusBadRequest)
+			return
+		}
+		amount = parsed
+	}
+
+	converted, rate, err := h.service.Convert(amount, from, to, date)
+	if err != nil {
+		status := http.StatusBadGateway
+		if errors.Is(err, currency.ErrRateNotFound) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"from":      from,
+		"to":        to,
+		"date":      date.Format("2006-01-02"),
+		"rate":      rate,
+		"amount":    amount,
+		"converted": converted,
+	})
+}
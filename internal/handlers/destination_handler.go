@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"travel-platform/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+type DestinationHandler interface {
+	Autocomplete(w http.ResponseWriter, r *http.Request)
+	GetDestination(w http.ResponseWriter, r *http.Request)
+}
+
+type destinationHandler struct {
+	service services.DestinationService
+}
+
+func NewDestinationHandler(service services.DestinationService) DestinationHandler {
+	return &destinationHandler{service: service}
+}
+
+// Autocomplete - Katalogda ad, alias veya ülkeye göre arama; q boşsa tüm katalog
+// Örnek: GET /api/destinations?q=bar&limit=5
+func (h *destinationHandler) Autocomplete(w http.ResponseWriter, r *http.Request) {
+	limit := 0
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			http.Error(w, "limit must be a positive number", http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	destinations, err := h.service.Autocomplete(r.URL.Query().Get("q"), limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(destinations)
+}
+
+// GetDestination - Katalog kaydı
+func (h *destinationHandler) GetDestination(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid destination ID", http.StatusBadRequest)
+		return
+	}
+
+	destination, err := h.service.GetDestination(uint(id))
+	if err != nil {
+		http.Error(w, "Destination not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(destination)
+}
@@ -0,0 +1,299 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// maxDocumentSize - Yüklenebilecek en büyük belge (10 MB)
+const maxDocumentSize = 10 << 20
+
+type DocumentHandler interface {
+	GetDocuments(w http.ResponseWriter, r *http.Request)
+	UploadDocument(w http.ResponseWriter, r *http.Request)
+	GetDocument(w http.ResponseWriter, r *http.Request)
+	DownloadDocument(w http.ResponseWriter, r *http.Request)
+	UpdateDocument(w http.ResponseWriter, r *http.Request)
+	DeleteDocument(w http.ResponseWriter, r *http.Request)
+	GetReminders(w http.ResponseWriter, r *http.Request)
+}
+
+type documentHandler struct {
+	service       services.DocumentService
+	tripService   services.TripService
+	memberService services.MemberService
+}
+
+func NewDocumentHandler(service services.DocumentService, tripService services.TripService, memberService services.MemberService) DocumentHandler {
+	return &documentHandler{service: service, tripService: tripService, memberService: memberService}
+}
+
+// documentRequest - Belge bilgisi güncelleme body'si
+type documentRequest struct {
+	Type      string `json:"type"`
+	Title     string `json:"title"`
+	Number    string `json:"number"`
+	Country   string `json:"country"`
+	ExpiresAt string `json:"expires_at"`
+	TripID    *uint  `json:"trip_id"`
+}
+
+// GetDocuments - Kullanıcının belgeleri, ?trip_id= ile bir geziye ait olanlar (🔒 Protected)
+func (h *documentHandler) GetDocuments(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	documents, err := h.service.GetDocuments(userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if value := r.URL.Query().Get("trip_id"); value != "" {
+		tripID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			http.Error(w, "Invalid trip ID", http.StatusBadRequest)
+			return
+		}
+		filtered := []models.TravelDocument{}
+		for _, document := range documents {
+			if document.TripID != nil && *document.TripID == uint(tripID) {
+				filtered = append(filtered, document)
+			}
+		}
+		documents = filtered
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(documents)
+}
+
+// UploadDocument - Belge yükle; numara ve dosya şifreli saklanır (🔒 Protected)
+// multipart alanları: file, type, title, number, country, expires_at (YYYY-MM-DD), trip_id
+func (h *documentHandler) UploadDocument(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxDocumentSize)
+	if err := r.ParseMultipartForm(maxDocumentSize); err != nil {
+		http.Error(w, "Invalid upload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "File is required", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "Invalid upload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	contentType := header.Header.Get("Content-Type")
+	if contentType == "" || contentType == "application/octet-stream" {
+		contentType = http.DetectContentType(data)
+	}
+
+	document := &models.TravelDocument{
+		UserID:      userID,
+		FileName:    path.Base(header.Filename),
+		ContentType: contentType,
+	}
+
+	req := documentRequest{
+		Type:      r.FormValue("type"),
+		Title:     r.FormValue("title"),
+		Number:    r.FormValue("number"),
+		Country:   r.FormValue("country"),
+		ExpiresAt: r.FormValue("expires_at"),
+	}
+	if value := r.FormValue("trip_id"); value != "" {
+		tripID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			http.Error(w, "Invalid trip ID", http.StatusBadRequest)
+			return
+		}
+		id := uint(tripID)
+		req.TripID = &id
+	}
+	if !h.applyRequest(w, req, document) {
+		return
+	}
+
+	if err := h.service.UploadDocument(document, data); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":  "Document uploaded successfully",
+		"document": document,
+	})
+}
+
+// GetDocument - Belge bilgileri (🔒 Protected + Ownership kontrolü)
+func (h *documentHandler) GetDocument(w http.ResponseWriter, r *http.Request) {
+	document, ok := h.ownedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(document)
+}
+
+// DownloadDocument - Çözülmüş dosyayı indir (🔒 Protected + Ownership kontrolü)
+func (h *documentHandler) DownloadDocument(w http.ResponseWriter, r *http.Request) {
+	document, ok := h.ownedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	data, err := h.service.OpenDocument(document)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", document.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", document.FileName))
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write(data)
+}
+
+// UpdateDocument - Belge bilgilerini güncelle (🔒 Protected + Ownership kontrolü)
+func (h *documentHandler) UpdateDocument(w http.ResponseWriter, r *http.Request) {
+	document, ok := h.ownedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	var req documentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if !h.applyRequest(w, req, document) {
+		return
+	}
+
+	if err := h.service.UpdateDocument(document); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":  "Document updated successfully",
+		"document": document,
+	})
+}
+
+// DeleteDocument - Belgeyi sil (🔒 Protected + Ownership kontrolü)
+func (h *documentHandler) DeleteDocument(w http.ResponseWriter, r *http.Request) {
+	document, ok := h.ownedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteDocument(document.ID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Document deleted successfully",
+	})
+}
+
+// GetReminders - Süresi dolan veya gezi için yetersiz kalan belgeler (🔒 Protected)
+func (h *documentHandler) GetReminders(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	reminders, err := h.service.GetReminders(userID, time.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(reminders)
+}
+
+// ownedDocument - URL'deki belgeyi yükler; başkasının belgesi için de 404 döner
+func (h *documentHandler) ownedDocument(w http.ResponseWriter, r *http.Request) (*models.TravelDocument, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return nil, false
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid document ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	document, err := h.service.GetDocumentByID(uint(id))
+	if err != nil || document.UserID != userID {
+		http.Error(w, "Document not found", http.StatusNotFound)
+		return nil, false
+	}
+
+	return document, true
+}
+
+// applyRequest - İstekteki alanları belgeye yazar; bağlanan gezide kullanıcı sahip veya üye olmalı
+func (h *documentHandler) applyRequest(w http.ResponseWriter, req documentRequest, document *models.TravelDocument) bool {
+	var expiresAt *time.Time
+	if req.ExpiresAt != "" {
+		t, err := time.Parse("2006-01-02", req.ExpiresAt)
+		if err != nil {
+			http.Error(w, "Invalid expires_at format. Use YYYY-MM-DD", http.StatusBadRequest)
+			return false
+		}
+		expiresAt = &t
+	}
+
+	if req.TripID != nil {
+		trip, err := h.tripService.GetTripByID(*req.TripID)
+		if err != nil || !h.memberService.IsParticipant(trip, document.UserID) {
+			http.Error(w, "Trip not found", http.StatusBadRequest)
+			return false
+		}
+	}
+
+	document.Type = req.Type
+	document.Title = req.Title
+	document.Number = req.Number
+	document.Country = req.Country
+	document.ExpiresAt = expiresAt
+	document.TripID = req.TripID
+	return true
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"travel-platform/internal/geo"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+type GeoHandler interface {
+	ExportTripMap(w http.ResponseWriter, r *http.Request)
+	GeocodeTrip(w http.ResponseWriter, r *http.Request)
+}
+
+type geoHandler struct {
+	geoService  services.GeoService
+	tripService services.TripService
+}
+
+func NewGeoHandler(geoService services.GeoService, tripService services.TripService) GeoHandler {
+	return &geoHandler{geoService: geoService, tripService: tripService}
+}
+
+// mapFormats - format parametresi -> yazıcı, content type ve dosya uzantısı
+var mapFormats = map[string]struct {
+	write       func(w *bytes.Buffer, trip *models.Trip) error
+	contentType string
+	extension   string
+}{
+	"geojson": {func(w *bytes.Buffer, t *models.Trip) error { return geo.WriteGeoJSON(w, t) }, "application/geo+json", "geojson"},
+	"gpx":     {func(w *bytes.Buffer, t *models.Trip) error { return geo.WriteGPX(w, t) }, "application/gpx+xml", "gpx"},
+	"kml":     {func(w *bytes.Buffer, t *models.Trip) error { return geo.WriteKML(w, t) }, "application/vnd.google-earth.kml+xml", "kml"},
+}
+
+// ExportTripMap - Gezinin rotası ve noktaları (public gezi veya sahibi)
+// Örnek: /api/trips/5/map?format=gpx (varsayılan geojson)
+func (h *geoHandler) ExportTripMap(w http.ResponseWriter, r *http.Request) {
+	trip, ok := h.loadTrip(w, r, false)
+	if !ok {
+		return
+	}
+
+	name := r.URL.Query().Get("format")
+	if name == "" {
+		name = "geojson"
+	}
+	format, exists := mapFormats[name]
+	if !exists {
+		http.Error(w, "Unsupported format. Use geojson, gpx or kml", http.StatusBadRequest)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := format.write(&buf, trip); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="trip-%d.%s"`, trip.ID, format.extension))
+	w.Write(buf.Bytes())
+}
+
+// GeocodeTrip - Koordinatı eksik destinasyon ve aktiviteleri geocode edip kaydet (🔒 Protected + Ownership kontrolü)
+func (h *geoHandler) GeocodeTrip(w http.ResponseWriter, r *http.Request) {
+	trip, ok := h.loadTrip(w, r, true)
+	if !ok {
+		return
+	}
+
+	// Hangi aktivitelerin yeni koordinat aldığını bilmek için önceki durumu sakla
+	missing := make(map[uint]bool)
+	for _, a := range trip.Activities {
+		missing[a.ID] = a.Latitude == nil || a.Longitude == nil
+	}
+
+	unresolved := h.geoService.GeocodeTrip(trip)
+
+	// loadTrip sahipliği doğruladı, işlemi yapan gezinin sahibidir
+	if err := h.tripService.UpdateTrip(trip, trip.UserID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for i := range trip.Activities {
+		activity := &trip.Activities[i]
+		if missing[activity.ID] && activity.Latitude != nil {
+			if err := h.tripService.UpdateActivity(activity, trip.UserID); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+
+	if unresolved == nil {
+		unresolved = []string{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":    "Trip geocoded successfully",
+		"trip":       trip,
+		"unresolved": unresolved,
+	})
+}
+
+// loadTrip - URL'deki geziyi getirir; ownerOnly değilse public geziler herkese açıktır
+func (h *geoHandler) loadTrip(w http.ResponseWriter, r *http.Request, ownerOnly bool) (*models.Trip, bool) {
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid trip ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	trip, err := h.tripService.GetTripByID(uint(id))
+	if err != nil {
+		http.Error(w, "Trip not found", http.StatusNotFound)
+		return nil, false
+	}
+
+	userID, _ := middleware.GetUserIDFromContext(r)
+	if trip.UserID != userID && (ownerOnly || !trip.IsPublic) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return nil, false
+	}
+
+	return trip, true
+}
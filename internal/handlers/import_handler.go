@@ -0,0 +1,300 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"travel-platform/internal/importer"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// maxImportSize - Yüklenebilecek en büyük import dosyası (5 MB)
+const maxImportSize = 5 << 20
+
+type ImportHandler interface {
+	ImportTrips(w http.ResponseWriter, r *http.Request)
+	PreviewStatement(w http.ResponseWriter, r *http.Request)
+	ConfirmStatement(w http.ResponseWriter, r *http.Request)
+}
+
+type importHandler struct {
+	service             services.ImportService
+	tripService         services.TripService
+	categoryService     services.CategoryService
+	notificationService services.NotificationService
+}
+
+func NewImportHandler(service services.ImportService, tripService services.TripService, categoryService services.CategoryService, notificationService services.NotificationService) ImportHandler {
+	return &importHandler{service: service, tripService: tripService, categoryService: categoryService, notificationService: notificationService}
+}
+
+// ImportTrips - .ics veya TravelMate JSON bundle'dan gezi içe aktar (🔒 Protected)
+// Örnek: POST /api/trips/import?format=ics&dry_run=true (multipart "file" alanı veya ham body)
+// .ics için ek alanlar: title, destination, description, budget, is_public
+func (h *importHandler) ImportTrips(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+
+	data, filename, err := readUpload(r)
+	if err != nil {
+		http.Error(w, "Invalid upload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	dryRun, _ := strconv.ParseBool(r.FormValue("dry_run"))
+
+	var report *services.ImportReport
+	switch detectImportFormat(r.FormValue("format"), filename, data) {
+	case "ics":
+		budget, _ := strconv.ParseFloat(r.FormValue("budget"), 64)
+		isPublic, _ := strconv.ParseBool(r.FormValue("is_public"))
+		report, err = h.service.ImportICS(userID, data, importer.TripOptions{
+			Title:       r.FormValue("title"),
+			Destination: r.FormValue("destination"),
+			Description: r.FormValue("description"),
+			Budget:      budget,
+			IsPublic:    isPublic,
+		}, dryRun)
+	case "json":
+		report, err = h.service.ImportBundle(userID, data, dryRun)
+	default:
+		http.Error(w, "Unsupported format. Use format=ics or format=json", http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	status := http.StatusOK
+	message := "Import preview generated"
+	if !dryRun {
+		status = http.StatusCreated
+		message = "Trips imported successfully"
+		if report.Imported == 0 {
+			status = http.StatusUnprocessableEntity
+			message = "No trips could be imported"
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": message,
+		"report":  report,
+	})
+}
+
+// PreviewStatement - Banka ekstresindeki harcamaları önizle; kayıt oluşturmaz (🔒 Protected + Ownership kontrolü)
+// Örnek: POST /api/trips/{id}/expenses/import?format=csv (multipart "file" alanı veya ham body)
+// CSV sütunları: date, amount, debit, description, currency (başlık adı veya 1'den başlayan sıra),
+// date_format (ör. DD.MM.YYYY), delimiter, no_header, sign (negative/positive)
+func (h *importHandler) PreviewStatement(w http.ResponseWriter, r *http.Request) {
+	trip, _, ok := h.ownedTrip(w, r)
+	if !ok {
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+
+	data, filename, err := readUpload(r)
+	if err != nil {
+		http.Error(w, "Invalid upload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	format := detectStatementFormat(r.FormValue("format"), filename, data)
+	if format == "" {
+		http.Error(w, "Unsupported format. Use format=csv or format=ofx", http.StatusBadRequest)
+		return
+	}
+
+	noHeader, _ := strconv.ParseBool(r.FormValue("no_header"))
+	preview, err := h.service.PreviewStatement(trip, data, format, importer.CSVMapping{
+		Date:        r.FormValue("date"),
+		Amount:      r.FormValue("amount"),
+		Debit:       r.FormValue("debit"),
+		Description: r.FormValue("description"),
+		Currency:    r.FormValue("currency"),
+		DateFormat:  r.FormValue("date_format"),
+		Delimiter:   r.FormValue("delimiter"),
+		NoHeader:    noHeader,
+		Sign:        r.FormValue("sign"),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Statement preview generated",
+		"preview": preview,
+	})
+}
+
+// ConfirmStatement - Önizlemeden seçilen hareketleri harcama olarak ekle (🔒 Protected + Ownership kontrolü)
+// Body: {"transactions": [{"ref": "...", "date": "...", "amount": 12.5, "currency": "EUR", "description": "...", "category": "food"}]}
+func (h *importHandler) ConfirmStatement(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.ownedTrip(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Transactions []services.StatementLine `json:"transactions"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	for i := range req.Transactions {
+		category, err := h.categoryService.ResolveCategory(userID, req.Transactions[i].Category)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("line %d: %v", req.Transactions[i].Line, err), http.StatusBadRequest)
+			return
+		}
+		req.Transactions[i].Category = category
+	}
+
+	result, err := h.service.ConfirmStatement(trip, userID, req.Transactions)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	status := http.StatusCreated
+	message := "Expenses imported successfully"
+	var alerts []services.BudgetAlertEvent
+	if result.Imported == 0 {
+		status = http.StatusUnprocessableEntity
+		message = "No expenses could be imported"
+	} else {
+		alerts = checkBudgetAlerts(h.notificationService, trip.ID)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": message,
+		"result":  result,
+		"alerts":  alerts,
+	})
+}
+
+// ownedTrip - URL'deki geziyi yükler; ekstre aktarımını sadece gezi sahibi yapabilir
+func (h *importHandler) ownedTrip(w http.ResponseWriter, r *http.Request) (*models.Trip, uint, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return nil, 0, false
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid trip ID", http.StatusBadRequest)
+		return nil, 0, false
+	}
+
+	trip, err := h.tripService.GetTripByID(uint(id))
+	if err != nil {
+		http.Error(w, "Trip not found", http.StatusNotFound)
+		return nil, 0, false
+	}
+
+	if trip.UserID != userID {
+		http.Error(w, "Forbidden - You can only modify your own trips", http.StatusForbidden)
+		return nil, 0, false
+	}
+
+	return trip, userID, true
+}
+
+// readUpload - multipart "file" alanını, yoksa ham request body'yi okur
+func readUpload(r *http.Request) ([]byte, string, error) {
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if err := r.ParseMultipartForm(maxImportSize); err != nil {
+			return nil, "", err
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			return nil, "", err
+		}
+		defer file.Close()
+
+		data, err := io.ReadAll(file)
+		return data, header.Filename, err
+	}
+
+	data, err := io.ReadAll(r.Body)
+	return data, "", err
+}
+
+// detectImportFormat - Önce ?format=, sonra dosya uzantısı, en son içerik
+func detectImportFormat(format, filename string, data []byte) string {
+	switch strings.ToLower(format) {
+	case "ics", "ical", "icalendar":
+		return "ics"
+	case "json":
+		return "json"
+	case "":
+	default:
+		return ""
+	}
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".ics", ".ical":
+		return "ics"
+	case ".json":
+		return "json"
+	}
+
+	trimmed := strings.TrimSpace(string(data))
+	if strings.HasPrefix(trimmed, "BEGIN:VCALENDAR") {
+		return "ics"
+	}
+	if strings.HasPrefix(trimmed, "{") {
+		return "json"
+	}
+	return ""
+}
+
+// detectStatementFormat - Önce ?format=, sonra dosya uzantısı, en son içerik
+func detectStatementFormat(format, filename string, data []byte) string {
+	switch strings.ToLower(format) {
+	case "csv":
+		return "csv"
+	case "ofx", "qfx":
+		return "ofx"
+	case "":
+	default:
+		return ""
+	}
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv", ".txt":
+		return "csv"
+	case ".ofx", ".qfx":
+		return "ofx"
+	}
+
+	if strings.Contains(strings.ToUpper(string(data)), "<OFX>") {
+		return "ofx"
+	}
+	return "csv"
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+type ReceiptHandler interface {
+	GetReceipts(w http.ResponseWriter, r *http.Request)
+	UploadReceipt(w http.ResponseWriter, r *http.Request)
+	DownloadReceipt(w http.ResponseWriter, r *http.Request)
+	DeleteReceipt(w http.ResponseWriter, r *http.Request)
+}
+
+type receiptHandler struct {
+	service       services.ReceiptService
+	tripService   services.TripService
+	memberService services.MemberService
+}
+
+func NewReceiptHandler(service services.ReceiptService, tripService services.TripService, memberService services.MemberService) ReceiptHandler {
+	return &receiptHandler{service: service, tripService: tripService, memberService: memberService}
+}
+
+// GetReceipts - Harcamanın fişleri (🔒 Protected + Sahip veya üye)
+func (h *receiptHandler) GetReceipts(w http.ResponseWriter, r *http.Request) {
+	trip, _, ok := h.loadTrip(w, r)
+	if !ok {
+		return
+	}
+	expense, ok := findExpense(w, r, trip)
+	if !ok {
+		return
+	}
+
+	receipts, err := h.service.GetReceipts(expense.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(receipts)
+}
+
+// UploadReceipt - Harcamaya fiş ekle; resim veya PDF (🔒 Protected + Sahip veya üye)
+// multipart alanı: file
+func (h *receiptHandler) UploadReceipt(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.loadTrip(w, r)
+	if !ok {
+		return
+	}
+	expense, ok := findExpense(w, r, trip)
+	if !ok {
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, services.MaxReceiptSize+1<<20)
+	if err := r.ParseMultipartForm(services.MaxReceiptSize); err != nil {
+		http.Error(w, "Invalid upload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "File is required", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "Invalid upload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	receipt := &models.Receipt{
+		ExpenseID:    expense.ID,
+		FileName:     header.Filename,
+		UploadedByID: userID,
+	}
+	if err := h.service.UploadReceipt(receipt, data); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Receipt uploaded successfully",
+		"receipt": receipt,
+	})
+}
+
+// DownloadReceipt - Fiş dosyası; ?download=1 ile indirilir, yoksa tarayıcıda açılır (🔒 Protected + Sahip veya üye)
+func (h *receiptHandler) DownloadReceipt(w http.ResponseWriter, r *http.Request) {
+	trip, _, ok := h.loadTrip(w, r)
+	if !ok {
+		return
+	}
+	receipt, ok := h.findReceipt(w, r, trip)
+	if !ok {
+		return
+	}
+
+	data, err := h.service.OpenReceipt(receipt)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	disposition := "inline"
+	if r.URL.Query().Get("download") != "" {
+		disposition = "attachment"
+	}
+	w.Header().Set("Content-Type", receipt.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("%s; filename=%q", disposition, receipt.FileName))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	w.Write(data)
+}
+
+// DeleteReceipt - Fişi sil (🔒 Protected + Gezi sahibi veya fişi yükleyen)
+func (h *receiptHandler) DeleteReceipt(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.loadTrip(w, r)
+	if !ok {
+		return
+	}
+	receipt, ok := h.findReceipt(w, r, trip)
+	if !ok {
+		return
+	}
+	if trip.UserID != userID && receipt.UploadedByID != userID {
+		http.Error(w, "Forbidden - You can only delete receipts you uploaded", http.StatusForbidden)
+		return
+	}
+
+	if err := h.service.DeleteReceipt(receipt); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Receipt deleted successfully",
+	})
+}
+
+// loadTrip - URL'deki geziyi yükler; fişleri gezinin sahibi ve üyeleri görebilir
+func (h *receiptHandler) loadTrip(w http.ResponseWriter, r *http.Request) (*models.Trip, uint, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return nil, 0, false
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid trip ID", http.StatusBadRequest)
+		return nil, 0, false
+	}
+
+	trip, err := h.tripService.GetTripByID(uint(id))
+	if err != nil {
+		http.Error(w, "Trip not found", http.StatusNotFound)
+		return nil, 0, false
+	}
+
+	if !h.memberService.IsParticipant(trip, userID) {
+		http.Error(w, "Forbidden - You are not a member of this trip", http.StatusForbidden)
+		return nil, 0, false
+	}
+
+	return trip, userID, true
+}
+
+// findReceipt - URL'deki fişi yükler ve URL'deki harcamaya ait olduğunu doğrular
+func (h *receiptHandler) findReceipt(w http.ResponseWriter, r *http.Request, trip *models.Trip) (*models.Receipt, bool) {
+	expense, ok := findExpense(w, r, trip)
+	if !ok {
+		return nil, false
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["receiptID"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid receipt ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	receipt, err := h.service.GetReceiptByID(uint(id))
+	if err != nil || receipt.ExpenseID != expense.ID {
+		http.Error(w, "Receipt not found", http.StatusNotFound)
+		return nil, false
+	}
+	return receipt, true
+}
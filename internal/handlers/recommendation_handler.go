@@ -6,20 +6,23 @@ import (
 	"net/http"
 	"strconv"
 	"time"
+	"travel-platform/internal/middleware"
 	"travel-platform/internal/services"
 	pb "travel-platform/proto"
 
 	mux "github.com/gorilla/mux"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 type RecommendationHandler struct {
-	grpcClient  pb.RecommendationServiceClient
-	tripService services.TripService
+	grpcClient    pb.RecommendationServiceClient
+	tripService   services.TripService
+	budgetService services.BudgetService // Kategori bütçeleri tahmine eklenir
 }
 
-func NewRecommendationHandler(tripService services.TripService) *RecommendationHandler {
+func NewRecommendationHandler(tripService services.TripService, budgetService services.BudgetService) *RecommendationHandler {
 	// gRPC Server'a bağlan
 	conn, err := grpc.Dial("localhost:50051",
 		grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -28,25 +31,44 @@ func NewRecommendationHandler(tripService services.TripService) *RecommendationH
 	}
 
 	return &RecommendationHandler{
-		grpcClient:  pb.NewRecommendationServiceClient(conn),
-		tripService: tripService,
+		grpcClient:    pb.NewRecommendationServiceClient(conn),
+		tripService:   tripService,
+		budgetService: budgetService,
 	}
 }
 
-// GET /api/recommendations?user_id=1&max_budget=1500&destination=Paris
+// GET /api/recommendations?user_id=1&max_budget=1500&destination=Paris&month=10
+// Giriş yapılmışsa öneriler oturumdaki kullanıcının gezilerine göre kişiselleşir (user_id yok sayılır);
+// yoksa user_id'nin sadece public gezileri kullanılır
 func (h *RecommendationHandler) GetRecommendations(w http.ResponseWriter, r *http.Request) {
 	userID, _ := strconv.ParseUint(r.URL.Query().Get("user_id"), 10, 32)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if sessionUserID, ok := middleware.GetUserIDFromContext(r); ok {
+		userID = uint64(sessionUserID)
+		// Gizli geziler sadece oturum token'ıyla kullanılır
+		ctx = withSession(ctx, r)
+	}
 	maxBudget, _ := strconv.ParseFloat(r.URL.Query().Get("max_budget"), 64)
 	destination := r.URL.Query().Get("destination")
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
+	// month boş olabilir (her ay); verilirse 1-12 olmalı
+	var month int64
+	if value := r.URL.Query().Get("month"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || parsed < 1 || parsed > 12 {
+			http.Error(w, "month must be between 1 and 12", http.StatusBadRequest)
+			return
+		}
+		month = parsed
+	}
 
 	// gRPC çağrısı
 	resp, err := h.grpcClient.GetRecommendations(ctx, &pb.RecommendationRequest{
 		UserId:               uint32(userID),
 		PreferredDestination: destination,
 		MaxBudget:            maxBudget,
+		TravelMonth:          int32(month),
 	})
 
 	if err != nil {
@@ -59,16 +81,35 @@ func (h *RecommendationHandler) GetRecommendations(w http.ResponseWriter, r *htt
 	json.NewEncoder(w).Encode(resp)
 }
 
+// withSession - Oturum token'ını gRPC çağrısına "authorization: Bearer" olarak ekler
+func withSession(ctx context.Context, r *http.Request) context.Context {
+	if cookie, err := r.Cookie("session_id"); err == nil {
+		return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+cookie.Value)
+	}
+	return ctx
+}
+
 // POST /api/budget/analyze
+// start_date ve end_date verilirse yanıt harcama hızına göre gezi sonu tahminini de içerir.
+// Giriş yapılmışsa kullanıcının özel kategorileri de değerlendirilir
 func (h *RecommendationHandler) AnalyzeBudget(w http.ResponseWriter, r *http.Request) {
 	var req struct {
 		TripID      uint32  `json:"trip_id"`
 		TotalBudget float64 `json:"total_budget"`
+		Currency    string  `json:"currency"` // Bütçenin para birimi (boşsa EUR)
 		Expenses    []struct {
+			Category    string  `json:"category"`
+			Amount      float64 `json:"amount"`
+			Currency    string  `json:"currency"`
+			ExpenseDate string  `json:"expense_date"` // YYYY-MM-DD, kur bu tarihe göre
+		} `json:"expenses"`
+		CategoryBudgets []struct {
 			Category string  `json:"category"`
 			Amount   float64 `json:"amount"`
-			Currency string  `json:"currency"`
-		} `json:"expenses"`
+		} `json:"category_budgets"`
+		StartDate string `json:"start_date"` // YYYY-MM-DD
+		EndDate   string `json:"end_date"`
+		Today     string `json:"today"` // Boşsa bugün
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -80,20 +121,34 @@ func (h *RecommendationHandler) AnalyzeBudget(w http.ResponseWriter, r *http.Req
 	var expenses []*pb.Expense
 	for _, exp := range req.Expenses {
 		expenses = append(expenses, &pb.Expense{
-			Category: exp.Category,
-			Amount:   exp.Amount,
-			Currency: exp.Currency,
+			Category:    exp.Category,
+			Amount:      exp.Amount,
+			Currency:    exp.Currency,
+			ExpenseDate: exp.ExpenseDate,
 		})
 	}
 
+	var categoryBudgets []*pb.CategoryBudget
+	for _, budget := range req.CategoryBudgets {
+		categoryBudgets = append(categoryBudgets, &pb.CategoryBudget{Category: budget.Category, Amount: budget.Amount})
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
+	if _, ok := middleware.GetUserIDFromContext(r); ok {
+		ctx = withSession(ctx, r)
+	}
 
 	// gRPC çağrısı
 	resp, err := h.grpcClient.AnalyzeBudget(ctx, &pb.BudgetAnalysisRequest{
-		TripId:      req.TripID,
-		TotalBudget: req.TotalBudget,
-		Expenses:    expenses,
+		TripId:          req.TripID,
+		TotalBudget:     req.TotalBudget,
+		Currency:        req.Currency,
+		Expenses:        expenses,
+		CategoryBudgets: categoryBudgets,
+		StartDate:       req.StartDate,
+		EndDate:         req.EndDate,
+		Today:           req.Today,
 	})
 
 	if err != nil {
@@ -119,24 +174,44 @@ func (h *RecommendationHandler) AnalyzeBudgetByTripID(w http.ResponseWriter, r *
 		return
 	}
 
-	// Expenses'i protobuf formatına çevir
+	// Expenses'i protobuf formatına çevir; kaydedilirken yakalanan kurlar gönderilir
 	var expenses []*pb.Expense
 	for _, exp := range trip.Expenses {
 		expenses = append(expenses, &pb.Expense{
-			Category: exp.Category,
-			Amount:   exp.Amount,
-			Currency: "EUR",
+			Category:     exp.Category,
+			Amount:       exp.Amount,
+			Currency:     exp.Currency,
+			ExpenseDate:  exp.ExpenseDate.Format("2006-01-02"),
+			ExchangeRate: exp.ExchangeRate,
 		})
 	}
 
+	budgets, err := h.budgetService.GetCategoryBudgets(trip.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	var categoryBudgets []*pb.CategoryBudget
+	for _, budget := range budgets {
+		categoryBudgets = append(categoryBudgets, &pb.CategoryBudget{Category: budget.Category, Amount: budget.Amount})
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
+	// Sahibin özel kategorileri sadece sahibin oturumuyla değerlendirilir
+	if userID, ok := middleware.GetUserIDFromContext(r); ok && userID == trip.UserID {
+		ctx = withSession(ctx, r)
+	}
 
-	// gRPC çağrısı
+	// gRPC çağrısı; gezi tarihleri gönderildiği için yanıt tahmini de içerir
 	resp, err := h.grpcClient.AnalyzeBudget(ctx, &pb.BudgetAnalysisRequest{
-		TripId:      uint32(tripID),
-		TotalBudget: trip.Budget,
-		Expenses:    expenses,
+		TripId:          uint32(tripID),
+		TotalBudget:     trip.Budget,
+		Currency:        trip.Currency,
+		Expenses:        expenses,
+		CategoryBudgets: categoryBudgets,
+		StartDate:       trip.StartDate.Format("2006-01-02"),
+		EndDate:         trip.EndDate.Format("2006-01-02"),
 	})
 
 	if err != nil {
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/report"
+	"travel-platform/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+type ReportHandler interface {
+	TripReport(w http.ResponseWriter, r *http.Request)
+	UserReport(w http.ResponseWriter, r *http.Request)
+}
+
+type reportHandler struct {
+	service       services.ReportService
+	tripService   services.TripService
+	memberService services.MemberService
+}
+
+func NewReportHandler(service services.ReportService, tripService services.TripService, memberService services.MemberService) ReportHandler {
+	return &reportHandler{service: service, tripService: tripService, memberService: memberService}
+}
+
+// reportFormats - format parametresi -> yazıcı, content type ve dosya uzantısı
+var reportFormats = map[string]struct {
+	write       func(w io.Writer, r *report.ExpenseReport) error
+	contentType string
+	extension   string
+}{
+	"csv":  {report.WriteCSV, "text/csv; charset=utf-8", "csv"},
+	"xlsx": {report.WriteXLSX, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", "xlsx"},
+	"pdf":  {report.WritePDF, "application/pdf", "pdf"},
+}
+
+// TripReport - Gezinin masraf raporu (🔒 Protected + Sahip veya üye)
+// Örnek: /api/trips/5/report?format=xlsx&from=2025-06-01&to=2025-06-03 (varsayılan csv, tüm tarihler)
+func (h *reportHandler) TripReport(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid trip ID", http.StatusBadRequest)
+		return
+	}
+
+	trip, err := h.tripService.GetTripByID(uint(id))
+	if err != nil {
+		http.Error(w, "Trip not found", http.StatusNotFound)
+		return
+	}
+
+	if !h.memberService.IsParticipant(trip, userID) {
+		http.Error(w, "Forbidden - You are not a member of this trip", http.StatusForbidden)
+		return
+	}
+
+	from, to, ok := reportRange(w, r)
+	if !ok {
+		return
+	}
+
+	expenseReport, err := h.service.TripReport(trip, from, to)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	writeReport(w, r, expenseReport, fmt.Sprintf("trip-%d-expenses", trip.ID))
+}
+
+// UserReport - Kullanıcının tüm gezilerinin masraf raporu (🔒 Protected)
+// Örnek: /api/reports/expenses?format=pdf&from=2025-01-01&to=2025-12-31&currency=USD (varsayılan EUR)
+func (h *reportHandler) UserReport(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	from, to, ok := reportRange(w, r)
+	if !ok {
+		return
+	}
+
+	expenseReport, err := h.service.UserReport(userID, from, to, r.URL.Query().Get("currency"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	writeReport(w, r, expenseReport, "expenses")
+}
+
+// reportRange - from/to sorgu parametreleri (YYYY-MM-DD); verilmeyen uç açık kalır
+func reportRange(w http.ResponseWriter, r *http.Request) (time.Time, time.Time, bool) {
+	var bounds [2]time.Time
+	for i, name := range []string{"from", "to"} {
+		value := r.URL.Query().Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid %s date. Use YYYY-MM-DD", name), http.StatusBadRequest)
+			return time.Time{}, time.Time{}, false
+		}
+		bounds[i] = parsed
+	}
+	return bounds[0], bounds[1], true
+}
+
+// writeReport - Raporu istenen formatta dosya olarak döner
+func writeReport(w http.ResponseWriter, r *http.Request, expenseReport *report.ExpenseReport, name string) {
+	formatName := r.URL.Query().Get("format")
+	if formatName == "" {
+		formatName = "csv"
+	}
+	format, exists := reportFormats[formatName]
+	if !exists {
+		http.Error(w, "Unsupported format. Use csv, xlsx or pdf", http.StatusBadRequest)
+		return
+	}
+
+	// Önce belleğe yaz; hata olursa yarım dosya yerine düzgün bir HTTP hatası dönebilelim
+	var buf bytes.Buffer
+	if err := format.write(&buf, expenseReport); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	filename := fmt.Sprintf("%s-%s.%s", name, time.Now().Format("20060102"), format.extension)
+	w.Header().Set("Content-Type", format.contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.Write(buf.Bytes())
+}
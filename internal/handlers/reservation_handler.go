@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+	"travel-platform/internal/importer"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+type ReservationHandler interface {
+	GetReservations(w http.ResponseWriter, r *http.Request)
+	CreateReservation(w http.ResponseWriter, r *http.Request)
+	UpdateReservation(w http.ResponseWriter, r *http.Request)
+	DeleteReservation(w http.ResponseWriter, r *http.Request)
+	GetItinerary(w http.ResponseWriter, r *http.Request)
+	ExportCalendar(w http.ResponseWriter, r *http.Request)
+}
+
+type reservationHandler struct {
+	service       services.ReservationService
+	tripService   services.TripService
+	memberService services.MemberService
+}
+
+func NewReservationHandler(service services.ReservationService, tripService services.TripService, memberService services.MemberService) ReservationHandler {
+	return &reservationHandler{service: service, tripService: tripService, memberService: memberService}
+}
+
+// reservationRequest - Rezervasyon ekleme/güncelleme body'si
+// Zamanlar RFC3339 veya "YYYY-MM-DDTHH:MM" (datetime-local) formatında olabilir
+type reservationRequest struct {
+	Type               string  `json:"type"`
+	ConfirmationNumber string  `json:"confirmation_number"`
+	Provider           string  `json:"provider"`
+	FlightNumber       string  `json:"flight_number"`
+	DepartureAirport   string  `json:"departure_airport"`
+	ArrivalAirport     string  `json:"arrival_airport"`
+	Address            string  `json:"address"`
+	Origin             string  `json:"origin"`
+	Destination        string  `json:"destination"`
+	StartAt            string  `json:"start_at"`
+	EndAt              string  `json:"end_at"`
+	Notes              string  `json:"notes"`
+	Cost               float64 `json:"cost"`
+	Currency           string  `json:"currency"`
+}
+
+// GetReservations - Gezinin rezervasyonları (🔒 Protected + Sahip veya üye)
+func (h *reservationHandler) GetReservations(w http.ResponseWriter, r *http.Request) {
+	trip, _, ok := h.loadTrip(w, r, false)
+	if !ok {
+		return
+	}
+
+	reservations, err := h.service.GetReservations(trip.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(reservations)
+}
+
+// CreateReservation - Geziye rezervasyon ekle (🔒 Protected + Ownership kontrolü)
+func (h *reservationHandler) CreateReservation(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.loadTrip(w, r, true)
+	if !ok {
+		return
+	}
+
+	reservation := &models.Reservation{TripID: trip.ID}
+	if !decodeReservation(w, r, reservation) {
+		return
+	}
+
+	if err := h.service.CreateReservation(trip, reservation, userID); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":     "Reservation added successfully",
+		"reservation": reservation,
+	})
+}
+
+// UpdateReservation - Rezervasyonu güncelle (🔒 Protected + Ownership kontrolü)
+func (h *reservationHandler) UpdateReservation(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.loadTrip(w, r, true)
+	if !ok {
+		return
+	}
+
+	reservation, ok := h.findReservation(w, r, trip)
+	if !ok {
+		return
+	}
+	if !decodeReservation(w, r, reservation) {
+		return
+	}
+
+	if err := h.service.UpdateReservation(trip, reservation, userID); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":     "Reservation updated successfully",
+		"reservation": reservation,
+	})
+}
+
+// DeleteReservation - Rezervasyonu ve bağlı harcamayı sil (🔒 Protected + Ownership kontrolü)
+func (h *reservationHandler) DeleteReservation(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.loadTrip(w, r, true)
+	if !ok {
+		return
+	}
+
+	reservation, ok := h.findReservation(w, r, trip)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteReservation(trip, reservation, userID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Reservation deleted successfully",
+	})
+}
+
+// GetItinerary - Aktivite ve rezervasyonların gün gün planı (🔒 Protected + Sahip veya üye)
+func (h *reservationHandler) GetItinerary(w http.ResponseWriter, r *http.Request) {
+	trip, _, ok := h.loadTrip(w, r, false)
+	if !ok {
+		return
+	}
+
+	reservations, err := h.service.GetReservations(trip.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(services.BuildItinerary(trip, reservations))
+}
+
+// ExportCalendar - Geziyi .ics takvim dosyası olarak indir (🔒 Protected + Sahip veya üye)
+func (h *reservationHandler) ExportCalendar(w http.ResponseWriter, r *http.Request) {
+	trip, _, ok := h.loadTrip(w, r, false)
+	if !ok {
+		return
+	}
+
+	reservations, err := h.service.GetReservations(trip.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="trip-%d.ics"`, trip.ID))
+	if err := importer.WriteICS(w, trip.Title, services.CalendarEvents(trip, reservations)); err != nil {
+		log.Printf("Calendar export for trip %d failed: %v", trip.ID, err)
+	}
+}
+
+// loadTrip - URL'deki geziyi yükler; ownerOnly ise sadece sahip, değilse üyeler de erişebilir
+func (h *reservationHandler) loadTrip(w http.ResponseWriter, r *http.Request, ownerOnly bool) (*models.Trip, uint, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return nil, 0, false
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid trip ID", http.StatusBadRequest)
+		return nil, 0, false
+	}
+
+	trip, err := h.tripService.GetTripByID(uint(id))
+	if err != nil {
+		http.Error(w, "Trip not found", http.StatusNotFound)
+		return nil, 0, false
+	}
+
+	if ownerOnly && trip.UserID != userID {
+		http.Error(w, "Forbidden - You can only modify your own trips", http.StatusForbidden)
+		return nil, 0, false
+	}
+	if !h.memberService.IsParticipant(trip, userID) {
+		http.Error(w, "Forbidden - You are not a member of this trip", http.StatusForbidden)
+		return nil, 0, false
+	}
+
+	return trip, userID, true
+}
+
+// findReservation - URL'deki rezervasyonu yükler ve geziye ait olduğunu doğrular
+func (h *reservationHandler) findReservation(w http.ResponseWriter, r *http.Request, trip *models.Trip) (*models.Reservation, bool) {
+	id, err := strconv.ParseUint(mux.Vars(r)["reservationID"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid reservation ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	reservation, err := h.service.GetReservationByID(uint(id))
+	if err != nil || reservation.TripID != trip.ID {
+		http.Error(w, "Reservation not found", http.StatusNotFound)
+		return nil, false
+	}
+
+	return reservation, true
+}
+
+// decodeReservation - Body'yi okuyup rezervasyona yazar
+func decodeReservation(w http.ResponseWriter, r *http.Request, reservation *models.Reservation) bool {
+	var req reservationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return false
+	}
+
+	startAt, err := parseDateTime(req.StartAt)
+	if err != nil {
+		http.Error(w, "Invalid start_at format. Use RFC3339 or YYYY-MM-DDTHH:MM", http.StatusBadRequest)
+		return false
+	}
+
+	var endAt *time.Time
+	if req.EndAt != "" {
+		t, err := parseDateTime(req.EndAt)
+		if err != nil {
+			http.Error(w, "Invalid end_at format. Use RFC3339 or YYYY-MM-DDTHH:MM", http.StatusBadRequest)
+			return false
+		}
+		endAt = &t
+	}
+
+	reservation.Type = req.Type
+	reservation.ConfirmationNumber = req.ConfirmationNumber
+	reservation.Provider = req.Provider
+	reservation.FlightNumber = req.FlightNumber
+	reservation.DepartureAirport = req.DepartureAirport
+	reservation.ArrivalAirport = req.ArrivalAirport
+	reservation.Address = req.Address
+	reservation.Origin = req.Origin
+	reservation.Destination = req.Destination
+	reservation.StartAt = startAt
+	reservation.EndAt = endAt
+	reservation.Notes = req.Notes
+	reservation.Cost = req.Cost
+	reservation.Currency = req.Currency
+	return true
+}
+
+func parseDateTime(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date/time %q", value)
+}
@@ -0,0 +1,232 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+type SplitHandler interface {
+	SetExpenseSplit(w http.ResponseWriter, r *http.Request)
+	GetBalances(w http.ResponseWriter, r *http.Request)
+	GetSettlements(w http.ResponseWriter, r *http.Request)
+	CreateSettlement(w http.ResponseWriter, r *http.Request)
+	DeleteSettlement(w http.ResponseWriter, r *http.Request)
+}
+
+type splitHandler struct {
+	service       services.SplitService
+	tripService   services.TripService
+	memberService services.MemberService
+}
+
+func NewSplitHandler(service services.SplitService, tripService services.TripService, memberService services.MemberService) SplitHandler {
+	return &splitHandler{service: service, tripService: tripService, memberService: memberService}
+}
+
+// SetExpenseSplit - Harcamanın ödeyenini ve paylarını belirle (🔒 Protected + Ownership kontrolü)
+// split_type boş gönderilirse paylaşım kaldırılır; "equal" ve splits boşsa tüm katılımcılara bölünür
+func (h *splitHandler) SetExpenseSplit(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.loadTrip(w, r, true)
+	if !ok {
+		return
+	}
+
+	expense, ok := findExpense(w, r, trip)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		PayerID   *uint  `json:"payer_id"`
+		SplitType string `json:"split_type"`
+		Splits    []struct {
+			UserID uint    `json:"user_id"`
+			Value  float64 `json:"value"`
+		} `json:"splits"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	var splits []models.ExpenseSplit
+	for _, split := range req.Splits {
+		splits = append(splits, models.ExpenseSplit{UserID: split.UserID, Value: split.Value})
+	}
+
+	if err := h.service.SetSplit(trip, expense, req.PayerID, req.SplitType, splits, userID); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Expense split updated successfully",
+		"expense": expense,
+	})
+}
+
+// GetBalances - Katılımcıların net durumu ve önerilen transferler (🔒 Protected + Sahip veya üye)
+func (h *splitHandler) GetBalances(w http.ResponseWriter, r *http.Request) {
+	trip, _, ok := h.loadTrip(w, r, false)
+	if !ok {
+		return
+	}
+
+	balances, err := h.service.GetBalances(trip)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(balances)
+}
+
+// GetSettlements - Gezinin kayıtlı ödemeleri (🔒 Protected + Sahip veya üye)
+func (h *splitHandler) GetSettlements(w http.ResponseWriter, r *http.Request) {
+	trip, _, ok := h.loadTrip(w, r, false)
+	if !ok {
+		return
+	}
+
+	settlements, err := h.service.GetSettlements(trip.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(settlements)
+}
+
+// CreateSettlement - Ödeme kaydet (🔒 Protected + Sahip veya üye)
+// Üyeler sadece kendi yaptıkları ödemeyi kaydedebilir, sahip herkes adına kaydedebilir
+func (h *splitHandler) CreateSettlement(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.loadTrip(w, r, false)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		FromUserID uint    `json:"from_user_id"`
+		ToUserID   uint    `json:"to_user_id"`
+		Amount     float64 `json:"amount"`
+		Currency   string  `json:"currency"`
+		PaidAt     string  `json:"paid_at"` // YYYY-MM-DD, boşsa bugün
+		Note       string  `json:"note"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.FromUserID == 0 {
+		req.FromUserID = userID
+	}
+	if req.FromUserID != userID && trip.UserID != userID {
+		http.Error(w, "Forbidden - You can only record payments you made", http.StatusForbidden)
+		return
+	}
+
+	settlement := &models.Settlement{
+		FromUserID: req.FromUserID,
+		ToUserID:   req.ToUserID,
+		Amount:     req.Amount,
+		Currency:   req.Currency,
+		Note:       req.Note,
+	}
+	if req.PaidAt != "" {
+		paidAt, err := time.Parse("2006-01-02", req.PaidAt)
+		if err != nil {
+			http.Error(w, "Invalid date format. Use YYYY-MM-DD", http.StatusBadRequest)
+			return
+		}
+		settlement.PaidAt = paidAt
+	}
+
+	if err := h.service.RecordSettlement(trip, settlement, userID); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":    "Payment recorded successfully",
+		"settlement": settlement,
+	})
+}
+
+// DeleteSettlement - Ödeme kaydını sil (🔒 Protected + Sahip veya kaydı oluşturan)
+func (h *splitHandler) DeleteSettlement(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.loadTrip(w, r, false)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["settlementID"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid settlement ID", http.StatusBadRequest)
+		return
+	}
+
+	settlement, err := h.service.GetSettlementByID(uint(id))
+	if err != nil || settlement.TripID != trip.ID {
+		http.Error(w, "Settlement not found", http.StatusNotFound)
+		return
+	}
+	if trip.UserID != userID && settlement.CreatedByID != userID {
+		http.Error(w, "Forbidden - You can only delete payments you recorded", http.StatusForbidden)
+		return
+	}
+
+	if err := h.service.DeleteSettlement(settlement); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Payment deleted successfully",
+	})
+}
+
+// loadTrip - URL'deki geziyi yükler; ownerOnly ise sadece sahip, değilse üyeler de erişebilir
+func (h *splitHandler) loadTrip(w http.ResponseWriter, r *http.Request, ownerOnly bool) (*models.Trip, uint, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return nil, 0, false
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid trip ID", http.StatusBadRequest)
+		return nil, 0, false
+	}
+
+	trip, err := h.tripService.GetTripByID(uint(id))
+	if err != nil {
+		http.Error(w, "Trip not found", http.StatusNotFound)
+		return nil, 0, false
+	}
+
+	if ownerOnly && trip.UserID != userID {
+		http.Error(w, "Forbidden - You can only modify your own trips", http.StatusForbidden)
+		return nil, 0, false
+	}
+	if !h.memberService.IsParticipant(trip, userID) {
+		http.Error(w, "Forbidden - You are not a member of this trip", http.StatusForbidden)
+		return nil, 0, false
+	}
+
+	return trip, userID, true
+}
@@ -6,20 +6,69 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"travel-platform/internal/currency"
 	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
 	"travel-platform/internal/services"
 
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/gorilla/mux"
 )
 
 type TemplateHandler struct {
-	templates   *template.Template
-	userService services.UserService
-	tripService services.TripService
+	templates           *template.Template
+	userService         services.UserService
+	tripService         services.TripService
+	checklistService    services.ChecklistService
+	memberService       services.MemberService
+	reservationService  services.ReservationService
+	documentService     services.DocumentService
+	currencyService     services.CurrencyService
+	splitService        services.SplitService
+	budgetService       services.BudgetService
+	categoryService     services.CategoryService
+	notificationService services.NotificationService
+}
+
+// NotificationsPageData - Bildirimler sayfasının verisi
+type NotificationsPageData struct {
+	Notifications []models.Notification
+	Settings      *models.NotificationSettings
+}
+
+// CategoriesPageData - Kategori yönetim sayfasının verisi
+type CategoriesPageData struct {
+	Categories []models.ExpenseCategory
+	Usage      map[string]int // Kategori anahtarı -> kullanıcının gezilerindeki harcama sayısı
+	Unlisted   []string       // Harcamalarda geçen ama katalogda olmayan eski kategoriler
+}
+
+// DocumentsPageData - Belge kasası sayfasının verisi
+type DocumentsPageData struct {
+	Documents []models.TravelDocument
+	Reminders []services.DocumentReminder
+	Trips     []models.Trip // Belgeyi bağlamak için kullanıcının gezileri
+}
+
+// TripDetailData - Gezi detay sayfasının verisi; listeler ve rezervasyonlar sadece sahip ve üyelere gösterilir
+type TripDetailData struct {
+	Trip          *models.Trip
+	IsOwner       bool
+	IsParticipant bool
+	Members       []models.TripMember
+	Checklists    []models.Checklist
+	Progress      services.ChecklistProgress
+	Reservations  []models.Reservation
+	Itinerary     []services.ItineraryDay
+	Spending      services.Spending // Gezinin para birimine çevrilmiş toplamlar
+	Balances      *services.TripBalances
+	Settlements   []models.Settlement
+	Forecast      *services.BudgetForecast // Kategori bütçeleri ve harcama hızından gezi sonu tahmini
+	UserID        uint
 }
 
 type TemplateData struct {
@@ -31,7 +80,7 @@ type TemplateData struct {
 	IsAuthenticated bool
 }
 
-func NewTemplateHandler(userService services.UserService, tripService services.TripService) *TemplateHandler {
+func NewTemplateHandler(userService services.UserService, tripService services.TripService, checklistService services.ChecklistService, memberService services.MemberService, reservationService services.ReservationService, documentService services.DocumentService, currencyService services.CurrencyService, splitService services.SplitService, budgetService services.BudgetService, categoryService services.CategoryService, notificationService services.NotificationService) *TemplateHandler {
 	funcMap := template.FuncMap{
 		"add": func(a, b int) int {
 			return a + b
@@ -73,6 +122,62 @@ func NewTemplateHandler(userService services.UserService, tripService services.T
 		"now": func() time.Time {
 			return time.Now()
 		},
+		"currencySymbol": currency.Symbol,
+		"currencies": func() []string {
+			return currencyService.Currencies()
+		},
+		"deref": func(id *uint) uint {
+			if id == nil {
+				return 0
+			}
+			return *id
+		},
+		"neg": func(value float64) float64 {
+			return -value
+		},
+		// splitFor - Kullanıcının harcamadaki payı (yoksa nil)
+		"splitFor": func(expense models.Expense, userID uint) *models.ExpenseSplit {
+			for i := range expense.Splits {
+				if expense.Splits[i].UserID == userID {
+					return &expense.Splits[i]
+				}
+			}
+			return nil
+		},
+		"statusLabel": func(status string) string {
+			switch status {
+			case models.TripStatusInProgress:
+				return "In Progress"
+			case models.TripStatusCompleted:
+				return "Completed"
+			case models.TripStatusCancelled:
+				return "Cancelled"
+			}
+			return "Upcoming"
+		},
+		"purgeDate": func(deletedAt time.Time) time.Time {
+			return deletedAt.Add(services.TrashRetention)
+		},
+		// expenseCategories - Kullanıcının kataloğu; hata olursa sistem kategorileri
+		"expenseCategories": func(userID uint) []models.ExpenseCategory {
+			categories, err := categoryService.GetCategories(userID)
+			if err != nil {
+				return services.DefaultCategories
+			}
+			return categories
+		},
+		// categoryFor - Harcamanın kategorisi; katalogda olmayan eski kategoriler gri etiketle gösterilir
+		// unreadNotifications - Navbar'daki okunmamış bildirim sayısı
+		"unreadNotifications": func(userID uint) int64 {
+			count, _ := notificationService.CountUnread(userID)
+			return count
+		},
+		"categoryFor": func(categories []models.ExpenseCategory, key string) models.ExpenseCategory {
+			if category, found := services.FindCategory(categories, key); found {
+				return category
+			}
+			return models.ExpenseCategory{Key: key, Name: key, Color: "#6b7280", Icon: "receipt"}
+		},
 	}
 
 	layoutFiles, err := filepath.Glob("web/templates/layout/*.html")
@@ -98,9 +203,18 @@ func NewTemplateHandler(userService services.UserService, tripService services.T
 	log.Println("✅ Shared templates loaded")
 
 	return &TemplateHandler{
-		templates:   tmpl,
-		userService: userService,
-		tripService: tripService,
+		templates:           tmpl,
+		userService:         userService,
+		tripService:         tripService,
+		checklistService:    checklistService,
+		memberService:       memberService,
+		reservationService:  reservationService,
+		documentService:     documentService,
+		currencyService:     currencyService,
+		splitService:        splitService,
+		budgetService:       budgetService,
+		categoryService:     categoryService,
+		notificationService: notificationService,
 	}
 }
 
@@ -193,6 +307,147 @@ func (h *TemplateHandler) Dashboard(w http.ResponseWriter, r *http.Request) {
 	h.render(w, "dashboard.html", data)
 }
 
+func (h *TemplateHandler) TrashPage(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	user, err := h.userService.GetProfile(userID)
+	if err != nil {
+		http.Error(w, "User not found", http.StatusNotFound)
+		return
+	}
+
+	trips, err := h.tripService.GetTrash(userID)
+
+	data := &TemplateData{
+		Title:           "Trash - TravelMate",
+		User:            user,
+		Data:            trips,
+		IsAuthenticated: true,
+	}
+
+	if err != nil {
+		data.Error = "Unable to load your trash"
+	}
+
+	h.render(w, "trash.html", data)
+}
+
+func (h *TemplateHandler) DocumentsPage(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	user, err := h.userService.GetProfile(userID)
+	if err != nil {
+		http.Error(w, "User not found", http.StatusNotFound)
+		return
+	}
+
+	pageData := &DocumentsPageData{}
+	data := &TemplateData{
+		Title:           "Travel Documents - TravelMate",
+		User:            user,
+		Data:            pageData,
+		IsAuthenticated: true,
+	}
+
+	pageData.Documents, err = h.documentService.GetDocuments(userID)
+	if err != nil {
+		data.Error = "Unable to load your documents"
+	}
+	pageData.Reminders, _ = h.documentService.GetReminders(userID, time.Now())
+	pageData.Trips, _ = h.tripService.GetTripByUserID(userID)
+
+	h.render(w, "documents.html", data)
+}
+
+// CategoriesPage - Harcama kategorilerini yönetme sayfası
+func (h *TemplateHandler) CategoriesPage(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	user, err := h.userService.GetProfile(userID)
+	if err != nil {
+		http.Error(w, "User not found", http.StatusNotFound)
+		return
+	}
+
+	pageData := &CategoriesPageData{Usage: make(map[string]int)}
+	data := &TemplateData{
+		Title:           "Expense Categories - TravelMate",
+		User:            user,
+		Data:            pageData,
+		IsAuthenticated: true,
+	}
+
+	pageData.Categories, err = h.categoryService.GetCategories(userID)
+	if err != nil {
+		data.Error = "Unable to load your categories"
+	}
+
+	trips, _ := h.tripService.GetTripByUserID(userID)
+	for _, trip := range trips {
+		for _, expense := range trip.Expenses {
+			category, found := services.FindCategory(pageData.Categories, expense.Category)
+			if !found {
+				if pageData.Usage[expense.Category] == 0 {
+					pageData.Unlisted = append(pageData.Unlisted, expense.Category)
+				}
+				pageData.Usage[expense.Category]++
+				continue
+			}
+			pageData.Usage[category.Key]++
+		}
+	}
+	sort.Strings(pageData.Unlisted)
+
+	h.render(w, "categories.html", data)
+}
+
+// NotificationsPage - Bütçe uyarıları ve bildirim kanalı ayarları
+func (h *TemplateHandler) NotificationsPage(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	user, err := h.userService.GetProfile(userID)
+	if err != nil {
+		http.Error(w, "User not found", http.StatusNotFound)
+		return
+	}
+
+	pageData := &NotificationsPageData{}
+	data := &TemplateData{
+		Title:           "Notifications - TravelMate",
+		User:            user,
+		Data:            pageData,
+		IsAuthenticated: true,
+	}
+
+	pageData.Notifications, err = h.notificationService.GetNotifications(userID, false)
+	if err != nil {
+		data.Error = "Unable to load your notifications"
+	}
+	pageData.Settings, err = h.notificationService.GetSettings(userID)
+	if err != nil {
+		defaults := models.DefaultNotificationSettings(userID)
+		pageData.Settings = &defaults
+	}
+
+	h.render(w, "notifications.html", data)
+}
+
 func (h *TemplateHandler) CreateTripPage(w http.ResponseWriter, r *http.Request) {
 	userID, ok := middleware.GetUserIDFromContext(r)
 	if !ok {
@@ -229,15 +484,31 @@ func (h *TemplateHandler) TripDetailPage(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	detail := &TripDetailData{Trip: trip, Spending: services.SummarizeSpending(trip)}
 	data := &TemplateData{
 		Title: trip.Title + " - TravelMate",
-		Data:  trip,
+		Data:  detail,
 	}
 
 	if userID, ok := middleware.GetUserIDFromContext(r); ok {
 		user, _ := h.userService.GetProfile(userID)
 		data.User = user
 		data.IsAuthenticated = true
+
+		detail.UserID = userID
+		detail.IsOwner = trip.UserID == userID
+		detail.IsParticipant = h.memberService.IsParticipant(trip, userID)
+	}
+
+	if detail.IsParticipant {
+		detail.Members, _ = h.memberService.GetMembers(trip.ID)
+		detail.Checklists, _ = h.checklistService.GetChecklists(trip.ID)
+		detail.Progress = services.Progress(detail.Checklists)
+		detail.Reservations, _ = h.reservationService.GetReservations(trip.ID)
+		detail.Itinerary = services.BuildItinerary(trip, detail.Reservations)
+		detail.Balances, _ = h.splitService.GetBalances(trip)
+		detail.Settlements, _ = h.splitService.GetSettlements(trip.ID)
+		detail.Forecast, _ = h.budgetService.Forecast(trip, time.Now())
 	}
 
 	h.render(w, "trip_detail.html", data)
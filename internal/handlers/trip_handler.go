@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 	"travel-platform/internal/middleware"
 	"travel-platform/internal/models"
@@ -21,19 +22,50 @@ type TripHandler interface {
 	SearchTrips(w http.ResponseWriter, r *http.Request)
 	UpdateTrip(w http.ResponseWriter, r *http.Request)
 	DeleteTrip(w http.ResponseWriter, r *http.Request)
+	UpdateTripStatus(w http.ResponseWriter, r *http.Request)
+	GetTrash(w http.ResponseWriter, r *http.Request)
+	RestoreTrip(w http.ResponseWriter, r *http.Request)
+	PurgeTrip(w http.ResponseWriter, r *http.Request)
+	AddActivity(w http.ResponseWriter, r *http.Request)
+	UpdateActivity(w http.ResponseWriter, r *http.Request)
+	DeleteActivity(w http.ResponseWriter, r *http.Request)
+	AddExpense(w http.ResponseWriter, r *http.Request)
+	UpdateExpense(w http.ResponseWriter, r *http.Request)
+	DeleteExpense(w http.ResponseWriter, r *http.Request)
+	GetTripHistory(w http.ResponseWriter, r *http.Request)
+	RevertTrip(w http.ResponseWriter, r *http.Request)
 }
 
 // Struct (private)
 type tripHandler struct {
-	service services.TripService
+	service      services.TripService
+	writeService services.TripWriteService // Oluşturma ve düzenleme gRPC ile ortak
 }
 
 // Constructor
-func NewTripHandler(service services.TripService) TripHandler {
-	return &tripHandler{service: service}
+func NewTripHandler(service services.TripService, writeService services.TripWriteService) TripHandler {
+	return &tripHandler{service: service, writeService: writeService}
+}
+
+// RegisterTripRoutes - Gezi CRUD rotalarını API router'ına ekler
+// mux ilk eşleşen rotayı kullanır; sabit yollar (/trips/my, /trips/public, /trips/search) /trips/{id}'den önce gelmeli.
+// /trips/trash, /trips/export gibi diğer sabit yollar bu fonksiyon çağrılmadan önce eklenmeli
+func RegisterTripRoutes(api *mux.Router, h TripHandler) {
+	api.HandleFunc("/trips",
+		middleware.AuthMiddleware(h.CreateTrip)).Methods("POST")
+	api.HandleFunc("/trips/my",
+		middleware.AuthMiddleware(h.GetMyTrips)).Methods("GET")
+	api.HandleFunc("/trips/public", h.GetPublicTrips).Methods("GET")
+	api.HandleFunc("/trips/search", h.SearchTrips).Methods("GET")
+	api.HandleFunc("/trips/{id}", h.GetTripByID).Methods("GET")
+	api.HandleFunc("/trips/{id}",
+		middleware.AuthMiddleware(h.UpdateTrip)).Methods("PUT")
+	api.HandleFunc("/trips/{id}",
+		middleware.AuthMiddleware(h.DeleteTrip)).Methods("DELETE")
 }
 
 // CreateTrip (🔒 Protected)
+// Geçersiz aktivite veya harcama varsa gezi oluşturulmaz (400)
 func (h *tripHandler) CreateTrip(w http.ResponseWriter, r *http.Request) {
 	userID, ok := middleware.GetUserIDFromContext(r)
 	if !ok {
@@ -41,101 +73,16 @@ func (h *tripHandler) CreateTrip(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Request body'yi parse et
-	var req struct {
-		Title       string  `json:"title"`
-		Destination string  `json:"destination"`
-		StartDate   string  `json:"start_date"`
-		EndDate     string  `json:"end_date"`
-		Description string  `json:"description"`
-		Budget      float64 `json:"budget"`
-		IsPublic    bool    `json:"is_public"`
-
-		// 🆕 Nested Activities ve Expenses (OPSİYONEL)
-		Activities []struct {
-			Name        string `json:"name"`
-			Description string `json:"description"`
-			Location    string `json:"location"`
-			Date        string `json:"date"`
-		} `json:"activities,omitempty"`
-
-		Expenses []struct {
-			Category    string  `json:"category"`
-			Amount      float64 `json:"amount"`
-			ExpenseDate string  `json:"expense_date"`
-		} `json:"expenses,omitempty"`
-	}
-
+	// Request body'yi parse et (activities ve expenses opsiyonel)
+	var req services.TripInput
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	// String tarihleri time.Time'a çevir
-	startDate, err := time.Parse("2006-01-02", req.StartDate)
-	if err != nil {
-		http.Error(w, "Invalid start_date format. Use YYYY-MM-DD", http.StatusBadRequest)
-		return
-	}
-
-	endDate, err := time.Parse("2006-01-02", req.EndDate)
-	if err != nil {
-		http.Error(w, "Invalid end_date format. Use YYYY-MM-DD", http.StatusBadRequest)
-		return
-	}
-
-	// Trip modeli oluştur
-	trip := &models.Trip{
-		UserID:      userID,
-		Title:       req.Title,
-		Destination: req.Destination,
-		StartDate:   startDate,
-		EndDate:     endDate,
-		Description: req.Description,
-		Budget:      req.Budget,
-		IsPublic:    req.IsPublic,
-	}
-	// 🆕 Activities varsa ekle
-	if len(req.Activities) > 0 {
-		for _, actReq := range req.Activities {
-			if actReq.Name == "" || actReq.Date == "" {
-				continue // Skip invalid activities
-			}
-
-			actDate, err := time.Parse("2006-01-02", actReq.Date)
-			if err != nil {
-				continue // Skip if date is invalid
-			}
-
-			activity := models.Activity{
-				Name:        actReq.Name,
-				Description: actReq.Description,
-				Location:    actReq.Location,
-				Date:        actDate,
-			}
-			trip.Activities = append(trip.Activities, activity)
-		}
-	}
-
-	// 🆕 Expenses varsa ekle
-	if len(req.Expenses) > 0 {
-		for _, expReq := range req.Expenses {
-			expenseDate, err := time.Parse("2006-01-02", expReq.ExpenseDate)
-			if err != nil {
-				continue // Skip invalid expenses
-			}
-
-			expense := models.Expense{
-				Category:    expReq.Category,
-				Amount:      expReq.Amount,
-				ExpenseDate: expenseDate,
-			}
-			trip.Expenses = append(trip.Expenses, expense)
-		}
-	}
-
 	// Service'e gönder (GORM otomatik olarak activities ve expenses'i de kaydeder)
-	if err := h.service.CreateTrip(trip); err != nil {
+	trip, _, err := h.writeService.CreateTrip(userID, req)
+	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -172,6 +119,7 @@ func (h *tripHandler) GetTripByID(w http.ResponseWriter, r *http.Request) {
 }
 
 // GetMyTrips - Kullanıcının kendi gezileri (🔒 Protected)
+// Örnek: /api/trips/my?status=upcoming,in_progress
 func (h *tripHandler) GetMyTrips(w http.ResponseWriter, r *http.Request) {
 	// Context'ten userID al
 	userID, ok := middleware.GetUserIDFromContext(r)
@@ -180,11 +128,21 @@ func (h *tripHandler) GetMyTrips(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Service'den gezileri al
-	trips, err := h.service.GetTripByUserID(userID)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+	// Service'den gezileri al (status verilmişse filtrele)
+	var trips []models.Trip
+	var err error
+	if status := r.URL.Query().Get("status"); status != "" {
+		trips, err = h.service.GetTripsByStatus(userID, strings.Split(status, ","))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	} else {
+		trips, err = h.service.GetTripByUserID(userID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
 	}
 
 	// Response
@@ -257,45 +215,14 @@ func (h *tripHandler) UpdateTrip(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Request body'yi parse et
-	var req struct {
-		Title       string  `json:"title"`
-		Destination string  `json:"destination"`
-		StartDate   string  `json:"start_date"`
-		EndDate     string  `json:"end_date"`
-		Description string  `json:"description"`
-		Budget      float64 `json:"budget"`
-		IsPublic    bool    `json:"is_public"`
-	}
-
+	var req services.TripInput
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	// Trip'i güncelle
-	trip.Title = req.Title
-	trip.Destination = req.Destination
-	trip.Description = req.Description
-	trip.Budget = req.Budget
-	trip.IsPublic = req.IsPublic
-
-	// Tarihleri güncelle (eğer gönderilmişse)
-	if req.StartDate != "" {
-		startDate, err := time.Parse("2006-01-02", req.StartDate)
-		if err == nil {
-			trip.StartDate = startDate
-		}
-	}
-
-	if req.EndDate != "" {
-		endDate, err := time.Parse("2006-01-02", req.EndDate)
-		if err == nil {
-			trip.EndDate = endDate
-		}
-	}
-
 	// Service'e gönder
-	if err := h.service.UpdateTrip(trip); err != nil {
+	if _, err := h.writeService.UpdateTrip(trip, userID, req); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -339,7 +266,7 @@ func (h *tripHandler) DeleteTrip(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Service'den sil
-	if err := h.service.DeleteTrip(uint(id)); err != nil {
+	if err := h.service.DeleteTrip(uint(id), userID); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -347,6 +274,140 @@ func (h *tripHandler) DeleteTrip(w http.ResponseWriter, r *http.Request) {
 	// Response
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]string{
-		"message": "Trip deleted successfully",
+		"message": "Trip moved to trash",
 	})
 }
+
+// UpdateTripStatus - Gezi durumunu değiştir, örn. iptal (🔒 Protected + Ownership kontrolü)
+func (h *tripHandler) UpdateTripStatus(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.ownedTrip(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Status string `json:"status"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	updated, err := h.service.ChangeTripStatus(trip.ID, req.Status, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Trip status updated",
+		"trip":    updated,
+	})
+}
+
+// GetTrash - Kullanıcının çöp kutusundaki gezileri (🔒 Protected)
+func (h *tripHandler) GetTrash(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	trips, err := h.service.GetTrash(userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Her gezi için kalıcı silinme zamanı
+	type trashItem struct {
+		Trip      models.Trip `json:"trip"`
+		DeletedAt time.Time   `json:"deleted_at"`
+		PurgeAt   time.Time   `json:"purge_at"`
+	}
+	items := []trashItem{}
+	for _, trip := range trips {
+		items = append(items, trashItem{
+			Trip:      trip,
+			DeletedAt: trip.DeletedAt.Time,
+			PurgeAt:   trip.DeletedAt.Time.Add(services.TrashRetention),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(items)
+}
+
+// RestoreTrip - Çöp kutusundaki geziyi geri yükle (🔒 Protected + Ownership kontrolü)
+func (h *tripHandler) RestoreTrip(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.deletedTripID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, _ := middleware.GetUserIDFromContext(r)
+	if err := h.service.RestoreTrip(id, userID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	trip, err := h.service.GetTripByID(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Trip restored successfully",
+		"trip":    trip,
+	})
+}
+
+// PurgeTrip - Çöp kutusundaki geziyi kalıcı olarak sil (🔒 Protected + Ownership kontrolü)
+func (h *tripHandler) PurgeTrip(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.deletedTripID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.PurgeTrip(id); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Trip permanently deleted",
+	})
+}
+
+// deletedTripID - URL'deki gezinin çöp kutusunda olduğunu ve kullanıcıya ait olduğunu doğrular
+func (h *tripHandler) deletedTripID(w http.ResponseWriter, r *http.Request) (uint, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return 0, false
+	}
+
+	vars := mux.Vars(r)
+	id, err := strconv.ParseUint(vars["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid trip ID", http.StatusBadRequest)
+		return 0, false
+	}
+
+	trip, err := h.service.GetDeletedTripByID(uint(id))
+	if err != nil {
+		http.Error(w, "Trip not found in trash", http.StatusNotFound)
+		return 0, false
+	}
+
+	if trip.UserID != userID {
+		http.Error(w, "Forbidden - You can only manage your own trash", http.StatusForbidden)
+		return 0, false
+	}
+
+	return trip.ID, true
+}
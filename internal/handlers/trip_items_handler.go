@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// AddActivity - Geziye aktivite ekle (🔒 Protected + Ownership kontrolü)
+func (h *tripHandler) AddActivity(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.ownedTrip(w, r)
+	if !ok {
+		return
+	}
+
+	var req services.ActivityInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	activity, err := h.writeService.AddActivity(trip, userID, req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":  "Activity added successfully",
+		"activity": activity,
+	})
+}
+
+// UpdateActivity - Aktiviteyi güncelle (🔒 Protected + Ownership kontrolü)
+func (h *tripHandler) UpdateActivity(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.ownedTrip(w, r)
+	if !ok {
+		return
+	}
+
+	activity, ok := findActivity(w, r, trip)
+	if !ok {
+		return
+	}
+
+	var req services.ActivityInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.writeService.UpdateActivity(activity, userID, req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":  "Activity updated successfully",
+		"activity": activity,
+	})
+}
+
+// DeleteActivity - Aktiviteyi sil (🔒 Protected + Ownership kontrolü)
+func (h *tripHandler) DeleteActivity(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.ownedTrip(w, r)
+	if !ok {
+		return
+	}
+
+	activity, ok := findActivity(w, r, trip)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteActivity(activity.ID, userID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Activity deleted successfully",
+	})
+}
+
+// AddExpense - Geziye harcama ekle (🔒 Protected + Ownership kontrolü)
+func (h *tripHandler) AddExpense(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.ownedTrip(w, r)
+	if !ok {
+		return
+	}
+
+	var req services.ExpenseInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	expense, alerts, err := h.writeService.AddExpense(trip, userID, req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Expense added successfully",
+		"expense": expense,
+		"alerts":  alerts,
+	})
+}
+
+// UpdateExpense - Harcamayı güncelle (🔒 Protected + Ownership kontrolü)
+func (h *tripHandler) UpdateExpense(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.ownedTrip(w, r)
+	if !ok {
+		return
+	}
+
+	expense, ok := findExpense(w, r, trip)
+	if !ok {
+		return
+	}
+
+	var req services.ExpenseInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	alerts, err := h.writeService.UpdateExpense(trip, expense, userID, req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Expense updated successfully",
+		"expense": expense,
+		"alerts":  alerts,
+	})
+}
+
+// DeleteExpense - Harcamayı sil (🔒 Protected + Ownership kontrolü)
+func (h *tripHandler) DeleteExpense(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.ownedTrip(w, r)
+	if !ok {
+		return
+	}
+
+	expense, ok := findExpense(w, r, trip)
+	if !ok {
+		return
+	}
+
+	if _, err := h.writeService.DeleteExpense(trip, expense, userID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Expense deleted successfully",
+	})
+}
+
+// GetTripHistory - Gezideki değişikliklerin geçmişi, en yeni başta (🔒 Protected + Ownership kontrolü)
+func (h *tripHandler) GetTripHistory(w http.ResponseWriter, r *http.Request) {
+	trip, _, ok := h.ownedTrip(w, r)
+	if !ok {
+		return
+	}
+
+	entries, err := h.service.GetTripHistory(trip.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(entries)
+}
+
+// RevertTrip - Geziyi geçmişteki bir sürüme döndür (🔒 Protected + Ownership kontrolü)
+func (h *tripHandler) RevertTrip(w http.ResponseWriter, r *http.Request) {
+	trip, userID, ok := h.ownedTrip(w, r)
+	if !ok {
+		return
+	}
+
+	entryID, err := strconv.ParseUint(mux.Vars(r)["entryID"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid history entry ID", http.StatusBadRequest)
+		return
+	}
+
+	reverted, err := h.service.RevertTrip(trip.ID, uint(entryID), userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Trip reverted successfully",
+		"trip":    reverted,
+	})
+}
+
+// ownedTrip - URL'deki geziyi yükler ve kullanıcının sahibi olduğunu doğrular
+func (h *tripHandler) ownedTrip(w http.ResponseWriter, r *http.Request) (*models.Trip, uint, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return nil, 0, false
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid trip ID", http.StatusBadRequest)
+		return nil, 0, false
+	}
+
+	trip, err := h.service.GetTripByID(uint(id))
+	if err != nil {
+		http.Error(w, "Trip not found", http.StatusNotFound)
+		return nil, 0, false
+	}
+
+	if trip.UserID != userID {
+		http.Error(w, "Forbidden - You can only modify your own trips", http.StatusForbidden)
+		return nil, 0, false
+	}
+
+	return trip, userID, true
+}
+
+// findActivity - URL'deki aktiviteyi gezinin aktiviteleri arasında bulur
+func findActivity(w http.ResponseWriter, r *http.Request, trip *models.Trip) (*models.Activity, bool) {
+	id, err := strconv.ParseUint(mux.Vars(r)["activityID"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid activity ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	for i := range trip.Activities {
+		if trip.Activities[i].ID == uint(id) {
+			return &trip.Activities[i], true
+		}
+	}
+
+	http.Error(w, "Activity not found", http.StatusNotFound)
+	return nil, false
+}
+
+// findExpense - URL'deki harcamayı gezinin harcamaları arasında bulur
+func findExpense(w http.ResponseWriter, r *http.Request, trip *models.Trip) (*models.Expense, bool) {
+	id, err := strconv.ParseUint(mux.Vars(r)["expenseID"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid expense ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	for i := range trip.Expenses {
+		if trip.Expenses[i].ID == uint(id) {
+			return &trip.Expenses[i], true
+		}
+	}
+
+	http.Error(w, "Expense not found", http.StatusNotFound)
+	return nil, false
+}
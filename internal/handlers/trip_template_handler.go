@@ -0,0 +1,273 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+type TripTemplateHandler interface {
+	DuplicateTrip(w http.ResponseWriter, r *http.Request)
+	CloneTrip(w http.ResponseWriter, r *http.Request)
+	PublishTemplate(w http.ResponseWriter, r *http.Request)
+	GetTemplates(w http.ResponseWriter, r *http.Request)
+	GetTemplateByID(w http.ResponseWriter, r *http.Request)
+	UseTemplate(w http.ResponseWriter, r *http.Request)
+	DeleteTemplate(w http.ResponseWriter, r *http.Request)
+}
+
+type tripTemplateHandler struct {
+	service     services.TripTemplateService
+	tripService services.TripService
+}
+
+func NewTripTemplateHandler(service services.TripTemplateService, tripService services.TripService) TripTemplateHandler {
+	return &tripTemplateHandler{service: service, tripService: tripService}
+}
+
+// copyRequest - Kopyalama isteklerinin ortak body'si
+type copyRequest struct {
+	StartDate string `json:"start_date"`
+	Title     string `json:"title"`
+	IsPublic  bool   `json:"is_public"`
+}
+
+// DuplicateTrip - Kendi gezisini yeni tarihlere kopyala (🔒 Protected + Ownership kontrolü)
+func (h *tripTemplateHandler) DuplicateTrip(w http.ResponseWriter, r *http.Request) {
+	userID, trip, ok := h.loadTrip(w, r)
+	if !ok {
+		return
+	}
+	if trip.UserID != userID {
+		http.Error(w, "Forbidden - You can only duplicate your own trips", http.StatusForbidden)
+		return
+	}
+
+	req, startDate, ok := decodeCopyRequest(w, r)
+	if !ok {
+		return
+	}
+
+	duplicate, err := h.service.DuplicateTrip(trip, userID, startDate, req.Title)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Trip duplicated successfully",
+		"trip":    duplicate,
+	})
+}
+
+// CloneTrip - Herkese açık bir geziyi harcamaları olmadan kendi hesabına kopyala (🔒 Protected)
+func (h *tripTemplateHandler) CloneTrip(w http.ResponseWriter, r *http.Request) {
+	userID, trip, ok := h.loadTrip(w, r)
+	if !ok {
+		return
+	}
+
+	_, startDate, ok := decodeCopyRequest(w, r)
+	if !ok {
+		return
+	}
+
+	clone, err := h.service.CloneTrip(trip, userID, startDate)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Trip cloned successfully",
+		"trip":    clone,
+	})
+}
+
+// PublishTemplate - Geziyi tekrar kullanılabilir şablon olarak yayınla (🔒 Protected + Ownership kontrolü)
+func (h *tripTemplateHandler) PublishTemplate(w http.ResponseWriter, r *http.Request) {
+	userID, trip, ok := h.loadTrip(w, r)
+	if !ok {
+		return
+	}
+	if trip.UserID != userID {
+		http.Error(w, "Forbidden - You can only publish your own trips", http.StatusForbidden)
+		return
+	}
+
+	var req copyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	template, err := h.service.PublishTemplate(trip, req.Title, req.IsPublic)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":  "Template published successfully",
+		"template": template,
+	})
+}
+
+// GetTemplates - Herkese açık şablonlar ve giriş yapılmışsa kullanıcının kendi şablonları
+func (h *tripTemplateHandler) GetTemplates(w http.ResponseWriter, r *http.Request) {
+	userID, _ := middleware.GetUserIDFromContext(r)
+
+	templates, err := h.service.GetTemplates(userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(templates)
+}
+
+// GetTemplateByID - Şablon detayı (gizli şablonları sadece sahibi görür)
+func (h *tripTemplateHandler) GetTemplateByID(w http.ResponseWriter, r *http.Request) {
+	userID, _ := middleware.GetUserIDFromContext(r)
+
+	template, ok := h.loadTemplate(w, r)
+	if !ok {
+		return
+	}
+	if !template.IsPublic && template.UserID != userID {
+		http.Error(w, "Template not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(template)
+}
+
+// UseTemplate - Şablondan yeni gezi oluştur (🔒 Protected)
+func (h *tripTemplateHandler) UseTemplate(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	template, ok := h.loadTemplate(w, r)
+	if !ok {
+		return
+	}
+
+	_, startDate, ok := decodeCopyRequest(w, r)
+	if !ok {
+		return
+	}
+
+	trip, err := h.service.CreateTripFromTemplate(template, userID, startDate)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Trip created from template",
+		"trip":    trip,
+	})
+}
+
+// DeleteTemplate - Şablonu sil (🔒 Protected + Ownership kontrolü)
+func (h *tripTemplateHandler) DeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	template, ok := h.loadTemplate(w, r)
+	if !ok {
+		return
+	}
+	if template.UserID != userID {
+		http.Error(w, "Forbidden - You can only delete your own templates", http.StatusForbidden)
+		return
+	}
+
+	if err := h.service.DeleteTemplate(template.ID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Template deleted successfully",
+	})
+}
+
+// loadTrip - Giriş yapmış kullanıcıyı ve URL'deki geziyi döndürür
+func (h *tripTemplateHandler) loadTrip(w http.ResponseWriter, r *http.Request) (uint, *models.Trip, bool) {
+	userID, ok := middleware.GetUserIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return 0, nil, false
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid trip ID", http.StatusBadRequest)
+		return 0, nil, false
+	}
+
+	trip, err := h.tripService.GetTripByID(uint(id))
+	if err != nil {
+		http.Error(w, "Trip not found", http.StatusNotFound)
+		return 0, nil, false
+	}
+
+	return userID, trip, true
+}
+
+func (h *tripTemplateHandler) loadTemplate(w http.ResponseWriter, r *http.Request) (*models.TripTemplate, bool) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid template ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	template, err := h.service.GetTemplateByID(uint(id))
+	if err != nil {
+		http.Error(w, "Template not found", http.StatusNotFound)
+		return nil, false
+	}
+
+	return template, true
+}
+
+// decodeCopyRequest - Body'yi okur, start_date zorunludur (YYYY-MM-DD)
+func decodeCopyRequest(w http.ResponseWriter, r *http.Request) (*copyRequest, time.Time, bool) {
+	var req copyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return nil, time.Time{}, false
+	}
+
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		http.Error(w, "Invalid start_date format. Use YYYY-MM-DD", http.StatusBadRequest)
+		return nil, time.Time{}, false
+	}
+
+	return &req, startDate, true
+}
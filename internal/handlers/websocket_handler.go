@@ -4,7 +4,6 @@ package handlers
 import (
 	"bufio"
 	"encoding/json"
-	"fmt"
 	"html/template"
 	"log"
 	"net"
@@ -192,11 +191,12 @@ func (h *WebSocketHandler) GetRoomHistory(w http.ResponseWriter, r *http.Request
 
 	var response []MessageResponse
 	for _, msg := range messages {
-		var user models.User
-		db.First(&user, msg.UserID)
+		if msg.UserID != models.SystemUserID {
+			db.First(&msg.User, msg.UserID)
+		}
 
 		response = append(response, MessageResponse{
-			Username:  fmt.Sprintf("%s %s", user.FirstName, user.LastName),
+			Username:  msg.SenderName(),
 			Message:   msg.Message,
 			Timestamp: msg.CreatedAt,
 		})
@@ -0,0 +1,168 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+	"travel-platform/internal/models"
+)
+
+const (
+	BundleFormat  = "travelmate-itinerary"
+	BundleVersion = 1
+	dateLayout    = "2006-01-02"
+)
+
+// Bundle - TravelMate JSON itinerary formatı
+//
+//	{
+//	  "format": "travelmate-itinerary",
+//	  "version": 1,
+//	  "trips": [{
+//	    "title": "Summer in Rome", "destination": "Rome, Italy",
+//	    "start_date": "2025-06-01", "end_date": "2025-06-07",
+//	    "description": "", "budget": 1500, "is_public": false,
+//	    "activities": [{"name": "Colosseum", "location": "Rome", "description": "", "date": "2025-06-02"}],
+//	    "expenses": [{"category": "food", "amount": 45.5, "currency": "EUR", "expense_date": "2025-06-02"}]
+//	  }]
+//	}
+//
+// Tarihler YYYY-MM-DD formatındadır (tripHandler.CreateTrip ile aynı)
+type Bundle struct {
+	Format  string       `json:"format"`
+	Version int          `json:"version"`
+	Trips   []BundleTrip `json:"trips"`
+}
+
+type BundleTrip struct {
+	Title       string           `json:"title"`
+	Destination string           `json:"destination"`
+	Latitude    *float64         `json:"latitude,omitempty"`
+	Longitude   *float64         `json:"longitude,omitempty"`
+	StartDate   string           `json:"start_date"`
+	EndDate     string           `json:"end_date"`
+	Description string           `json:"description"`
+	Budget      float64          `json:"budget"`
+	Currency    string           `json:"currency,omitempty"` // Boşsa EUR
+	IsPublic    bool             `json:"is_public"`
+	Activities  []BundleActivity `json:"activities,omitempty"`
+	Expenses    []BundleExpense  `json:"expenses,omitempty"`
+}
+
+type BundleActivity struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Location    string   `json:"location"`
+	Latitude    *float64 `json:"latitude,omitempty"`
+	Longitude   *float64 `json:"longitude,omitempty"`
+	Date        string   `json:"date"`
+}
+
+type BundleExpense struct {
+	Category    string  `json:"category"`
+	Amount      float64 `json:"amount"`
+	Currency    string  `json:"currency"`
+	ExpenseDate string  `json:"expense_date"`
+}
+
+// ParseBundle - JSON bundle'ı okur ve format/version alanlarını doğrular
+func ParseBundle(data []byte) (*Bundle, error) {
+	var bundle Bundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, fmt.Errorf("invalid JSON bundle: %v", err)
+	}
+	if bundle.Format != BundleFormat {
+		return nil, fmt.Errorf("unsupported bundle format %q, expected %q", bundle.Format, BundleFormat)
+	}
+	if bundle.Version < 1 || bundle.Version > BundleVersion {
+		return nil, fmt.Errorf("unsupported bundle version %d", bundle.Version)
+	}
+	if len(bundle.Trips) == 0 {
+		return nil, fmt.Errorf("bundle contains no trips")
+	}
+	return &bundle, nil
+}
+
+// ToTrips - Bundle'daki gezileri modellere çevirir
+// Tarihi geçersiz gezi atlanır, geçersiz aktivite/harcamalar gezi içinden çıkarılır
+func (b *Bundle) ToTrips(userID uint) ([]models.Trip, []ItemError) {
+	var trips []models.Trip
+	var itemErrors []ItemError
+
+	for i, bt := range b.Trips {
+		item := fmt.Sprintf("trips[%d]", i)
+
+		startDate, err := time.Parse(dateLayout, bt.StartDate)
+		if err != nil {
+			itemErrors = append(itemErrors, ItemError{Item: item, Message: "invalid start_date, use YYYY-MM-DD"})
+			continue
+		}
+		endDate, err := time.Parse(dateLayout, bt.EndDate)
+		if err != nil {
+			itemErrors = append(itemErrors, ItemError{Item: item, Message: "invalid end_date, use YYYY-MM-DD"})
+			continue
+		}
+
+		trip := models.Trip{
+			UserID:      userID,
+			Title:       bt.Title,
+			Destination: bt.Destination,
+			Latitude:    bt.Latitude,
+			Longitude:   bt.Longitude,
+			StartDate:   startDate,
+			EndDate:     endDate,
+			Description: bt.Description,
+			Budget:      bt.Budget,
+			Currency:    bt.Currency,
+			IsPublic:    bt.IsPublic,
+		}
+
+		for j, ba := range bt.Activities {
+			actItem := fmt.Sprintf("%s.activities[%d]", item, j)
+			if ba.Name == "" {
+				itemErrors = append(itemErrors, ItemError{Item: actItem, Message: "name is required"})
+				continue
+			}
+			date, err := time.Parse(dateLayout, ba.Date)
+			if err != nil {
+				itemErrors = append(itemErrors, ItemError{Item: actItem, Message: "invalid date, use YYYY-MM-DD"})
+				continue
+			}
+			trip.Activities = append(trip.Activities, models.Activity{
+				Name:        ba.Name,
+				Description: ba.Description,
+				Location:    ba.Location,
+				Latitude:    ba.Latitude,
+				Longitude:   ba.Longitude,
+				Date:        date,
+			})
+		}
+
+		for j, be := range bt.Expenses {
+			expItem := fmt.Sprintf("%s.expenses[%d]", item, j)
+			if be.Category == "" || be.Amount <= 0 {
+				itemErrors = append(itemErrors, ItemError{Item: expItem, Message: "category and a positive amount are required"})
+				continue
+			}
+			date, err := time.Parse(dateLayout, be.ExpenseDate)
+			if err != nil {
+				itemErrors = append(itemErrors, ItemError{Item: expItem, Message: "invalid expense_date, use YYYY-MM-DD"})
+				continue
+			}
+			currency := be.Currency
+			if currency == "" {
+				currency = "EUR"
+			}
+			trip.Expenses = append(trip.Expenses, models.Expense{
+				Category:    be.Category,
+				Amount:      be.Amount,
+				Currency:    currency,
+				ExpenseDate: date,
+			})
+		}
+
+		trips = append(trips, trip)
+	}
+
+	return trips, itemErrors
+}
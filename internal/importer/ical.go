@@ -0,0 +1,232 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Event - Bir .ics dosyasındaki tek bir VEVENT (uçuş, otel rezervasyonu vb.)
+type Event struct {
+	UID         string    `json:"uid"`
+	Summary     string    `json:"summary"`
+	Description string    `json:"description"`
+	Location    string    `json:"location"`
+	Latitude    *float64  `json:"latitude,omitempty"` // GEO özelliğinden
+	Longitude   *float64  `json:"longitude,omitempty"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	AllDay      bool      `json:"all_day"`
+	Line        int       `json:"line"` // BEGIN:VEVENT satırı (hata raporları için)
+}
+
+// ItemError - Import sırasında tek bir öğede oluşan hata
+// Tüm dosyayı reddetmek yerine hatalı öğe atlanır ve raporlanır
+type ItemError struct {
+	Item    string `json:"item"`
+	Message string `json:"message"`
+}
+
+// icsProperty - "NAME;PARAM=VALUE:content" satırının parçalanmış hali
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// ParseICS - iCalendar (RFC 5545) verisini okuyup VEVENT'leri döndürür
+// Okunamayan event'ler atlanır ve []ItemError içinde raporlanır
+func ParseICS(r io.Reader) ([]Event, []ItemError, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		events     []Event
+		itemErrors []ItemError
+		current    *Event
+		currentErr string
+		inCalendar bool
+		depth      int // VEVENT içindeki VALARM gibi alt bileşenler
+	)
+
+	for _, line := range lines {
+		prop, ok := parseProperty(line.text)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VCALENDAR"):
+			inCalendar = true
+			continue
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT"):
+			current = &Event{Line: line.number}
+			currentErr = ""
+			continue
+		case prop.name == "END" && strings.EqualFold(prop.value, "VEVENT"):
+			if current == nil {
+				continue
+			}
+			if currentErr == "" && current.Start.IsZero() {
+				currentErr = "missing DTSTART"
+			}
+			if currentErr == "" && current.Summary == "" {
+				currentErr = "missing SUMMARY"
+			}
+			if currentErr != "" {
+				itemErrors = append(itemErrors, ItemError{Item: current.label(), Message: currentErr})
+			} else {
+				if current.End.IsZero() || current.End.Before(current.Start) {
+					current.End = current.Start
+				}
+				events = append(events, *current)
+			}
+			current = nil
+			continue
+		case prop.name == "BEGIN" && current != nil:
+			depth++
+			continue
+		case prop.name == "END" && current != nil && depth > 0:
+			depth--
+			continue
+		}
+
+		if current == nil || depth > 0 {
+			continue
+		}
+
+		switch prop.name {
+		case "UID":
+			current.UID = prop.value
+		case "SUMMARY":
+			current.Summary = unescapeText(prop.value)
+		case "DESCRIPTION":
+			current.Description = unescapeText(prop.value)
+		case "LOCATION":
+			current.Location = unescapeText(prop.value)
+		case "GEO":
+			// GEO:lat;lon - bozuksa event'i reddetmek yerine yok sayıyoruz
+			var lat, lon float64
+			if _, err := fmt.Sscanf(strings.Replace(prop.value, ";", " ", 1), "%g %g", &lat, &lon); err == nil {
+				current.Latitude, current.Longitude = &lat, &lon
+			}
+		case "DTSTART":
+			t, allDay, err := parseICSTime(prop)
+			if err != nil {
+				currentErr = fmt.Sprintf("invalid DTSTART: %v", err)
+				continue
+			}
+			current.Start = t
+			current.AllDay = allDay
+		case "DTEND":
+			t, allDay, err := parseICSTime(prop)
+			if err != nil {
+				currentErr = fmt.Sprintf("invalid DTEND: %v", err)
+				continue
+			}
+			// Tüm gün event'lerinde DTEND hariçtir (exclusive), bir gün geri al
+			if allDay {
+				t = t.AddDate(0, 0, -1)
+			}
+			current.End = t
+		}
+	}
+
+	if !inCalendar {
+		return nil, nil, fmt.Errorf("not an iCalendar file: BEGIN:VCALENDAR not found")
+	}
+
+	return events, itemErrors, nil
+}
+
+func (e *Event) label() string {
+	if e.UID != "" {
+		return fmt.Sprintf("event at line %d (UID %s)", e.Line, e.UID)
+	}
+	return fmt.Sprintf("event at line %d", e.Line)
+}
+
+type icsLine struct {
+	number int
+	text   string
+}
+
+// unfoldLines - Boşluk/tab ile başlayan satırlar bir önceki satırın devamıdır
+func unfoldLines(r io.Reader) ([]icsLine, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []icsLine
+	number := 0
+	for scanner.Scan() {
+		number++
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(text, " ") || strings.HasPrefix(text, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1].text += text[1:]
+			continue
+		}
+		if text == "" {
+			continue
+		}
+		lines = append(lines, icsLine{number: number, text: text})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+func parseProperty(line string) (icsProperty, bool) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return icsProperty{}, false
+	}
+
+	head, value := line[:colon], line[colon+1:]
+	parts := strings.Split(head, ";")
+
+	prop := icsProperty{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string),
+		value:  value,
+	}
+	for _, p := range parts[1:] {
+		if eq := strings.Index(p, "="); eq > 0 {
+			prop.params[strings.ToUpper(p[:eq])] = strings.Trim(p[eq+1:], `"`)
+		}
+	}
+	return prop, true
+}
+
+// parseICSTime - DATE (20250601), UTC (20250601T100000Z) ve TZID'li yerel zamanları destekler
+func parseICSTime(prop icsProperty) (time.Time, bool, error) {
+	value := strings.TrimSpace(prop.value)
+
+	if prop.params["VALUE"] == "DATE" || len(value) == 8 {
+		t, err := time.Parse("20060102", value)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err
+	}
+
+	loc := time.UTC
+	if tzid := prop.params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	return t, false, err
+}
+
+func unescapeText(s string) string {
+	replacer := strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+	return replacer.Replace(s)
+}
@@ -0,0 +1,86 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// WriteICS - Event'leri iCalendar (RFC 5545) formatında yazar; ParseICS ile geri okunabilir
+// Tüm gün event'lerinde End dahildir (inclusive), DTEND bir gün sonrası olarak yazılır
+func WriteICS(w io.Writer, name string, events []Event) error {
+	stamp := time.Now().UTC().Format("20060102T150405Z")
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//TravelMate//Trips//EN",
+		"CALSCALE:GREGORIAN",
+		"X-WR-CALNAME:" + escapeText(name),
+	}
+
+	for _, e := range events {
+		lines = append(lines, "BEGIN:VEVENT", "UID:"+e.UID, "DTSTAMP:"+stamp)
+		if e.AllDay {
+			end := e.End
+			if end.Before(e.Start) {
+				end = e.Start
+			}
+			lines = append(lines,
+				"DTSTART;VALUE=DATE:"+e.Start.Format("20060102"),
+				"DTEND;VALUE=DATE:"+end.AddDate(0, 0, 1).Format("20060102"))
+		} else {
+			lines = append(lines, "DTSTART:"+e.Start.UTC().Format("20060102T150405Z"))
+			if !e.End.IsZero() {
+				lines = append(lines, "DTEND:"+e.End.UTC().Format("20060102T150405Z"))
+			}
+		}
+		lines = append(lines, "SUMMARY:"+escapeText(e.Summary))
+		if e.Description != "" {
+			lines = append(lines, "DESCRIPTION:"+escapeText(e.Description))
+		}
+		if e.Location != "" {
+			lines = append(lines, "LOCATION:"+escapeText(e.Location))
+		}
+		if e.Latitude != nil && e.Longitude != nil {
+			lines = append(lines, fmt.Sprintf("GEO:%g;%g", *e.Latitude, *e.Longitude))
+		}
+		lines = append(lines, "END:VEVENT")
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := io.WriteString(w, foldLine(line)+"\r\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func escapeText(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	return replacer.Replace(s)
+}
+
+// foldLine - 75 byte'tan uzun satırlar bir boşlukla başlayan devam satırlarına bölünür
+// UTF-8 karakterleri ortadan bölünmez
+func foldLine(line string) string {
+	const limit = 75
+	if len(line) <= limit {
+		return line
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
@@ -0,0 +1,415 @@
+package importer
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Transaction - Banka ekstresindeki tek bir harcama hareketi
+// Amount pozitiftir (harcanan tutar); gelen paralar ve iadeler ekstreden alınmaz
+type Transaction struct {
+	Ref         string    `json:"ref"` // OFX FITID; yoksa satır içeriğinden üretilen özet
+	Date        time.Time `json:"date"`
+	Amount      float64   `json:"amount"`
+	Currency    string    `json:"currency,omitempty"` // Boşsa ekstrenin/gezinin para birimi
+	Description string    `json:"description"`
+	Line        int       `json:"line"` // CSV satırı veya OFX'teki sıra (hata raporları için)
+}
+
+// CSVMapping - CSV sütunlarının anlamı
+// Sütunlar başlık adıyla (büyük/küçük harf fark etmez) veya 1'den başlayan sıra numarasıyla verilir
+type CSVMapping struct {
+	Date        string `json:"date"`        // varsayılan "date"
+	Amount      string `json:"amount"`      // varsayılan "amount"; Debit verilmişse kullanılmaz
+	Debit       string `json:"debit"`       // Ayrı borç sütunu olan ekstreler için (pozitif harcama)
+	Description string `json:"description"` // varsayılan "description"
+	Currency    string `json:"currency"`    // isteğe bağlı
+	DateFormat  string `json:"date_format"` // ör. DD.MM.YYYY; boşsa yaygın biçimler denenir
+	Delimiter   string `json:"delimiter"`   // boşsa başlık satırından tahmin edilir (, ; veya tab)
+	NoHeader    bool   `json:"no_header"`   // İlk satır veri ise; sütunlar sıra numarasıyla verilmeli
+	// Sign - Harcamaların işareti: "negative" (varsayılan, -12.50 harcamadır) veya "positive"
+	Sign string `json:"sign"`
+}
+
+// defaultDateLayouts - DateFormat verilmediğinde denenen biçimler
+var defaultDateLayouts = []string{"2006-01-02", "02.01.2006", "02/01/2006", "2006/01/02", "2.1.2006", "02-01-2006"}
+
+// ParseCSVStatement - CSV banka ekstresini okur; okunamayan satırlar atlanır ve raporlanır
+func ParseCSVStatement(data []byte, mapping CSVMapping) ([]Transaction, []ItemError, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comma = statementDelimiter(data, mapping.Delimiter)
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid CSV: %v", err)
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("CSV file is empty")
+	}
+
+	var header []string
+	first := 0
+	if !mapping.NoHeader {
+		header = records[0]
+		first = 1
+	}
+
+	columns := map[string]string{
+		"date":        orDefault(mapping.Date, "date"),
+		"description": orDefault(mapping.Description, "description"),
+		"currency":    mapping.Currency,
+	}
+	if mapping.Debit != "" {
+		columns["debit"] = mapping.Debit
+	} else {
+		columns["amount"] = orDefault(mapping.Amount, "amount")
+	}
+
+	index := make(map[string]int)
+	for field, column := range columns {
+		if column == "" {
+			continue
+		}
+		i, err := columnIndex(header, column)
+		if err != nil {
+			// Açıkça verilmemiş açıklama sütunu olmayabilir
+			if field == "description" && mapping.Description == "" {
+				continue
+			}
+			return nil, nil, err
+		}
+		index[field] = i
+	}
+
+	layouts := defaultDateLayouts
+	if mapping.DateFormat != "" {
+		layouts = []string{statementDateLayout(mapping.DateFormat)}
+	}
+	negative := mapping.Sign != "positive"
+
+	var (
+		transactions []Transaction
+		itemErrors   []ItemError
+	)
+	for n, record := range records[first:] {
+		line := first + n + 1
+		if isBlankRecord(record) {
+			continue
+		}
+		item := fmt.Sprintf("line %d", line)
+		cell := func(field string) string {
+			i, ok := index[field]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		date, err := parseStatementDate(cell("date"), layouts)
+		if err != nil {
+			itemErrors = append(itemErrors, ItemError{Item: item, Message: err.Error()})
+			continue
+		}
+
+		var amount float64
+		if _, ok := index["debit"]; ok {
+			if cell("debit") == "" {
+				continue // alacak satırı
+			}
+			amount, err = ParseAmount(cell("debit"))
+			amount = abs(amount)
+		} else {
+			amount, err = ParseAmount(cell("amount"))
+			if negative {
+				amount = -amount
+			}
+		}
+		if err != nil {
+			itemErrors = append(itemErrors, ItemError{Item: item, Message: err.Error()})
+			continue
+		}
+		// Gelen para veya iade
+		if amount <= 0 {
+			continue
+		}
+
+		transactions = append(transactions, Transaction{
+			Ref:         csvRef(record),
+			Date:        date,
+			Amount:      amount,
+			Currency:    strings.ToUpper(cell("currency")),
+			Description: cell("description"),
+			Line:        line,
+		})
+	}
+	return transactions, itemErrors, nil
+}
+
+// ParseOFX - OFX 1.x (SGML) ve 2.x (XML) ekstrelerindeki STMTTRN kayıtlarını okur
+// Sadece harcamalar (negatif TRNAMT) döndürülür
+func ParseOFX(data []byte) ([]Transaction, []ItemError, error) {
+	text := string(data)
+	if !strings.Contains(strings.ToUpper(text), "<OFX>") {
+		return nil, nil, fmt.Errorf("not an OFX statement")
+	}
+
+	statementCurrency := ofxValue(text, "CURDEF")
+
+	var (
+		transactions []Transaction
+		itemErrors   []ItemError
+	)
+	upper := strings.ToUpper(text)
+	for n, offset := 0, 0; ; n++ {
+		start := strings.Index(upper[offset:], "<STMTTRN>")
+		if start < 0 {
+			break
+		}
+		start += offset
+		// SGML'de kapanış etiketi olmayabilir; kayıt bir sonraki STMTTRN'e kadar sürer
+		end := len(upper) - start
+		if close := strings.Index(upper[start:], "</STMTTRN>"); close >= 0 {
+			end = close
+		}
+		if next := strings.Index(upper[start+9:], "<STMTTRN>"); next >= 0 && next+9 < end {
+			end = next + 9
+		}
+		block := text[start : start+end]
+		offset = start + end
+		item := fmt.Sprintf("transaction %d", n+1)
+
+		date, err := parseOFXDate(ofxValue(block, "DTPOSTED"))
+		if err != nil {
+			itemErrors = append(itemErrors, ItemError{Item: item, Message: err.Error()})
+			continue
+		}
+		amount, err := ParseAmount(ofxValue(block, "TRNAMT"))
+		if err != nil {
+			itemErrors = append(itemErrors, ItemError{Item: item, Message: err.Error()})
+			continue
+		}
+		if amount >= 0 {
+			continue
+		}
+
+		description := ofxValue(block, "NAME")
+		if memo := ofxValue(block, "MEMO"); memo != "" && memo != description {
+			description = strings.TrimSpace(description + " " + memo)
+		}
+		currency := statementCurrency
+		if value := ofxValue(block, "CURSYM"); value != "" {
+			currency = value
+		}
+
+		ref := ofxValue(block, "FITID")
+		if ref == "" {
+			ref = hashRef(block)
+		}
+		transactions = append(transactions, Transaction{
+			Ref:         ref,
+			Date:        date,
+			Amount:      -amount,
+			Currency:    strings.ToUpper(currency),
+			Description: description,
+			Line:        n + 1,
+		})
+	}
+	return transactions, itemErrors, nil
+}
+
+// ParseAmount - "1.234,56", "1,234.56", "-12.50 €", "(12.50)" gibi tutarları okur
+// Hem nokta hem virgül varsa sondaki ondalık ayırıcıdır; tek virgül ve ardından 1-2 hane varsa ondalıktır
+func ParseAmount(value string) (float64, error) {
+	raw := value
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative = true
+		value = value[1 : len(value)-1]
+	}
+	cleaned := strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) || r == '.' || r == ',' || r == '-' || r == '+' {
+			return r
+		}
+		return -1
+	}, value)
+	if strings.HasSuffix(cleaned, "-") {
+		negative = !negative
+		cleaned = strings.TrimSuffix(cleaned, "-")
+	}
+
+	lastDot, lastComma := strings.LastIndex(cleaned, "."), strings.LastIndex(cleaned, ",")
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		if lastComma > lastDot {
+			cleaned = strings.ReplaceAll(cleaned, ".", "")
+			cleaned = strings.Replace(cleaned, ",", ".", 1)
+		} else {
+			cleaned = strings.ReplaceAll(cleaned, ",", "")
+		}
+	case lastComma >= 0:
+		if strings.Count(cleaned, ",") == 1 && len(cleaned)-lastComma-1 <= 2 {
+			cleaned = strings.Replace(cleaned, ",", ".", 1)
+		} else {
+			cleaned = strings.ReplaceAll(cleaned, ",", "")
+		}
+	}
+
+	amount, err := strconv.ParseFloat(cleaned, 64)
+	if err != nil || cleaned == "" {
+		return 0, fmt.Errorf("invalid amount %q", raw)
+	}
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+// categoryKeywords - Açıklamadan kategori tahmini için anahtar kelimeler (küçük harf)
+var categoryKeywords = []struct {
+	category string
+	words    []string
+}{
+	{"accommodation", []string{"hotel", "hostel", "airbnb", "booking.com", "motel", " inn ", "resort", "lodging", "pension", "otel"}},
+	{"transport", []string{"uber", "lyft", "taxi", "bolt", "airline", "airways", " air ", "ryanair", "easyjet", "lufthansa", "rail", "train", "bahn", "sncf", "trenitalia", "metro", " bus ", "flixbus", "shell", "fuel", "parking", "car rental", "hertz", "sixt", "europcar", "ferry", "toll"}},
+	{"food", []string{"restaurant", "cafe", "café", "coffee", "starbucks", " bar ", " pub ", "bistro", "pizza", "burger", "mcdonald", "kfc", "bakery", "trattoria", "supermarket", "market", "grocery", "carrefour", "lidl", "aldi", "spar", " deli ", "food", "lokanta"}},
+	{"activities", []string{"museum", "ticket", " tour ", "cinema", "theatre", "theater", "concert", " park ", "zoo", "gallery", " show ", "excursion", " spa "}},
+}
+
+// SuggestCategory - Hareket açıklamasından harcama kategorisi tahmini; bulunamazsa "other"
+func SuggestCategory(description string) string {
+	text := " " + strings.ToLower(description) + " "
+	for _, rule := range categoryKeywords {
+		for _, word := range rule.words {
+			if strings.Contains(text, word) {
+				return rule.category
+			}
+		}
+	}
+	return "other"
+}
+
+// statementDelimiter - Verilen ayırıcı, yoksa ilk satırda en çok geçen , ; veya tab
+func statementDelimiter(data []byte, delimiter string) rune {
+	switch delimiter {
+	case "tab", "\\t", "\t":
+		return '\t'
+	case "":
+	default:
+		return []rune(delimiter)[0]
+	}
+
+	firstLine := string(data)
+	if i := strings.IndexAny(firstLine, "\r\n"); i >= 0 {
+		firstLine = firstLine[:i]
+	}
+	best, count := ',', strings.Count(firstLine, ",")
+	for _, candidate := range []rune{';', '\t'} {
+		if c := strings.Count(firstLine, string(candidate)); c > count {
+			best, count = candidate, c
+		}
+	}
+	return best
+}
+
+// columnIndex - Sütun adı veya 1'den başlayan sıra numarası
+func columnIndex(header []string, column string) (int, error) {
+	if n, err := strconv.Atoi(column); err == nil {
+		if n < 1 {
+			return 0, fmt.Errorf("column numbers start at 1, got %d", n)
+		}
+		return n - 1, nil
+	}
+	for i, name := range header {
+		if strings.EqualFold(strings.TrimSpace(name), column) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("column %q not found in header", column)
+}
+
+// statementDateLayout - DD.MM.YYYY gibi biçimi Go layout'una çevirir
+func statementDateLayout(format string) string {
+	return strings.NewReplacer("YYYY", "2006", "YY", "06", "MM", "01", "DD", "02", "M", "1", "D", "2").
+		Replace(strings.ToUpper(format))
+}
+
+func parseStatementDate(value string, layouts []string) (time.Time, error) {
+	// Tarih-saat sütunlarında sadece tarih kısmı kullanılır
+	if fields := strings.Fields(value); len(fields) > 1 {
+		value = fields[0]
+	}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// parseOFXDate - YYYYMMDD[HHMMSS[.XXX]][TZ] biçiminden sadece gün
+func parseOFXDate(value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("invalid DTPOSTED %q", value)
+	}
+	t, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid DTPOSTED %q", value)
+	}
+	return t, nil
+}
+
+// ofxValue - <TAG>değer şeklindeki ilk alanın değeri (SGML'de kapanış etiketi olmayabilir)
+func ofxValue(block, tag string) string {
+	upper := strings.ToUpper(block)
+	start := strings.Index(upper, "<"+tag+">")
+	if start < 0 {
+		return ""
+	}
+	value := block[start+len(tag)+2:]
+	if end := strings.IndexAny(value, "<\r\n"); end >= 0 {
+		value = value[:end]
+	}
+	return strings.TrimSpace(value)
+}
+
+func csvRef(record []string) string {
+	return hashRef(strings.Join(record, "\x1f"))
+}
+
+func hashRef(value string) string {
+	sum := sha1.Sum([]byte(value))
+	return "stmt-" + hex.EncodeToString(sum[:8])
+}
+
+func isBlankRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+func abs(value float64) float64 {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
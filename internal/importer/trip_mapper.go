@@ -0,0 +1,100 @@
+package importer
+
+import (
+	"fmt"
+	"sort"
+	"time"
+	"travel-platform/internal/models"
+)
+
+// TripOptions - .ics dosyasında bulunmayan gezi bilgileri (formdan gelir)
+type TripOptions struct {
+	Title       string
+	Destination string
+	Description string
+	Budget      float64
+	IsPublic    bool
+}
+
+// TripFromEvents - Takvim event'lerinden tek bir gezi oluşturur
+// Gezi tarihleri en erken başlangıç ve en geç bitişten, her event bir aktiviteden oluşur
+func TripFromEvents(userID uint, events []Event, opts TripOptions) (*models.Trip, error) {
+	if len(events) == 0 {
+		return nil, fmt.Errorf("calendar contains no usable events")
+	}
+
+	sorted := make([]Event, len(events))
+	copy(sorted, events)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	start, end := sorted[0].Start, sorted[0].End
+	for _, e := range sorted[1:] {
+		if e.End.After(end) {
+			end = e.End
+		}
+	}
+
+	trip := &models.Trip{
+		UserID:      userID,
+		Title:       opts.Title,
+		Destination: opts.Destination,
+		StartDate:   truncateToDay(start),
+		EndDate:     truncateToDay(end),
+		Description: opts.Description,
+		Budget:      opts.Budget,
+		IsPublic:    opts.IsPublic,
+	}
+
+	if trip.Title == "" {
+		trip.Title = sorted[0].Summary
+	}
+	if trip.Destination == "" {
+		trip.Destination = mostCommonLocation(sorted)
+	}
+
+	for _, e := range sorted {
+		trip.Activities = append(trip.Activities, models.Activity{
+			Name:        e.Summary,
+			Description: activityDescription(e),
+			Location:    e.Location,
+			Latitude:    e.Latitude,
+			Longitude:   e.Longitude,
+			Date:        e.Start,
+		})
+	}
+
+	return trip, nil
+}
+
+// activityDescription - Birden fazla güne yayılan event'lerde (otel vb.) bitiş tarihini ekler
+func activityDescription(e Event) string {
+	if truncateToDay(e.End).After(truncateToDay(e.Start)) {
+		until := fmt.Sprintf("Until %s", e.End.Format("Jan 2, 2006"))
+		if e.Description == "" {
+			return until
+		}
+		return e.Description + "\n" + until
+	}
+	return e.Description
+}
+
+func mostCommonLocation(events []Event) string {
+	counts := make(map[string]int)
+	best := ""
+	for _, e := range events {
+		if e.Location == "" {
+			continue
+		}
+		counts[e.Location]++
+		if counts[e.Location] > counts[best] {
+			best = e.Location
+		}
+	}
+	return best
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
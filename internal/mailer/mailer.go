@@ -0,0 +1,81 @@
+package mailer
+
+import (
+	"fmt"
+	"log"
+	"net/smtp"
+	"os"
+	"strings"
+)
+
+// Mailer - Bildirim e-postalarını gönderir
+type Mailer interface {
+	Send(to, subject, body string) error
+}
+
+// SMTPMailer - Düz metin e-postayı SMTP sunucusu üzerinden gönderir
+type SMTPMailer struct {
+	Host     string
+	Port     string
+	Username string // Boşsa kimlik doğrulama yapılmaz
+	Password string
+	From     string
+}
+
+func (m *SMTPMailer) Send(to, subject, body string) error {
+	message := strings.Join([]string{
+		"From: " + m.From,
+		"To: " + headerValue(to),
+		"Subject: " + headerValue(subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+
+	var auth smtp.Auth
+	if m.Username != "" {
+		auth = smtp.PlainAuth("", m.Username, m.Password, m.Host)
+	}
+	if err := smtp.SendMail(m.Host+":"+m.Port, auth, m.From, []string{to}, []byte(message)); err != nil {
+		return fmt.Errorf("mailer: %w", err)
+	}
+	return nil
+}
+
+// LogMailer - SMTP yapılandırılmamışsa e-postalar sadece loglanır
+type LogMailer struct{}
+
+func (LogMailer) Send(to, subject, body string) error {
+	log.Printf("✉️ Email to %s: %s", to, subject)
+	return nil
+}
+
+// FromEnv - SMTP_HOST verilmişse SMTPMailer, yoksa LogMailer
+// Diğer değişkenler: SMTP_PORT (varsayılan 587), SMTP_USERNAME, SMTP_PASSWORD, SMTP_FROM
+func FromEnv() Mailer {
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		return LogMailer{}
+	}
+
+	mailer := &SMTPMailer{
+		Host:     host,
+		Port:     os.Getenv("SMTP_PORT"),
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("SMTP_FROM"),
+	}
+	if mailer.Port == "" {
+		mailer.Port = "587"
+	}
+	if mailer.From == "" {
+		mailer.From = "no-reply@travelmate.local"
+	}
+	return mailer
+}
+
+// headerValue - Başlık enjeksiyonunu önlemek için satır sonlarını temizler
+func headerValue(value string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+}
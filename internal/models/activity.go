@@ -2,6 +2,8 @@ package models
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type Activity struct {
@@ -11,5 +13,9 @@ type Activity struct {
 	Name        string    `gorm:"not null" json:"name"`
 	Description string    `json:"description"`
 	Location    string    `json:"location"`
+	Latitude    *float64  `json:"latitude,omitempty"` // Geocoder veya kullanıcı tarafından doldurulur
+	Longitude   *float64  `json:"longitude,omitempty"`
 	Date        time.Time `gorm:"not null" json:"date"`
+
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"` // Gezi çöp kutusuna taşınınca birlikte silinir
 }
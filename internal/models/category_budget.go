@@ -0,0 +1,10 @@
+package models
+
+// CategoryBudget - Gezinin bir harcama kategorisine ayrılan bütçe
+// Amount gezinin para birimindedir; toplamı Trip.Budget'ı geçemez
+type CategoryBudget struct {
+	ID       uint    `gorm:"primaryKey" json:"id"`
+	TripID   uint    `gorm:"not null;uniqueIndex:idx_trip_category" json:"trip_id"`
+	Category string  `gorm:"not null;uniqueIndex:idx_trip_category" json:"category"`
+	Amount   float64 `gorm:"not null" json:"amount"`
+}
@@ -6,6 +6,12 @@ import (
 	"gorm.io/gorm"
 )
 
+// Sistem mesajları (bütçe uyarıları vb.) bir kullanıcıya ait değildir
+const (
+	SystemUserID     uint = 0
+	SystemSenderName      = "System"
+)
+
 type ChatMessage struct {
 	ID        uint           `gorm:"primaryKey" json:"id"`
 	RoomID    uint           `gorm:"index;not null" json:"room_id"`
@@ -17,3 +23,11 @@ type ChatMessage struct {
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 }
+
+// SenderName - Mesajı yazanın adı; sistem mesajlarında "System"
+func (m ChatMessage) SenderName() string {
+	if m.UserID == SystemUserID {
+		return SystemSenderName
+	}
+	return m.User.FirstName + " " + m.User.LastName
+}
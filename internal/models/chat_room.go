@@ -1,6 +1,7 @@
 package models
 
 import (
+	"fmt"
 	"time"
 
 	"gorm.io/gorm"
@@ -9,9 +10,24 @@ import (
 type ChatRoom struct {
 	ID        uint           `gorm:"primaryKey" json:"id"`
 	Name      string         `gorm:"unique;size:100" json:"name"`
+	TripID    *uint          `gorm:"index" json:"trip_id,omitempty"` // Geziye ait oda ise (trip-<id>)
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 	// Cascade delete messages when room is deleted
 	Messages []ChatMessage `gorm:"foreignKey:RoomID;constraint:OnDelete:CASCADE" json:"messages,omitempty"`
 }
+
+// TripRoomName - Bir gezinin sohbet odasının adı
+func TripRoomName(tripID uint) string {
+	return fmt.Sprintf("trip-%d", tripID)
+}
+
+// ParseTripRoomName - "trip-<id>" formatındaki oda adından gezi ID'sini çıkarır
+func ParseTripRoomName(name string) (uint, bool) {
+	var tripID uint
+	if _, err := fmt.Sscanf(name, "trip-%d", &tripID); err != nil || TripRoomName(tripID) != name {
+		return 0, false
+	}
+	return tripID, true
+}
@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// Checklist türleri
+const (
+	ChecklistPacking   = "packing"
+	ChecklistDocuments = "documents"
+	ChecklistBookings  = "bookings"
+	ChecklistOther     = "other"
+)
+
+// Checklist - Geziye ait hazırlık listesi (bavul, belgeler, rezervasyonlar)
+type Checklist struct {
+	ID        uint            `gorm:"primaryKey" json:"id"`
+	TripID    uint            `gorm:"not null;index" json:"trip_id"`
+	Title     string          `gorm:"not null" json:"title"`
+	Kind      string          `gorm:"not null;default:other" json:"kind"`
+	Items     []ChecklistItem `gorm:"foreignKey:ChecklistID" json:"items"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type ChecklistItem struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	ChecklistID uint       `gorm:"not null;index" json:"checklist_id"`
+	Text        string     `gorm:"not null" json:"text"`
+	Position    int        `json:"position"`
+	AssigneeID  *uint      `json:"assignee_id,omitempty"` // Gezi sahibi veya üyelerden biri
+	Assignee    *User      `gorm:"foreignKey:AssigneeID" json:"assignee,omitempty"`
+	Done        bool       `json:"done"`
+	DoneAt      *time.Time `json:"done_at,omitempty"`
+	DoneByID    *uint      `json:"done_by_id,omitempty"`
+}
+
+// ChecklistTemplate - Kullanıcının tekrar kullanmak için kaydettiği liste
+type ChecklistTemplate struct {
+	ID        uint                    `gorm:"primaryKey" json:"id"`
+	UserID    uint                    `gorm:"not null;index" json:"user_id"`
+	Title     string                  `gorm:"not null" json:"title"`
+	Kind      string                  `gorm:"not null;default:other" json:"kind"`
+	Items     []ChecklistTemplateItem `gorm:"foreignKey:TemplateID" json:"items"`
+	CreatedAt time.Time               `json:"created_at"`
+}
+
+type ChecklistTemplateItem struct {
+	ID         uint   `gorm:"primaryKey" json:"id"`
+	TemplateID uint   `gorm:"not null;index" json:"template_id"`
+	Text       string `gorm:"not null" json:"text"`
+	Position   int    `json:"position"`
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Destination - Normalleştirilmiş destinasyon kataloğu kaydı
+// Gezilerin serbest metin destinasyonları bulanık eşleştirme ile bu kayda bağlanır (Trip.DestinationID)
+type Destination struct {
+	ID          uint    `gorm:"primaryKey" json:"id"`
+	Name        string  `gorm:"not null;uniqueIndex:idx_destination_name_country" json:"name"`
+	Country     string  `gorm:"not null;uniqueIndex:idx_destination_name_country" json:"country"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	Aliases     string  `json:"aliases"` // "|" ile ayrılmış alternatif isimler, örn. "roma"
+	Description string  `json:"description"`
+
+	// Öne çıkan destinasyonlar, topluluk gezisi yokken öneri olarak sunulur
+	EstimatedBudget float64 `json:"estimated_budget"` // EUR
+	Highlights      string  `json:"highlights"`       // "|" ile ayrılmış önerilen aktiviteler
+	Featured        bool    `gorm:"default:false" json:"featured"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DisplayName - "Paris, France"
+func (d Destination) DisplayName() string {
+	return d.Name + ", " + d.Country
+}
+
+// AliasList - Alternatif isimler
+func (d Destination) AliasList() []string {
+	return splitList(d.Aliases)
+}
+
+// HighlightList - Önerilen aktiviteler
+func (d Destination) HighlightList() []string {
+	return splitList(d.Highlights)
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, "|") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
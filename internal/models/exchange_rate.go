@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// ExchangeRate - Veritabanında saklanan günlük kur (1 Base = Rate Quote)
+// Sağlayıcılardan alınan kurlar burada önbelleğe alınır; böylece servis kapalıyken de kullanılır
+type ExchangeRate struct {
+	ID     uint      `gorm:"primaryKey" json:"id"`
+	Base   string    `gorm:"size:3;not null;uniqueIndex:idx_exchange_rate_pair_date" json:"base"`
+	Quote  string    `gorm:"size:3;not null;uniqueIndex:idx_exchange_rate_pair_date" json:"quote"`
+	Date   time.Time `gorm:"not null;uniqueIndex:idx_exchange_rate_pair_date" json:"date"`
+	Rate   float64   `gorm:"not null" json:"rate"`
+	Source string    `json:"source"` // table, http, ...
+
+	CreatedAt time.Time `json:"created_at"`
+}
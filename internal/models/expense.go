@@ -2,14 +2,51 @@ package models
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type Expense struct {
 	ID          uint      `gorm:"primaryKey" json:"id"`
 	TripID      uint      `gorm:"not null" json:"trip_id"`
 	Trip        Trip      `gorm:"foreignKey:TripID" json:"trip,omitempty"`
-	Category    string    `gorm:"not null" json:"category"` // food, transport, accommodation, other
+	Category    string    `gorm:"not null" json:"category"` // ExpenseCategory.Key: food, transport, accommodation, ...
 	Amount      float64   `gorm:"not null" json:"amount"`
 	Currency    string    `gorm:"default:EUR" json:"currency"`
 	ExpenseDate time.Time `gorm:"not null" json:"expense_date"`
+	Description string    `json:"description,omitempty"`
+
+	// Banka ekstresinden aktarılan harcamanın kaynak kimliği (OFX FITID); tekrar aktarımı önler
+	ImportRef string `gorm:"index" json:"import_ref,omitempty"`
+
+	// Harcama tarihindeki kur ile gezinin para birimine çevrilmiş tutar
+	ExchangeRate float64 `json:"exchange_rate"` // 1 Currency = ExchangeRate trip.Currency
+	HomeAmount   float64 `json:"home_amount"`
+
+	// Paylaşımlı harcama: kim ödedi ve katılımcılar arasında nasıl bölündü (SplitType boşsa bölünmemiş)
+	PayerID   *uint          `json:"payer_id,omitempty"`
+	SplitType string         `json:"split_type,omitempty"`
+	Splits    []ExpenseSplit `gorm:"foreignKey:ExpenseID" json:"splits,omitempty"`
+
+	Receipts []Receipt `gorm:"foreignKey:ExpenseID" json:"receipts,omitempty"`
+
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"` // Gezi çöp kutusuna taşınınca birlikte silinir
+}
+
+// Bölme türleri - ExpenseSplit.Value'nun anlamını belirler
+const (
+	SplitEqual      = "equal"      // Value kullanılmaz, tutar eşit bölünür
+	SplitShares     = "shares"     // Value pay sayısıdır (ör. 2:1)
+	SplitExact      = "exact"      // Value kişinin tutarıdır, toplamı harcamaya eşit olmalı
+	SplitPercentage = "percentage" // Value yüzdedir, toplamı 100 olmalı
+)
+
+// ExpenseSplit - Bir katılımcının harcamadaki payı
+// Amount harcamanın para birimindedir ve Value'dan hesaplanır
+type ExpenseSplit struct {
+	ID        uint    `gorm:"primaryKey" json:"id"`
+	ExpenseID uint    `gorm:"not null;index" json:"expense_id"`
+	UserID    uint    `gorm:"not null" json:"user_id"`
+	Value     float64 `json:"value"`
+	Amount    float64 `json:"amount"`
 }
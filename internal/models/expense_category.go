@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// ExpenseCategory - Harcama kategorisi kataloğu
+// UserID nil ise sistem kategorisidir (kodda tanımlı, herkes kullanır); doluysa kullanıcının özel kategorisi
+// Expense.Category ve CategoryBudget.Category bu kaydın Key değerini tutar
+type ExpenseCategory struct {
+	ID     uint   `gorm:"primaryKey" json:"id"`
+	UserID *uint  `gorm:"uniqueIndex:idx_user_category_key" json:"user_id,omitempty"`
+	Key    string `gorm:"not null;uniqueIndex:idx_user_category_key" json:"key"`
+	Name   string `gorm:"not null" json:"name"`
+	Color  string `gorm:"size:7" json:"color"` // #rrggbb
+	Icon   string `json:"icon"`                // Font Awesome ikon adı, örn. "utensils"
+
+	// Bütçe analizi kuralları (harcamanın yüzdesi); IdealMax 0 ise aralık kontrolü yapılmaz
+	IdealMin     float64 `json:"ideal_min"`
+	IdealMax     float64 `json:"ideal_max"`
+	SuggestAbove float64 `json:"suggest_above"` // Payı bunu geçince Suggestion gösterilir; 0 ise öneri yok
+	Suggestion   string  `json:"suggestion,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IsSystem - Sistem kategorileri değiştirilemez ve silinemez
+func (c ExpenseCategory) IsSystem() bool {
+	return c.UserID == nil
+}
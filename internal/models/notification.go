@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// Bildirim türleri
+const (
+	NotificationBudgetAlert = "budget_alert"
+)
+
+// Notification - Kullanıcıya gösterilen uygulama içi bildirim
+type Notification struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"index;not null" json:"user_id"`
+	TripID    *uint      `gorm:"index" json:"trip_id,omitempty"`
+	Type      string     `gorm:"size:30;not null" json:"type"`
+	Title     string     `gorm:"size:200;not null" json:"title"`
+	Message   string     `gorm:"type:text" json:"message"`
+	Link      string     `gorm:"size:200" json:"link,omitempty"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// NotificationSettings - Kullanıcının bütçe uyarılarını hangi kanallardan alacağı
+// Kayıt yoksa DefaultNotificationSettings geçerlidir
+type NotificationSettings struct {
+	UserID      uint      `gorm:"primaryKey;autoIncrement:false" json:"-"`
+	BudgetInApp bool      `json:"budget_in_app"`
+	BudgetEmail bool      `json:"budget_email"`
+	BudgetChat  bool      `json:"budget_chat"` // Gezi sahibi açarsa uyarılar gezinin sohbet odasına da yazılır
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// DefaultNotificationSettings - Uygulama içi uyarılar açık, e-posta ve sohbet kullanıcı açana kadar kapalı
+func DefaultNotificationSettings(userID uint) NotificationSettings {
+	return NotificationSettings{UserID: userID, BudgetInApp: true}
+}
+
+// BudgetAlert - Gezide geçilmiş ve bildirilmiş bütçe eşiği; aynı eşik için tekrar uyarı gönderilmez
+// Category boşsa gezinin toplam bütçesidir. Harcama eşiğin altına düşerse kayıt silinir
+type BudgetAlert struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	TripID    uint      `gorm:"not null;uniqueIndex:idx_budget_alert" json:"trip_id"`
+	Category  string    `gorm:"uniqueIndex:idx_budget_alert" json:"category"`
+	Threshold int       `gorm:"not null;uniqueIndex:idx_budget_alert" json:"threshold"`
+	CreatedAt time.Time `json:"created_at"`
+}
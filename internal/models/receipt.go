@@ -0,0 +1,24 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Receipt - Harcamaya eklenmiş fiş/fatura dosyası
+// Dosya içeriği BlobStore'da StorageKey altında saklanır
+type Receipt struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	ExpenseID    uint      `gorm:"not null;index" json:"expense_id"`
+	FileName     string    `gorm:"not null" json:"file_name"`
+	ContentType  string    `gorm:"not null" json:"content_type"`
+	Size         int64     `json:"size"`
+	StorageKey   string    `gorm:"not null;uniqueIndex" json:"-"`
+	UploadedByID uint      `gorm:"not null" json:"uploaded_by_id"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// IsImage - Fiş sayfada önizlenebilir bir resim mi
+func (r Receipt) IsImage() bool {
+	return strings.HasPrefix(r.ContentType, "image/")
+}
@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// Rezervasyon türleri
+const (
+	ReservationFlight  = "flight"
+	ReservationLodging = "lodging"
+	ReservationRail    = "rail"
+	ReservationCar     = "car"
+)
+
+// Reservation - Geziye ait uçuş, konaklama, tren veya araç kiralama rezervasyonu
+// StartAt/EndAt türe göre kalkış/varış, giriş/çıkış veya teslim alma/bırakma zamanıdır
+type Reservation struct {
+	ID                 uint   `gorm:"primaryKey" json:"id"`
+	TripID             uint   `gorm:"not null;index" json:"trip_id"`
+	Type               string `gorm:"not null" json:"type"`
+	ConfirmationNumber string `json:"confirmation_number"`
+	Provider           string `json:"provider"` // Havayolu, otel, tren işletmesi veya kiralama şirketi
+
+	// Uçuş
+	FlightNumber     string `json:"flight_number,omitempty"`
+	DepartureAirport string `json:"departure_airport,omitempty"` // IATA kodu (IST, FCO...)
+	ArrivalAirport   string `json:"arrival_airport,omitempty"`
+
+	// Konaklama ve araç kiralama adresi
+	Address string `json:"address,omitempty"`
+
+	// Tren istasyonları veya aracın teslim alındığı/bırakıldığı yer
+	Origin      string `json:"origin,omitempty"`
+	Destination string `json:"destination,omitempty"`
+
+	StartAt time.Time  `gorm:"not null" json:"start_at"`
+	EndAt   *time.Time `json:"end_at,omitempty"`
+	Notes   string     `json:"notes"`
+
+	// Ücret girilirse geziye bağlı bir Expense oluşturulur ve senkron tutulur
+	Cost      float64  `json:"cost"`
+	Currency  string   `json:"currency"`
+	ExpenseID *uint    `json:"expense_id,omitempty"`
+	Expense   *Expense `gorm:"foreignKey:ExpenseID" json:"-"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// Settlement - Katılımcılar arasında borç kapatmak için yapılan ödeme kaydı
+// FromUserID, ToUserID'ye Amount kadar ödeme yapmıştır
+type Settlement struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	TripID      uint      `gorm:"not null;index" json:"trip_id"`
+	FromUserID  uint      `gorm:"not null" json:"from_user_id"`
+	FromUser    User      `gorm:"foreignKey:FromUserID" json:"from_user,omitempty"`
+	ToUserID    uint      `gorm:"not null" json:"to_user_id"`
+	ToUser      User      `gorm:"foreignKey:ToUserID" json:"to_user,omitempty"`
+	Amount      float64   `gorm:"not null" json:"amount"`
+	Currency    string    `gorm:"size:3;not null" json:"currency"`
+	Note        string    `json:"note"`
+	PaidAt      time.Time `gorm:"not null" json:"paid_at"`
+	CreatedByID uint      `gorm:"not null" json:"created_by_id"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// Belge türleri
+const (
+	DocumentPassport  = "passport"
+	DocumentVisa      = "visa"
+	DocumentInsurance = "insurance"
+	DocumentIDCard    = "id_card"
+	DocumentOther     = "other"
+)
+
+// TravelDocument - Kullanıcının pasaport, vize, sigorta gibi belgeleri
+// Belge numarası ve dosya içeriği şifreli saklanır, sadece sahibi görebilir
+type TravelDocument struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"not null;index" json:"user_id"`
+	TripID    *uint      `gorm:"index" json:"trip_id,omitempty"` // Belge belirli bir geziye aitse (ör. vize)
+	Type      string     `gorm:"not null" json:"type"`
+	Title     string     `gorm:"not null" json:"title"`
+	Country   string     `json:"country,omitempty"` // Veren ülke veya vizenin ülkesi
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	Number          string `gorm:"-" json:"number,omitempty"` // Çözülmüş belge numarası
+	EncryptedNumber []byte `json:"-"`
+
+	FileName      string `json:"file_name,omitempty"`
+	ContentType   string `json:"content_type,omitempty"`
+	Size          int64  `json:"size"`
+	EncryptedData []byte `json:"-"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
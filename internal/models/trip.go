@@ -7,15 +7,20 @@ import (
 )
 
 type Trip struct {
-	ID          uint      `gorm:"primaryKey" json:"id"`
-	UserID      uint      `gorm:"not null" json:"user_id"`
-	User        User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	Title       string    `gorm:"not null" json:"title"`
-	Destination string    `gorm:"not null" json:"destination"`
-	StartDate   time.Time `gorm:"not null" json:"start_date"`
-	EndDate     time.Time `gorm:"not null" json:"end_date"`
-	Description string    `json:"description"`
-	Budget      float64   `json:"budget"`
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	UserID        uint      `gorm:"not null" json:"user_id"`
+	User          User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Title         string    `gorm:"not null" json:"title"`
+	Destination   string    `gorm:"not null" json:"destination"`
+	DestinationID *uint     `gorm:"index" json:"destination_id,omitempty"` // Katalogdaki eşleşen destinasyon (bulunamazsa boş)
+	Latitude      *float64  `json:"latitude,omitempty"`                    // Destinasyonun koordinatları
+	Longitude     *float64  `json:"longitude,omitempty"`
+	StartDate     time.Time `gorm:"not null" json:"start_date"`
+	EndDate       time.Time `gorm:"not null" json:"end_date"`
+	Description   string    `json:"description"`
+	Budget        float64   `json:"budget"`
+	Currency      string    `gorm:"size:3;not null;default:EUR" json:"currency"`   // Ev para birimi; bütçe ve toplamlar bu birimde
+	Status        string    `gorm:"not null;default:upcoming;index" json:"status"` // upcoming, in_progress, completed, cancelled
 
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
@@ -25,3 +30,11 @@ type Trip struct {
 	Activities []Activity `gorm:"foreignKey:TripID" json:"activities,omitempty"`
 	IsPublic   bool       `gorm:"default:true" json:"is_public"`
 }
+
+// Gezi durumları
+const (
+	TripStatusUpcoming   = "upcoming"
+	TripStatusInProgress = "in_progress"
+	TripStatusCompleted  = "completed"
+	TripStatusCancelled  = "cancelled"
+)
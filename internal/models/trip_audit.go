@@ -0,0 +1,192 @@
+package models
+
+import "time"
+
+// Audit aksiyonları
+const (
+	AuditCreate  = "create"
+	AuditUpdate  = "update"
+	AuditDelete  = "delete"
+	AuditRestore = "restore"
+	AuditRevert  = "revert"
+)
+
+// Audit kaydının ilgili olduğu varlık tipleri
+const (
+	AuditEntityTrip     = "trip"
+	AuditEntityActivity = "activity"
+	AuditEntityExpense  = "expense"
+)
+
+// FieldChange - Tek bir alanın eski ve yeni değeri (oluşturmada Old, silmede New boştur)
+type FieldChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+// TripAuditEntry - TripService üzerinden yapılan her değişikliğin kaydı
+// Snapshot değişiklikten sonraki gezi durumudur, geri alma (revert) bunu kullanır
+type TripAuditEntry struct {
+	ID         uint          `gorm:"primaryKey" json:"id"`
+	TripID     uint          `gorm:"not null;index" json:"trip_id"`
+	ActorID    uint          `gorm:"not null" json:"actor_id"`
+	Actor      User          `gorm:"foreignKey:ActorID" json:"actor,omitempty"`
+	Action     string        `gorm:"not null" json:"action"`
+	EntityType string        `gorm:"not null" json:"entity_type"`
+	EntityID   uint          `json:"entity_id"`
+	Changes    []FieldChange `gorm:"serializer:json" json:"changes"`
+	Snapshot   TripSnapshot  `gorm:"serializer:json" json:"-"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+// TripSnapshot - Gezinin aktivite ve harcamalarıyla birlikte belirli bir andaki hali
+type TripSnapshot struct {
+	Title         string             `json:"title"`
+	Destination   string             `json:"destination"`
+	DestinationID *uint              `json:"destination_id,omitempty"`
+	Latitude      *float64           `json:"latitude,omitempty"`
+	Longitude     *float64           `json:"longitude,omitempty"`
+	StartDate     time.Time          `json:"start_date"`
+	EndDate       time.Time          `json:"end_date"`
+	Description   string             `json:"description"`
+	Budget        float64            `json:"budget"`
+	Currency      string             `json:"currency,omitempty"`
+	IsPublic      bool               `json:"is_public"`
+	Activities    []ActivitySnapshot `json:"activities"`
+	Expenses      []ExpenseSnapshot  `json:"expenses"`
+}
+
+type ActivitySnapshot struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Location    string    `json:"location"`
+	Latitude    *float64  `json:"latitude,omitempty"`
+	Longitude   *float64  `json:"longitude,omitempty"`
+	Date        time.Time `json:"date"`
+}
+
+type ExpenseSnapshot struct {
+	ID          uint      `json:"id"`
+	Category    string    `json:"category"`
+	Amount      float64   `json:"amount"`
+	Currency    string    `json:"currency"`
+	ExpenseDate time.Time `json:"expense_date"`
+	Description string    `json:"description,omitempty"`
+	ImportRef   string    `json:"import_ref,omitempty"`
+
+	ExchangeRate float64 `json:"exchange_rate,omitempty"`
+	HomeAmount   float64 `json:"home_amount,omitempty"`
+
+	PayerID   *uint          `json:"payer_id,omitempty"`
+	SplitType string         `json:"split_type,omitempty"`
+	Splits    []ExpenseSplit `json:"splits,omitempty"`
+}
+
+// NewTripSnapshot - Gezinin şu anki halini (yüklü aktivite ve harcamalarıyla) kopyalar
+func NewTripSnapshot(trip *Trip) TripSnapshot {
+	snapshot := TripSnapshot{
+		Title:         trip.Title,
+		Destination:   trip.Destination,
+		DestinationID: trip.DestinationID,
+		Latitude:      trip.Latitude,
+		Longitude:     trip.Longitude,
+		StartDate:     trip.StartDate,
+		EndDate:       trip.EndDate,
+		Description:   trip.Description,
+		Budget:        trip.Budget,
+		Currency:      trip.Currency,
+		IsPublic:      trip.IsPublic,
+		Activities:    []ActivitySnapshot{},
+		Expenses:      []ExpenseSnapshot{},
+	}
+
+	for _, a := range trip.Activities {
+		snapshot.Activities = append(snapshot.Activities, ActivitySnapshot{
+			ID:          a.ID,
+			Name:        a.Name,
+			Description: a.Description,
+			Location:    a.Location,
+			Latitude:    a.Latitude,
+			Longitude:   a.Longitude,
+			Date:        a.Date,
+		})
+	}
+
+	for _, e := range trip.Expenses {
+		snapshot.Expenses = append(snapshot.Expenses, ExpenseSnapshot{
+			ID:          e.ID,
+			Category:    e.Category,
+			Amount:      e.Amount,
+			Currency:    e.Currency,
+			ExpenseDate: e.ExpenseDate,
+			Description: e.Description,
+			ImportRef:   e.ImportRef,
+
+			ExchangeRate: e.ExchangeRate,
+			HomeAmount:   e.HomeAmount,
+
+			PayerID:   e.PayerID,
+			SplitType: e.SplitType,
+			Splits:    append([]ExpenseSplit(nil), e.Splits...),
+		})
+	}
+
+	return snapshot
+}
+
+// ApplyTo - Snapshot'taki alanları geziye yazar; aktivite ve harcamalar ID'leriyle birlikte değiştirilir
+func (s TripSnapshot) ApplyTo(trip *Trip) {
+	trip.Title = s.Title
+	// Katalog bağlantısı eklenmeden önceki kayıtlarda boş; destinasyon aynıysa mevcut bağlantı korunur
+	if s.DestinationID != nil || s.Destination != trip.Destination {
+		trip.DestinationID = s.DestinationID
+	}
+	trip.Destination = s.Destination
+	trip.Latitude = s.Latitude
+	trip.Longitude = s.Longitude
+	trip.StartDate = s.StartDate
+	trip.EndDate = s.EndDate
+	trip.Description = s.Description
+	trip.Budget = s.Budget
+	trip.IsPublic = s.IsPublic
+	if s.Currency != "" { // para birimi eklenmeden önceki kayıtlarda boş
+		trip.Currency = s.Currency
+	}
+
+	trip.Activities = []Activity{}
+	for _, a := range s.Activities {
+		trip.Activities = append(trip.Activities, Activity{
+			ID:          a.ID,
+			TripID:      trip.ID,
+			Name:        a.Name,
+			Description: a.Description,
+			Location:    a.Location,
+			Latitude:    a.Latitude,
+			Longitude:   a.Longitude,
+			Date:        a.Date,
+		})
+	}
+
+	trip.Expenses = []Expense{}
+	for _, e := range s.Expenses {
+		trip.Expenses = append(trip.Expenses, Expense{
+			ID:          e.ID,
+			TripID:      trip.ID,
+			Category:    e.Category,
+			Amount:      e.Amount,
+			Currency:    e.Currency,
+			ExpenseDate: e.ExpenseDate,
+			Description: e.Description,
+			ImportRef:   e.ImportRef,
+
+			ExchangeRate: e.ExchangeRate,
+			HomeAmount:   e.HomeAmount,
+
+			PayerID:   e.PayerID,
+			SplitType: e.SplitType,
+			Splits:    append([]ExpenseSplit(nil), e.Splits...),
+		})
+	}
+}
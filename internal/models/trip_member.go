@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// TripMember - Gezi sahibinin geziye eklediği kullanıcı (checklist atamaları vb. için)
+// Gezi sahibi ayrıca üye olarak saklanmaz, Trip.UserID'dir
+type TripMember struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	TripID    uint      `gorm:"not null;uniqueIndex:idx_trip_member" json:"trip_id"`
+	UserID    uint      `gorm:"not null;uniqueIndex:idx_trip_member" json:"user_id"`
+	User      User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
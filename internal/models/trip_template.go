@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// TripTemplate - Bir geziden yayınlanan, tekrar kullanılabilir plan
+// Tarihler yerine gün ofsetleri saklanır; harcamalar şablona dahil edilmez
+type TripTemplate struct {
+	ID           uint               `gorm:"primaryKey" json:"id"`
+	UserID       uint               `gorm:"not null;index" json:"user_id"`
+	User         User               `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	SourceTripID uint               `json:"source_trip_id"`
+	Title        string             `gorm:"not null" json:"title"`
+	Destination  string             `gorm:"not null" json:"destination"`
+	Latitude     *float64           `json:"latitude,omitempty"`
+	Longitude    *float64           `json:"longitude,omitempty"`
+	Description  string             `json:"description"`
+	DurationDays int                `json:"duration_days"` // Başlangıç ile bitiş arasındaki gün sayısı
+	Budget       float64            `json:"budget"`
+	Currency     string             `gorm:"size:3;not null;default:EUR" json:"currency"`
+	IsPublic     bool               `json:"is_public"`
+	Activities   []TemplateActivity `gorm:"foreignKey:TemplateID" json:"activities,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type TemplateActivity struct {
+	ID          uint     `gorm:"primaryKey" json:"id"`
+	TemplateID  uint     `gorm:"not null;index" json:"template_id"`
+	DayOffset   int      `json:"day_offset"` // Gezinin ilk gününe göre (0 = ilk gün)
+	Name        string   `gorm:"not null" json:"name"`
+	Description string   `json:"description"`
+	Location    string   `json:"location"`
+	Latitude    *float64 `json:"latitude,omitempty"`
+	Longitude   *float64 `json:"longitude,omitempty"`
+}
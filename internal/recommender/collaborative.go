@@ -0,0 +1,195 @@
+package recommender
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Öğe anahtarları tür önekiyle ayrılır: "dest:rome", "act:museum"
+const (
+	destinationPrefix = "dest:"
+	activityPrefix    = "act:"
+)
+
+// DestinationItem - Destinasyonun (kanonik şehir anahtarı) öğe anahtarı
+func DestinationItem(key string) string {
+	return destinationPrefix + strings.ToLower(strings.TrimSpace(key))
+}
+
+// ActivityItem - Aktivite anahtar kelimesinin öğe anahtarı
+func ActivityItem(keyword string) string {
+	return activityPrefix + strings.ToLower(strings.TrimSpace(keyword))
+}
+
+// IsDestination - Öğe bir destinasyon mu (öneri adayı sadece destinasyonlar)
+func IsDestination(item string) bool {
+	return strings.HasPrefix(item, destinationPrefix)
+}
+
+// Interaction - Bir kullanıcının bir gezide bir öğeyle etkileşimi
+type Interaction struct {
+	UserID uint
+	TripID uint
+	Item   string
+	Label  string    // Gösterim adı, ör. "Rome, Italy" veya "museum"
+	Date   time.Time // Gezinin başlangıcı; değerlendirmede en son gezi ayrılır
+}
+
+// Model - Kullanıcı × öğe verisinden öğe tabanlı işbirlikçi filtreleme modeli
+// Benzerlik, öğeyi seçen kullanıcı kümeleri arasındaki kosinüs benzerliğidir
+type Model struct {
+	users      map[uint]map[string]bool      // kullanıcı -> öğeler
+	counts     map[string]int                // öğe -> kullanıcı sayısı
+	similarity map[string]map[string]float64 // öğe -> öğe -> benzerlik
+	labels     map[string]string
+	BuiltAt    time.Time
+}
+
+// Match - Aday destinasyonun puanı ve puana en çok katkı veren kullanıcı öğesi
+type Match struct {
+	Score   float64 // 0-1
+	Because string  // Etiket, ör. "Rome"
+	Item    string
+}
+
+// Build - Etkileşimlerden modeli oluşturur; aynı kullanıcının tekrar eden öğeleri bir kez sayılır
+func Build(interactions []Interaction) *Model {
+	m := &Model{
+		users:      make(map[uint]map[string]bool),
+		counts:     make(map[string]int),
+		similarity: make(map[string]map[string]float64),
+		labels:     make(map[string]string),
+		BuiltAt:    time.Now(),
+	}
+
+	for _, in := range interactions {
+		if in.Item == "" {
+			continue
+		}
+		if _, exists := m.users[in.UserID]; !exists {
+			m.users[in.UserID] = make(map[string]bool)
+		}
+		if !m.users[in.UserID][in.Item] {
+			m.users[in.UserID][in.Item] = true
+			m.counts[in.Item]++
+		}
+		if _, exists := m.labels[in.Item]; !exists && in.Label != "" {
+			m.labels[in.Item] = in.Label
+		}
+	}
+
+	// Ortak kullanıcı sayıları (co-occurrence)
+	together := make(map[string]map[string]int)
+	for _, items := range m.users {
+		for a := range items {
+			for b := range items {
+				if a == b {
+					continue
+				}
+				if _, exists := together[a]; !exists {
+					together[a] = make(map[string]int)
+				}
+				together[a][b]++
+			}
+		}
+	}
+	for a, others := range together {
+		m.similarity[a] = make(map[string]float64, len(others))
+		for b, count := range others {
+			m.similarity[a][b] = float64(count) / math.Sqrt(float64(m.counts[a]*m.counts[b]))
+		}
+	}
+	return m
+}
+
+// Users - Modeldeki kullanıcı sayısı
+func (m *Model) Users() int {
+	return len(m.users)
+}
+
+// Destinations - Modeldeki destinasyon sayısı
+func (m *Model) Destinations() int {
+	count := 0
+	for item := range m.counts {
+		if IsDestination(item) {
+			count++
+		}
+	}
+	return count
+}
+
+// Knows - Öğe eğitim verisinde geçiyor mu
+func (m *Model) Knows(item string) bool {
+	return m.counts[item] > 0
+}
+
+// Similarity - İki öğenin kosinüs benzerliği (0-1)
+func (m *Model) Similarity(a, b string) float64 {
+	return m.similarity[a][b]
+}
+
+// Label - Öğenin gösterim adı
+func (m *Model) Label(item string) string {
+	if label, exists := m.labels[item]; exists {
+		return label
+	}
+	return strings.TrimPrefix(strings.TrimPrefix(item, destinationPrefix), activityPrefix)
+}
+
+// Score - Adayın kullanıcı öğelerine ortalama benzerliği
+func (m *Model) Score(userItems []string, candidate string) Match {
+	match := Match{}
+	if len(userItems) == 0 {
+		return match
+	}
+
+	best := 0.0
+	total := 0.0
+	for _, item := range userItems {
+		sim := m.Similarity(candidate, item)
+		total += sim
+		if sim > best {
+			best = sim
+			match.Item = item
+			match.Because = m.Label(item)
+		}
+	}
+	match.Score = total / float64(len(userItems))
+	return match
+}
+
+// Recommend - Kullanıcının sahip olmadığı destinasyonlardan en yüksek puanlı k tanesi
+func (m *Model) Recommend(userItems []string, k int) []string {
+	owned := make(map[string]bool, len(userItems))
+	for _, item := range userItems {
+		owned[item] = true
+	}
+
+	type scored struct {
+		item  string
+		score float64
+	}
+	var candidates []scored
+	for item := range m.counts {
+		if !IsDestination(item) || owned[item] {
+			continue
+		}
+		if score := m.Score(userItems, item).Score; score > 0 {
+			candidates = append(candidates, scored{item: item, score: score})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].item < candidates[j].item
+	})
+
+	var items []string
+	for i := 0; i < len(candidates) && i < k; i++ {
+		items = append(items, candidates[i].item)
+	}
+	return items
+}
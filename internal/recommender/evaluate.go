@@ -0,0 +1,84 @@
+package recommender
+
+import "sort"
+
+// Evaluation - Ayrılmış gezilerle çevrimdışı değerlendirme sonucu
+type Evaluation struct {
+	K            int     `json:"k"`
+	Users        int     `json:"users"` // Değerlendirilebilen kullanıcı sayısı
+	Hits         int     `json:"hits"`
+	PrecisionAtK float64 `json:"precision_at_k"` // hits / (k × users)
+	HitRate      float64 `json:"hit_rate"`       // hits / users (tek ayrılmış gezide recall@k)
+}
+
+// Evaluate - Her kullanıcının en son gezisini ayırır, kalan tüm verilerle modeli eğitir ve
+// ayrılan destinasyonun ilk k öneride olup olmadığını ölçer
+// En az iki gezisi olan ve ayrılan destinasyona daha önce gitmemiş kullanıcılar değerlendirilir
+func Evaluate(interactions []Interaction, k int) Evaluation {
+	result := Evaluation{K: k}
+	if k <= 0 {
+		return result
+	}
+
+	// Kullanıcı başına en son gezi
+	latest := make(map[uint]Interaction)
+	trips := make(map[uint]map[uint]bool)
+	for _, in := range interactions {
+		if !IsDestination(in.Item) {
+			continue
+		}
+		if _, exists := trips[in.UserID]; !exists {
+			trips[in.UserID] = make(map[uint]bool)
+		}
+		trips[in.UserID][in.TripID] = true
+
+		current, exists := latest[in.UserID]
+		if !exists || in.Date.After(current.Date) || (in.Date.Equal(current.Date) && in.TripID > current.TripID) {
+			latest[in.UserID] = in
+		}
+	}
+
+	users := make([]uint, 0, len(latest))
+	for userID := range latest {
+		if len(trips[userID]) >= 2 {
+			users = append(users, userID)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i] < users[j] })
+
+	for _, userID := range users {
+		target := latest[userID]
+
+		// Sadece bu kullanıcının son gezisi eğitimden çıkarılır (leave-one-out)
+		var training []Interaction
+		var userItems []string
+		revisit := false
+		for _, in := range interactions {
+			if in.TripID == target.TripID {
+				continue
+			}
+			training = append(training, in)
+			if in.UserID == userID {
+				userItems = append(userItems, in.Item)
+				revisit = revisit || in.Item == target.Item
+			}
+		}
+		if revisit {
+			continue
+		}
+
+		result.Users++
+		for _, item := range Build(training).Recommend(userItems, k) {
+			if item == target.Item {
+				result.Hits++
+				break
+			}
+		}
+	}
+
+	if result.Users > 0 {
+		result.PrecisionAtK = float64(result.Hits) / float64(k*result.Users)
+		result.HitRate = float64(result.Hits) / float64(result.Users)
+	}
+	return result
+}
@@ -0,0 +1,211 @@
+package recommender
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed climate.csv
+var climateCSV string
+
+// MinSeasonTrips - Mevsimin gezilerden çıkarılması için gereken en az gezi sayısı
+const MinSeasonTrips = 3
+
+// Mevsim bilgisinin kaynağı
+const (
+	SeasonFromTrips   = "trips"
+	SeasonFromClimate = "climate"
+)
+
+// Seasons - Kuzey yarımküre mevsimleri, gösterim sırasıyla
+var Seasons = []string{"Winter", "Spring", "Summer", "Fall"}
+
+var seasonMonths = map[string][]time.Month{
+	"Winter": {time.December, time.January, time.February},
+	"Spring": {time.March, time.April, time.May},
+	"Summer": {time.June, time.July, time.August},
+	"Fall":   {time.September, time.October, time.November},
+}
+
+// SeasonSample - Mevsim çıkarımı için bir public gezinin başlangıcı ve EUR bütçesi (0 = bilinmiyor)
+type SeasonSample struct {
+	Month  time.Month
+	Budget float64
+}
+
+// SeasonInfo - Destinasyonun en iyi mevsimi ve o mevsimlerin ayları
+type SeasonInfo struct {
+	Season string       // ör. "Spring/Fall"; bilinmiyorsa "All Year"
+	Months []time.Month // Sıralı; boşsa her ay uygun sayılır
+	Source string       // SeasonFromTrips, SeasonFromClimate veya ""
+	Trips  int          // Gezilerden çıkarıldıysa kullanılan gezi sayısı
+}
+
+// Fits - Ay bu destinasyon için uygun mu (bilgi yoksa her ay uygun)
+func (info SeasonInfo) Fits(month time.Month) bool {
+	if len(info.Months) == 0 {
+		return true
+	}
+	for _, m := range info.Months {
+		if m == month {
+			return true
+		}
+	}
+	return false
+}
+
+// SeasonOf - Ayın mevsimi
+func SeasonOf(month time.Month) string {
+	for season, months := range seasonMonths {
+		for _, m := range months {
+			if m == month {
+				return season
+			}
+		}
+	}
+	return ""
+}
+
+// BestSeason - Önce public gezilerin başlangıç ayları, yeterli gezi yoksa iklim verisi
+func BestSeason(city string, samples []SeasonSample) SeasonInfo {
+	if info, ok := InferSeason(samples); ok {
+		return info
+	}
+	if months, ok := ClimateMonths(city); ok {
+		return SeasonInfo{Season: SeasonLabel(months), Months: months, Source: SeasonFromClimate}
+	}
+	return SeasonInfo{Season: "All Year"}
+}
+
+// InferSeason - En çok gezi başlayan mevsim ve ona yakın (%75 ve üstü) mevsimler seçilir
+// Eşit sayıda gezide ortalama bütçesi düşük olan mevsim öne geçer
+func InferSeason(samples []SeasonSample) (SeasonInfo, bool) {
+	if len(samples) < MinSeasonTrips {
+		return SeasonInfo{}, false
+	}
+
+	counts := make(map[string]int)
+	budgetTotals := make(map[string]float64)
+	budgetCounts := make(map[string]int)
+	for _, sample := range samples {
+		season := SeasonOf(sample.Month)
+		counts[season]++
+		if sample.Budget > 0 {
+			budgetTotals[season] += sample.Budget
+			budgetCounts[season]++
+		}
+	}
+	avgBudget := func(season string) float64 {
+		if budgetCounts[season] == 0 {
+			return 0
+		}
+		return budgetTotals[season] / float64(budgetCounts[season])
+	}
+
+	ranked := append([]string(nil), Seasons...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+		return avgBudget(a) > 0 && (avgBudget(b) == 0 || avgBudget(a) < avgBudget(b))
+	})
+
+	top := counts[ranked[0]]
+	var chosen []string
+	for _, season := range ranked {
+		if counts[season] > 0 && float64(counts[season]) >= float64(top)*0.75 {
+			chosen = append(chosen, season)
+		}
+	}
+
+	var months []time.Month
+	for _, season := range chosen {
+		months = append(months, seasonMonths[season]...)
+	}
+	sort.Slice(months, func(i, j int) bool { return months[i] < months[j] })
+
+	// Gösterimde en iyi mevsim önce, ör. "Fall/Spring"
+	return SeasonInfo{Season: joinSeasons(chosen), Months: months, Source: SeasonFromTrips, Trips: len(samples)}, true
+}
+
+// SeasonLabel - Üç ayından en az ikisi iyi olan mevsimler, ör. [4 5 6 9 10] -> "Spring/Fall"
+func SeasonLabel(months []time.Month) string {
+	good := make(map[time.Month]bool, len(months))
+	for _, m := range months {
+		good[m] = true
+	}
+
+	var chosen []string
+	for _, season := range Seasons {
+		count := 0
+		for _, m := range seasonMonths[season] {
+			if good[m] {
+				count++
+			}
+		}
+		if count >= 2 {
+			chosen = append(chosen, season)
+		}
+	}
+	if len(chosen) == 0 && len(months) > 0 {
+		chosen = append(chosen, SeasonOf(months[0]))
+	}
+	return joinSeasons(chosen)
+}
+
+func joinSeasons(seasons []string) string {
+	if len(seasons) == 0 || len(seasons) == len(Seasons) {
+		return "All Year"
+	}
+	return strings.Join(seasons, "/")
+}
+
+var (
+	climate     map[string][]time.Month
+	climateOnce sync.Once
+)
+
+// ClimateMonths - Gömülü iklim verisinde şehrin en iyi ayları
+func ClimateMonths(city string) ([]time.Month, bool) {
+	climateOnce.Do(func() {
+		var err error
+		if climate, err = loadClimate(climateCSV); err != nil {
+			panic(fmt.Sprintf("embedded climate data is invalid: %v", err))
+		}
+	})
+	months, ok := climate[strings.ToLower(strings.TrimSpace(city))]
+	return months, ok
+}
+
+// loadClimate - "city,best_months" başlıklı CSV; aylar "|" ile ayrılır
+func loadClimate(data string) (map[string][]time.Month, error) {
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]time.Month)
+	for i, rec := range records[1:] {
+		if len(rec) != 2 {
+			return nil, fmt.Errorf("line %d: expected 2 columns", i+2)
+		}
+		var months []time.Month
+		for _, value := range strings.Split(rec[1], "|") {
+			month, err := strconv.Atoi(value)
+			if err != nil || month < 1 || month > 12 {
+				return nil, fmt.Errorf("line %d: invalid month %q", i+2, value)
+			}
+			months = append(months, time.Month(month))
+		}
+		sort.Slice(months, func(i, j int) bool { return months[i] < months[j] })
+		result[strings.ToLower(rec[0])] = months
+	}
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package report
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteCSV - Raporu tek bir CSV olarak yazar: kalemler, ardından boş satırla ayrılmış
+// kategori ve gün özetleri ile genel toplam
+func WriteCSV(w io.Writer, r *ExpenseReport) error {
+	cw := csv.NewWriter(w)
+
+	homeColumn := "Amount (" + r.Currency + ")"
+	rows := [][]string{
+		{csvText(r.Title)},
+		{"Period", r.Period()},
+		{},
+		{"Date", "Trip", "Category", "Description", "Amount", "Currency", homeColumn},
+	}
+	for _, line := range r.Lines {
+		rows = append(rows, []string{
+			line.Date.Format(dateLayout),
+			csvText(line.Trip),
+			csvText(line.Category),
+			csvText(line.Description),
+			money(line.Amount),
+			line.Currency,
+			money(line.HomeAmount),
+		})
+	}
+
+	rows = append(rows, []string{}, []string{"Category", "Expenses", homeColumn})
+	for _, total := range r.ByCategory {
+		rows = append(rows, []string{csvText(total.Key), strconv.Itoa(total.Count), money(total.Amount)})
+	}
+
+	rows = append(rows, []string{}, []string{"Day", "Expenses", homeColumn})
+	for _, total := range r.ByDay {
+		rows = append(rows, []string{total.Key, strconv.Itoa(total.Count), money(total.Amount)})
+	}
+
+	rows = append(rows, []string{}, []string{"Total", strconv.Itoa(len(r.Lines)), money(r.Total)})
+
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// money - İki ondalıklı, binlik ayırıcısız tutar (tablolama programları sayı olarak okusun)
+func money(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+// csvText - Kullanıcıdan veya ekstreden gelen metin; formül gibi başlayanlar tablolama
+// programında çalıştırılmasın diye ' ile başlatılır (CSV injection)
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
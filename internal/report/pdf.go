@@ -0,0 +1,253 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// PDF çıktısı harici kütüphane olmadan yazılır: A4 sayfalar, standart Helvetica fontları
+// ve WinAnsi kodlaması. Bu kodlamada olmayan karakterler "?" olarak basılır
+
+const (
+	pageWidth   = 595 // A4, punto
+	pageHeight  = 842
+	pageMargin  = 40
+	lineHeight  = 14
+	fontSize    = 9
+	titleSize   = 16
+	sectionSize = 12
+	regularFont = "F1"
+	boldFont    = "F2"
+	tripChars   = 20 // Trip ve açıklama sütunlarında kırpma sınırları
+	textChars   = 28
+)
+
+// pdfColumn - Tablo sütunu; sayılar sağa yaslanır (X sütunun sağ kenarıdır)
+type pdfColumn struct {
+	Title string
+	X     float64
+	Right bool
+}
+
+var lineColumns = []pdfColumn{
+	{"Date", pageMargin, false},
+	{"Trip", 100, false},
+	{"Category", 215, false},
+	{"Description", 290, false},
+	{"Amount", 480, true},
+	{"", 485, false}, // para birimi
+	{"", pageWidth - pageMargin, true},
+}
+
+var totalColumns = []pdfColumn{
+	{"", pageMargin, false},
+	{"Expenses", 300, true},
+	{"", pageWidth - pageMargin, true},
+}
+
+// pdfDocument - Sayfa sayfa içerik akışları; yeni sayfa gerektiğinde otomatik açılır
+type pdfDocument struct {
+	pages   []*bytes.Buffer
+	y       float64
+	columns []pdfColumn // sayfa taşınca tekrar basılacak tablo başlığı
+}
+
+// WritePDF - Raporu yazdırılabilir bir PDF olarak yazar: başlık, kalemler,
+// kategori ve gün özetleri, genel toplam
+func WritePDF(w io.Writer, r *ExpenseReport) error {
+	doc := &pdfDocument{}
+	doc.newPage()
+
+	doc.text(boldFont, titleSize, pageMargin, r.Title)
+	doc.y -= lineHeight + 6
+	doc.text(regularFont, fontSize+1, pageMargin, fmt.Sprintf("%s · %d expenses · total %s %s", r.Period(), len(r.Lines), money(r.Total), r.Currency))
+	doc.y -= 2 * lineHeight
+
+	homeColumn := "Amount (" + r.Currency + ")"
+	columns := append([]pdfColumn{}, lineColumns...)
+	columns[len(columns)-1].Title = homeColumn
+
+	doc.table(columns)
+	for _, line := range r.Lines {
+		doc.row(columns, []string{
+			line.Date.Format(dateLayout),
+			truncate(line.Trip, tripChars),
+			line.Category,
+			truncate(line.Description, textChars),
+			money(line.Amount),
+			line.Currency,
+			money(line.HomeAmount),
+		}, regularFont)
+	}
+	doc.row(columns, []string{"Total", "", "", "", "", "", money(r.Total)}, boldFont)
+
+	sections := []struct {
+		title  string
+		totals []Total
+	}{
+		{"By category", r.ByCategory},
+		{"By day", r.ByDay},
+	}
+	for _, section := range sections {
+		doc.columns = nil
+		doc.y -= lineHeight
+		doc.ensureSpace(4 * lineHeight)
+		doc.text(boldFont, sectionSize, pageMargin, section.title)
+		doc.y -= lineHeight + 4
+
+		columns := append([]pdfColumn{}, totalColumns...)
+		columns[len(columns)-1].Title = homeColumn
+		doc.table(columns)
+		for _, total := range section.totals {
+			doc.row(columns, []string{total.Key, strconv.Itoa(total.Count), money(total.Amount)}, regularFont)
+		}
+	}
+
+	return doc.write(w)
+}
+
+func (d *pdfDocument) newPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.y = pageHeight - pageMargin - titleSize
+	if d.columns != nil {
+		d.header(d.columns)
+	}
+}
+
+// ensureSpace - Sayfada yeterli yer yoksa yeni sayfaya geçer
+func (d *pdfDocument) ensureSpace(height float64) {
+	if d.y-height < pageMargin {
+		d.newPage()
+	}
+}
+
+// table - Tablo başlığını basar; tablo bitene kadar her yeni sayfada tekrarlanır
+func (d *pdfDocument) table(columns []pdfColumn) {
+	d.columns = nil
+	d.ensureSpace(2 * lineHeight)
+	d.columns = columns
+	d.header(columns)
+}
+
+func (d *pdfDocument) header(columns []pdfColumn) {
+	titles := make([]string, len(columns))
+	for i, column := range columns {
+		titles[i] = column.Title
+	}
+	d.cells(columns, titles, boldFont)
+	page := d.pages[len(d.pages)-1]
+	fmt.Fprintf(page, "0.5 w %d %.1f m %d %.1f l S\n", pageMargin, d.y+lineHeight-3, pageWidth-pageMargin, d.y+lineHeight-3)
+}
+
+func (d *pdfDocument) row(columns []pdfColumn, values []string, font string) {
+	d.ensureSpace(lineHeight)
+	d.cells(columns, values, font)
+}
+
+func (d *pdfDocument) cells(columns []pdfColumn, values []string, font string) {
+	for i, column := range columns {
+		if values[i] == "" {
+			continue
+		}
+		x := column.X
+		if column.Right {
+			x -= textWidth(values[i], fontSize)
+		}
+		d.text(font, fontSize, x, values[i])
+	}
+	d.y -= lineHeight
+}
+
+func (d *pdfDocument) text(font string, size, x float64, value string) {
+	page := d.pages[len(d.pages)-1]
+	fmt.Fprintf(page, "BT /%s %g Tf %.1f %.1f Td (%s) Tj ET\n", font, size, x, d.y, pdfString(value))
+}
+
+// write - Nesneler: 1 katalog, 2 sayfa ağacı, 3-4 fontlar, ardından her sayfa için sayfa + içerik
+func (d *pdfDocument) write(w io.Writer) error {
+	var (
+		out     bytes.Buffer
+		offsets []int
+	)
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] "+
+			"/Resources << /Font << /%s 3 0 R /%s 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, regularFont, boldFont, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+// pdfString - Metni WinAnsi'ye çevirir ve PDF string'i için kaçışlar
+func pdfString(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(byte(r))
+		case r == '€':
+			b.WriteByte(0x80)
+		case r == '…':
+			b.WriteByte(0x85)
+		case r >= 32 && r < 127, r >= 160 && r <= 255:
+			b.WriteByte(byte(r))
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// textWidth - Sağa yaslama için yaklaşık genişlik (Helvetica rakam ve noktalama ölçüleri)
+func textWidth(value string, size float64) float64 {
+	width := 0.0
+	for _, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			width += 556
+		case r == '.' || r == ',' || r == ' ':
+			width += 278
+		case r == '-':
+			width += 333
+		default:
+			width += 600
+		}
+	}
+	return width * size / 1000
+}
+
+func truncate(value string, max int) string {
+	runes := []rune(value)
+	if len(runes) <= max {
+		return value
+	}
+	return string(runes[:max-1]) + "…"
+}
@@ -0,0 +1,110 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// Line - Rapordaki tek harcama
+// HomeAmount raporun para birimindeki tutardır; toplamlar bundan hesaplanır
+type Line struct {
+	Date        time.Time `json:"date"`
+	Trip        string    `json:"trip"`
+	Category    string    `json:"category"`
+	Description string    `json:"description,omitempty"`
+	Amount      float64   `json:"amount"`
+	Currency    string    `json:"currency"`
+	HomeAmount  float64   `json:"home_amount"`
+}
+
+// Total - Bir grubun (kategori veya gün) harcama sayısı ve toplamı
+type Total struct {
+	Key    string  `json:"key"`
+	Count  int     `json:"count"`
+	Amount float64 `json:"amount"`
+}
+
+// ExpenseReport - Masraf raporu; kalemler tarihe göre sıralıdır
+// From/To sıfırsa rapor tarih aralığıyla sınırlanmamıştır
+type ExpenseReport struct {
+	Title       string    `json:"title"`
+	Currency    string    `json:"currency"`
+	From        time.Time `json:"from,omitempty"`
+	To          time.Time `json:"to,omitempty"`
+	GeneratedAt time.Time `json:"generated_at"`
+	Lines       []Line    `json:"lines"`
+	ByCategory  []Total   `json:"by_category"`
+	ByDay       []Total   `json:"by_day"`
+	Total       float64   `json:"total"`
+}
+
+// New - Kalemleri sıralar, kategori ve gün bazında gruplar
+func New(title, currency string, from, to time.Time, lines []Line) *ExpenseReport {
+	sorted := append([]Line{}, lines...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	report := &ExpenseReport{
+		Title:       title,
+		Currency:    currency,
+		From:        from,
+		To:          to,
+		GeneratedAt: time.Now().UTC(),
+		Lines:       sorted,
+		ByCategory:  []Total{},
+		ByDay:       []Total{},
+	}
+
+	categories := make(map[string]int)
+	days := make(map[string]int)
+	for _, line := range sorted {
+		report.Total += line.HomeAmount
+		addTo(categories, &report.ByCategory, line.Category, line.HomeAmount)
+		addTo(days, &report.ByDay, line.Date.Format(dateLayout), line.HomeAmount)
+	}
+
+	// Kategoriler tutara göre azalan, günler tarih sırasında
+	sort.SliceStable(report.ByCategory, func(i, j int) bool {
+		return report.ByCategory[i].Amount > report.ByCategory[j].Amount
+	})
+	for i := range report.ByCategory {
+		report.ByCategory[i].Amount = round(report.ByCategory[i].Amount)
+	}
+	for i := range report.ByDay {
+		report.ByDay[i].Amount = round(report.ByDay[i].Amount)
+	}
+	report.Total = round(report.Total)
+	return report
+}
+
+// Period - Rapor aralığının okunabilir hali
+func (r *ExpenseReport) Period() string {
+	switch {
+	case r.From.IsZero() && r.To.IsZero():
+		return "All dates"
+	case r.From.IsZero():
+		return "Until " + r.To.Format(dateLayout)
+	case r.To.IsZero():
+		return "From " + r.From.Format(dateLayout)
+	}
+	return fmt.Sprintf("%s to %s", r.From.Format(dateLayout), r.To.Format(dateLayout))
+}
+
+// addTo - Gruplar ilk görüldükleri sırayla listeye eklenir; index anahtarın listedeki yeri
+func addTo(index map[string]int, list *[]Total, key string, amount float64) {
+	i, ok := index[key]
+	if !ok {
+		i = len(*list)
+		index[key] = i
+		*list = append(*list, Total{Key: key})
+	}
+	(*list)[i].Count++
+	(*list)[i].Amount += amount
+}
+
+func round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
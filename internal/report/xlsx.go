@@ -0,0 +1,218 @@
+package report
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// XLSX dosyası Office Open XML (SpreadsheetML) paketidir: zip içinde birkaç XML parçası
+// Metinler inline string olarak yazılır, böylece sharedStrings tablosuna gerek kalmaz
+
+const (
+	xlsxMain          = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+	xlsxRelationships = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+	packageRels       = "http://schemas.openxmlformats.org/package/2006/relationships"
+)
+
+// Hücre stilleri - styles.xml'deki cellXfs sırası
+const (
+	styleDefault = iota
+	styleDate
+	styleMoney
+	styleHeader
+)
+
+// xlsxCell - Tek hücre; IsText değilse Number yazılır
+type xlsxCell struct {
+	Text   string
+	Number float64
+	Style  int
+	IsText bool
+}
+
+type xlsxSheet struct {
+	Name string
+	Rows [][]xlsxCell
+}
+
+// xlsxPart - Zip paketindeki bir dosya
+type xlsxPart struct {
+	name    string
+	content string
+}
+
+func text(value string) xlsxCell    { return xlsxCell{Text: value, IsText: true} }
+func header(value string) xlsxCell  { return xlsxCell{Text: value, IsText: true, Style: styleHeader} }
+func amount(value float64) xlsxCell { return xlsxCell{Number: value, Style: styleMoney} }
+func count(value int) xlsxCell      { return xlsxCell{Number: float64(value)} }
+
+// date - Excel tarihleri 1899-12-30'dan bu yana geçen gün sayısıdır
+func date(value time.Time) xlsxCell {
+	day := time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, time.UTC)
+	epoch := time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	return xlsxCell{Number: day.Sub(epoch).Hours() / 24, Style: styleDate}
+}
+
+// WriteXLSX - Raporu dört sayfalı bir Excel çalışma kitabı olarak yazar:
+// Summary, Expenses, By category, By day
+func WriteXLSX(w io.Writer, r *ExpenseReport) error {
+	homeColumn := "Amount (" + r.Currency + ")"
+
+	summary := xlsxSheet{Name: "Summary", Rows: [][]xlsxCell{
+		{header("Report"), text(r.Title)},
+		{header("Period"), text(r.Period())},
+		{header("Currency"), text(r.Currency)},
+		{header("Expenses"), count(len(r.Lines))},
+		{header("Total"), amount(r.Total)},
+		{header("Generated"), text(r.GeneratedAt.Format(time.RFC3339))},
+	}}
+
+	expenses := xlsxSheet{Name: "Expenses", Rows: [][]xlsxCell{{
+		header("Date"), header("Trip"), header("Category"), header("Description"),
+		header("Amount"), header("Currency"), header(homeColumn),
+	}}}
+	for _, line := range r.Lines {
+		expenses.Rows = append(expenses.Rows, []xlsxCell{
+			date(line.Date), text(line.Trip), text(line.Category), text(line.Description),
+			amount(line.Amount), text(line.Currency), amount(line.HomeAmount),
+		})
+	}
+	expenses.Rows = append(expenses.Rows, []xlsxCell{
+		header("Total"), text(""), text(""), text(""), text(""), text(""), amount(r.Total),
+	})
+
+	categories := xlsxSheet{Name: "By category", Rows: [][]xlsxCell{{header("Category"), header("Expenses"), header(homeColumn)}}}
+	for _, total := range r.ByCategory {
+		categories.Rows = append(categories.Rows, []xlsxCell{text(total.Key), count(total.Count), amount(total.Amount)})
+	}
+
+	days := xlsxSheet{Name: "By day", Rows: [][]xlsxCell{{header("Day"), header("Expenses"), header(homeColumn)}}}
+	for _, total := range r.ByDay {
+		day, _ := time.Parse(dateLayout, total.Key)
+		days.Rows = append(days.Rows, []xlsxCell{date(day), count(total.Count), amount(total.Amount)})
+	}
+
+	return writeWorkbook(w, []xlsxSheet{summary, expenses, categories, days})
+}
+
+func writeWorkbook(w io.Writer, sheets []xlsxSheet) error {
+	parts := []xlsxPart{
+		{"[Content_Types].xml", contentTypesXML(len(sheets))},
+		{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+			`<Relationships xmlns="` + packageRels + `">` +
+			`<Relationship Id="rId1" Type="` + xlsxRelationships + `/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`},
+		{"xl/workbook.xml", workbookXML(sheets)},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXML(len(sheets))},
+		{"xl/styles.xml", stylesXML},
+	}
+	for i, sheet := range sheets {
+		parts = append(parts, xlsxPart{fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), sheetXML(sheet)})
+	}
+
+	zw := zip.NewWriter(w)
+	for _, part := range parts {
+		f, err := zw.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func contentTypesXML(sheetCount int) string {
+	var b bytes.Buffer
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`)
+	b.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	b.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	b.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	b.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	b.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := 1; i <= sheetCount; i++ {
+		fmt.Fprintf(&b, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i)
+	}
+	b.WriteString(`</Types>`)
+	return b.String()
+}
+
+func workbookXML(sheets []xlsxSheet) string {
+	var b bytes.Buffer
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`)
+	fmt.Fprintf(&b, `<workbook xmlns="%s" xmlns:r="%s"><sheets>`, xlsxMain, xlsxRelationships)
+	for i, sheet := range sheets {
+		fmt.Fprintf(&b, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escapeXML(sheet.Name), i+1, i+1)
+	}
+	b.WriteString(`</sheets></workbook>`)
+	return b.String()
+}
+
+func workbookRelsXML(sheetCount int) string {
+	var b bytes.Buffer
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`)
+	fmt.Fprintf(&b, `<Relationships xmlns="%s">`, packageRels)
+	for i := 1; i <= sheetCount; i++ {
+		fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="%s/worksheet" Target="worksheets/sheet%d.xml"/>`, i, xlsxRelationships, i)
+	}
+	fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="%s/styles" Target="styles.xml"/>`, sheetCount+1, xlsxRelationships)
+	b.WriteString(`</Relationships>`)
+	return b.String()
+}
+
+func sheetXML(sheet xlsxSheet) string {
+	var b bytes.Buffer
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`)
+	fmt.Fprintf(&b, `<worksheet xmlns="%s"><sheetData>`, xlsxMain)
+	for i, row := range sheet.Rows {
+		fmt.Fprintf(&b, `<row r="%d">`, i+1)
+		for j, c := range row {
+			ref := columnName(j) + strconv.Itoa(i+1)
+			if c.IsText {
+				fmt.Fprintf(&b, `<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, c.Style, escapeXML(c.Text))
+			} else {
+				fmt.Fprintf(&b, `<c r="%s" s="%d"><v>%s</v></c>`, ref, c.Style, strconv.FormatFloat(c.Number, 'f', -1, 64))
+			}
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+// columnName - 0 -> A, 25 -> Z, 26 -> AA
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+func escapeXML(value string) string {
+	var b bytes.Buffer
+	xml.EscapeText(&b, []byte(value))
+	return b.String()
+}
+
+// stylesXML - cellXfs sırası styleDefault, styleDate, styleMoney, styleHeader sabitleriyle aynı
+const stylesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+	`<styleSheet xmlns="` + xlsxMain + `">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="4">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="4" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`</cellXfs>` +
+	`</styleSheet>`
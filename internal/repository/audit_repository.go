@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"travel-platform/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type AuditRepository interface {
+	CreateEntry(entry *models.TripAuditEntry) error
+	GetEntriesByTripID(tripID uint) ([]models.TripAuditEntry, error)
+	GetEntryByID(id uint) (*models.TripAuditEntry, error)
+}
+
+type auditRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditRepository(db *gorm.DB) AuditRepository {
+	return &auditRepository{db: db}
+}
+
+func (r *auditRepository) CreateEntry(entry *models.TripAuditEntry) error {
+	return r.db.Omit("Actor").Create(entry).Error
+}
+
+// GetEntriesByTripID - Gezinin geçmişi, en yeni kayıt başta
+func (r *auditRepository) GetEntriesByTripID(tripID uint) ([]models.TripAuditEntry, error) {
+	var entries []models.TripAuditEntry
+	result := r.db.Preload("Actor").
+		Where("trip_id = ?", tripID).
+		Order("created_at DESC, id DESC").
+		Find(&entries).Error
+	if result != nil {
+		return nil, result
+	}
+	return entries, nil
+}
+
+func (r *auditRepository) GetEntryByID(id uint) (*models.TripAuditEntry, error) {
+	var entry models.TripAuditEntry
+	result := r.db.First(&entry, id).Error
+	if result != nil {
+		return nil, result
+	}
+	return &entry, nil
+}
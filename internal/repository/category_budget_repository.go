@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"travel-platform/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type CategoryBudgetRepository interface {
+	GetCategoryBudgets(tripID uint) ([]models.CategoryBudget, error)
+	ReplaceCategoryBudgets(tripID uint, budgets []models.CategoryBudget) error
+}
+
+type categoryBudgetRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoryBudgetRepository(db *gorm.DB) CategoryBudgetRepository {
+	return &categoryBudgetRepository{db: db}
+}
+
+// GetCategoryBudgets - Gezinin kategori bütçeleri, kategori adına göre sıralı
+func (r *categoryBudgetRepository) GetCategoryBudgets(tripID uint) ([]models.CategoryBudget, error) {
+	var budgets []models.CategoryBudget
+	result := r.db.Where("trip_id = ?", tripID).Order("category").Find(&budgets).Error
+	if result != nil {
+		return nil, result
+	}
+	return budgets, nil
+}
+
+// ReplaceCategoryBudgets - Gezinin tüm kategori bütçelerini tek transaction'da değiştirir
+func (r *categoryBudgetRepository) ReplaceCategoryBudgets(tripID uint, budgets []models.CategoryBudget) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("trip_id = ?", tripID).Delete(&models.CategoryBudget{}).Error; err != nil {
+			return err
+		}
+		if len(budgets) == 0 {
+			return nil
+		}
+		for i := range budgets {
+			budgets[i].ID = 0
+			budgets[i].TripID = tripID
+		}
+		return tx.Create(&budgets).Error
+	})
+}
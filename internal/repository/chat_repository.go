@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"travel-platform/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type ChatRepository interface {
+	CreateRoom(room *models.ChatRoom) error
+	GetRoomByName(name string) (*models.ChatRoom, error)
+	GetRoomByTripID(tripID uint) (*models.ChatRoom, error)
+	GetMessagesByRoomID(roomID uint) ([]models.ChatMessage, error)
+	CreateMessage(message *models.ChatMessage) error
+}
+
+type chatRepository struct {
+	db *gorm.DB
+}
+
+func NewChatRepository(db *gorm.DB) ChatRepository {
+	return &chatRepository{db: db}
+}
+
+func (r *chatRepository) CreateRoom(room *models.ChatRoom) error {
+	return r.db.Create(room).Error
+}
+
+func (r *chatRepository) GetRoomByName(name string) (*models.ChatRoom, error) {
+	var room models.ChatRoom
+	result := r.db.Where("name = ?", name).First(&room).Error
+	if result != nil {
+		return nil, result
+	}
+	return &room, nil
+}
+
+func (r *chatRepository) GetRoomByTripID(tripID uint) (*models.ChatRoom, error) {
+	var room models.ChatRoom
+	result := r.db.Where("trip_id = ?", tripID).First(&room).Error
+	if result != nil {
+		return nil, result
+	}
+	return &room, nil
+}
+
+func (r *chatRepository) GetMessagesByRoomID(roomID uint) ([]models.ChatMessage, error) {
+	var messages []models.ChatMessage
+	result := r.db.Preload("User").
+		Where("room_id = ?", roomID).
+		Order("created_at ASC").
+		Find(&messages).Error
+	if result != nil {
+		return nil, result
+	}
+	return messages, nil
+}
+
+func (r *chatRepository) CreateMessage(message *models.ChatMessage) error {
+	return r.db.Create(message).Error
+}
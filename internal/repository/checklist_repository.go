@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"travel-platform/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type ChecklistRepository interface {
+	CreateChecklist(checklist *models.Checklist) error
+	GetChecklistsByTripID(tripID uint) ([]models.Checklist, error)
+	GetChecklistByID(id uint) (*models.Checklist, error)
+	DeleteChecklist(id uint) error
+	CreateItem(item *models.ChecklistItem) error
+	GetItemByID(id uint) (*models.ChecklistItem, error)
+	UpdateItem(item *models.ChecklistItem) error
+	DeleteItem(id uint) error
+	CreateTemplate(template *models.ChecklistTemplate) error
+	GetTemplatesByUserID(userID uint) ([]models.ChecklistTemplate, error)
+	GetTemplateByID(id uint) (*models.ChecklistTemplate, error)
+	DeleteTemplate(id uint) error
+}
+
+type checklistRepository struct {
+	db *gorm.DB
+}
+
+func NewChecklistRepository(db *gorm.DB) ChecklistRepository {
+	return &checklistRepository{db: db}
+}
+
+// orderedItems - Maddeler listedeki sırasıyla yüklenir
+func orderedItems(db *gorm.DB) *gorm.DB {
+	return db.Order("position, id")
+}
+
+func (r *checklistRepository) CreateChecklist(checklist *models.Checklist) error {
+	return r.db.Create(checklist).Error
+}
+
+func (r *checklistRepository) GetChecklistsByTripID(tripID uint) ([]models.Checklist, error) {
+	var checklists []models.Checklist
+	result := r.db.Preload("Items", orderedItems).
+		Preload("Items.Assignee").
+		Where("trip_id = ?", tripID).
+		Order("created_at, id").
+		Find(&checklists).Error
+	if result != nil {
+		return nil, result
+	}
+	return checklists, nil
+}
+
+func (r *checklistRepository) GetChecklistByID(id uint) (*models.Checklist, error) {
+	var checklist models.Checklist
+	result := r.db.Preload("Items", orderedItems).
+		Preload("Items.Assignee").
+		First(&checklist, id).Error
+	if result != nil {
+		return nil, result
+	}
+	return &checklist, nil
+}
+
+func (r *checklistRepository) DeleteChecklist(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("checklist_id = ?", id).Delete(&models.ChecklistItem{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Checklist{}, id).Error
+	})
+}
+
+func (r *checklistRepository) CreateItem(item *models.ChecklistItem) error {
+	return r.db.Omit("Assignee").Create(item).Error
+}
+
+func (r *checklistRepository) GetItemByID(id uint) (*models.ChecklistItem, error) {
+	var item models.ChecklistItem
+	result := r.db.First(&item, id).Error
+	if result != nil {
+		return nil, result
+	}
+	return &item, nil
+}
+
+func (r *checklistRepository) UpdateItem(item *models.ChecklistItem) error {
+	return r.db.Omit("Assignee").Save(item).Error
+}
+
+func (r *checklistRepository) DeleteItem(id uint) error {
+	return r.db.Delete(&models.ChecklistItem{}, id).Error
+}
+
+func (r *checklistRepository) CreateTemplate(template *models.ChecklistTemplate) error {
+	return r.db.Create(template).Error
+}
+
+func (r *checklistRepository) GetTemplatesByUserID(userID uint) ([]models.ChecklistTemplate, error) {
+	var templates []models.ChecklistTemplate
+	result := r.db.Preload("Items", orderedItems).
+		Where("user_id = ?", userID).
+		Order("title").
+		Find(&templates).Error
+	if result != nil {
+		return nil, result
+	}
+	return templates, nil
+}
+
+func (r *checklistRepository) GetTemplateByID(id uint) (*models.ChecklistTemplate, error) {
+	var template models.ChecklistTemplate
+	result := r.db.Preload("Items", orderedItems).First(&template, id).Error
+	if result != nil {
+		return nil, result
+	}
+	return &template, nil
+}
+
+func (r *checklistRepository) DeleteTemplate(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("template_id = ?", id).Delete(&models.ChecklistTemplateItem{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.ChecklistTemplate{}, id).Error
+	})
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"travel-platform/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type DestinationRepository interface {
+	GetDestinations() ([]models.Destination, error)
+	GetDestinationByID(id uint) (*models.Destination, error)
+	CreateDestination(destination *models.Destination) error
+	CountTripsByDestination() (map[uint]int64, error)
+	GetUnlinkedTrips() ([]models.Trip, error)
+	LinkTrip(tripID, destinationID uint) error
+}
+
+type destinationRepository struct {
+	db *gorm.DB
+}
+
+func NewDestinationRepository(db *gorm.DB) DestinationRepository {
+	return &destinationRepository{db: db}
+}
+
+// GetDestinations - Tüm katalog, ada göre sıralı
+func (r *destinationRepository) GetDestinations() ([]models.Destination, error) {
+	var destinations []models.Destination
+	result := r.db.Order("name").Find(&destinations).Error
+	if result != nil {
+		return nil, result
+	}
+	return destinations, nil
+}
+
+func (r *destinationRepository) GetDestinationByID(id uint) (*models.Destination, error) {
+	var destination models.Destination
+	result := r.db.First(&destination, id).Error
+	if result != nil {
+		return nil, result
+	}
+	return &destination, nil
+}
+
+func (r *destinationRepository) CreateDestination(destination *models.Destination) error {
+	return r.db.Create(destination).Error
+}
+
+// CountTripsByDestination - Destinasyona bağlı public gezi sayıları (otomatik tamamlamada sıralama için)
+func (r *destinationRepository) CountTripsByDestination() (map[uint]int64, error) {
+	var rows []struct {
+		DestinationID uint
+		Count         int64
+	}
+	result := r.db.Model(&models.Trip{}).
+		Select("destination_id, COUNT(*) AS count").
+		Where("destination_id IS NOT NULL AND is_public = ?", true).
+		Group("destination_id").
+		Scan(&rows).Error
+	if result != nil {
+		return nil, result
+	}
+
+	counts := make(map[uint]int64, len(rows))
+	for _, row := range rows {
+		counts[row.DestinationID] = row.Count
+	}
+	return counts, nil
+}
+
+// GetUnlinkedTrips - Kataloğa henüz bağlanmamış geziler (çöp kutusundakiler dahil)
+func (r *destinationRepository) GetUnlinkedTrips() ([]models.Trip, error) {
+	var trips []models.Trip
+	result := r.db.Unscoped().Where("destination_id IS NULL").Find(&trips).Error
+	if result != nil {
+		return nil, result
+	}
+	return trips, nil
+}
+
+// LinkTrip - Sadece destination_id sütununu günceller (updated_at ve geçmiş değişmez)
+func (r *destinationRepository) LinkTrip(tripID, destinationID uint) error {
+	return r.db.Unscoped().Model(&models.Trip{}).Where("id = ?", tripID).UpdateColumn("destination_id", destinationID).Error
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"travel-platform/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type DocumentRepository interface {
+	CreateDocument(document *models.TravelDocument) error
+	GetDocumentsByUserID(userID uint) ([]models.TravelDocument, error)
+	GetDocumentByID(id uint) (*models.TravelDocument, error)
+	UpdateDocument(document *models.TravelDocument) error
+	DeleteDocument(id uint) error
+}
+
+type documentRepository struct {
+	db *gorm.DB
+}
+
+func NewDocumentRepository(db *gorm.DB) DocumentRepository {
+	return &documentRepository{db: db}
+}
+
+func (r *documentRepository) CreateDocument(document *models.TravelDocument) error {
+	return r.db.Create(document).Error
+}
+
+// GetDocumentsByUserID - Kullanıcının belgeleri, dosya içeriği olmadan (liste için)
+func (r *documentRepository) GetDocumentsByUserID(userID uint) ([]models.TravelDocument, error) {
+	var documents []models.TravelDocument
+	result := r.db.Omit("EncryptedData").
+		Where("user_id = ?", userID).
+		Order("expires_at IS NULL, expires_at, id").
+		Find(&documents).Error
+	if result != nil {
+		return nil, result
+	}
+	return documents, nil
+}
+
+// GetDocumentByID - Belge, şifreli dosya içeriğiyle birlikte
+func (r *documentRepository) GetDocumentByID(id uint) (*models.TravelDocument, error) {
+	var document models.TravelDocument
+	result := r.db.First(&document, id).Error
+	if result != nil {
+		return nil, result
+	}
+	return &document, nil
+}
+
+// UpdateDocument - Sadece belge bilgilerini günceller, dosya içeriğine dokunmaz
+func (r *documentRepository) UpdateDocument(document *models.TravelDocument) error {
+	return r.db.Model(document).
+		Select("TripID", "Type", "Title", "Country", "ExpiresAt", "EncryptedNumber").
+		Updates(document).Error
+}
+
+func (r *documentRepository) DeleteDocument(id uint) error {
+	return r.db.Delete(&models.TravelDocument{}, id).Error
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"time"
+	"travel-platform/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ExchangeRateRepository interface {
+	SaveRate(rate *models.ExchangeRate) error
+	FindRate(base, quote string, from, to time.Time) (*models.ExchangeRate, error)
+}
+
+type exchangeRateRepository struct {
+	db *gorm.DB
+}
+
+func NewExchangeRateRepository(db *gorm.DB) ExchangeRateRepository {
+	return &exchangeRateRepository{db: db}
+}
+
+// SaveRate - Aynı çift ve gün için kayıt varsa kuru günceller
+func (r *exchangeRateRepository) SaveRate(rate *models.ExchangeRate) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "base"}, {Name: "quote"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "source"}),
+	}).Create(rate).Error
+}
+
+// FindRate - [from, to] aralığındaki en yeni kur
+func (r *exchangeRateRepository) FindRate(base, quote string, from, to time.Time) (*models.ExchangeRate, error) {
+	var rate models.ExchangeRate
+	result := r.db.Where("base = ? AND quote = ? AND date >= ? AND date <= ?", base, quote, from, to).
+		Order("date DESC").
+		First(&rate).Error
+	if result != nil {
+		return nil, result
+	}
+	return &rate, nil
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"errors"
+	"travel-platform/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type ExpenseCategoryRepository interface {
+	GetUserCategories(userID uint) ([]models.ExpenseCategory, error)
+	GetCategoryByID(id uint) (*models.ExpenseCategory, error)
+	CreateCategory(category *models.ExpenseCategory) error
+	UpdateCategory(category *models.ExpenseCategory) error
+	DeleteCategory(id uint) error
+	CountExpenses(userID uint, key string) (int64, error)
+	MoveExpenses(userID uint, from, to string) (int64, error)
+}
+
+type expenseCategoryRepository struct {
+	db *gorm.DB
+}
+
+func NewExpenseCategoryRepository(db *gorm.DB) ExpenseCategoryRepository {
+	return &expenseCategoryRepository{db: db}
+}
+
+// userTrips - Kullanıcının sahibi olduğu geziler (çöp kutusundakiler dahil)
+const userTrips = "trip_id IN (SELECT id FROM trips WHERE user_id = ?)"
+
+// GetUserCategories - Kullanıcının özel kategorileri, ada göre sıralı
+func (r *expenseCategoryRepository) GetUserCategories(userID uint) ([]models.ExpenseCategory, error) {
+	var categories []models.ExpenseCategory
+	result := r.db.Where("user_id = ?", userID).Order("name").Find(&categories).Error
+	if result != nil {
+		return nil, result
+	}
+	return categories, nil
+}
+
+func (r *expenseCategoryRepository) GetCategoryByID(id uint) (*models.ExpenseCategory, error) {
+	var category models.ExpenseCategory
+	result := r.db.First(&category, id).Error
+	if result != nil {
+		return nil, result
+	}
+	return &category, nil
+}
+
+func (r *expenseCategoryRepository) CreateCategory(category *models.ExpenseCategory) error {
+	return r.db.Create(category).Error
+}
+
+func (r *expenseCategoryRepository) UpdateCategory(category *models.ExpenseCategory) error {
+	return r.db.Save(category).Error
+}
+
+func (r *expenseCategoryRepository) DeleteCategory(id uint) error {
+	return r.db.Delete(&models.ExpenseCategory{}, id).Error
+}
+
+// CountExpenses - Kullanıcının gezilerinde bu kategorideki harcama sayısı
+func (r *expenseCategoryRepository) CountExpenses(userID uint, key string) (int64, error) {
+	var count int64
+	result := r.db.Unscoped().Model(&models.Expense{}).Where(userTrips+" AND category = ?", userID, key).Count(&count).Error
+	return count, result
+}
+
+// MoveExpenses - Kullanıcının gezilerinde from kategorisindeki harcamaları ve bütçeleri to kategorisine taşır
+// Aynı gezide iki kategorinin de bütçesi varsa tutarlar toplanır
+func (r *expenseCategoryRepository) MoveExpenses(userID uint, from, to string) (int64, error) {
+	var moved int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Model(&models.Expense{}).Where(userTrips+" AND category = ?", userID, from).Update("category", to)
+		if result.Error != nil {
+			return result.Error
+		}
+		moved = result.RowsAffected
+
+		var budgets []models.CategoryBudget
+		if err := tx.Where(userTrips+" AND category = ?", userID, from).Find(&budgets).Error; err != nil {
+			return err
+		}
+		for _, budget := range budgets {
+			var target models.CategoryBudget
+			err := tx.Where("trip_id = ? AND category = ?", budget.TripID, to).First(&target).Error
+			switch {
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				if err := tx.Model(&budget).Update("category", to).Error; err != nil {
+					return err
+				}
+			case err != nil:
+				return err
+			default:
+				if err := tx.Model(&target).Update("amount", target.Amount+budget.Amount).Error; err != nil {
+					return err
+				}
+				if err := tx.Delete(&budget).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	return moved, err
+}
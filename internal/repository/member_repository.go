@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"travel-platform/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type MemberRepository interface {
+	AddMember(member *models.TripMember) error
+	GetMembersByTripID(tripID uint) ([]models.TripMember, error)
+	IsMember(tripID, userID uint) (bool, error)
+	GetTripIDsByUserID(userID uint) ([]uint, error)
+	RemoveMember(tripID, userID uint) error
+}
+
+type memberRepository struct {
+	db *gorm.DB
+}
+
+func NewMemberRepository(db *gorm.DB) MemberRepository {
+	return &memberRepository{db: db}
+}
+
+func (r *memberRepository) AddMember(member *models.TripMember) error {
+	return r.db.Omit("User").Create(member).Error
+}
+
+func (r *memberRepository) GetMembersByTripID(tripID uint) ([]models.TripMember, error) {
+	var members []models.TripMember
+	result := r.db.Preload("User").
+		Where("trip_id = ?", tripID).
+		Order("created_at").
+		Find(&members).Error
+	if result != nil {
+		return nil, result
+	}
+	return members, nil
+}
+
+func (r *memberRepository) IsMember(tripID, userID uint) (bool, error) {
+	var count int64
+	result := r.db.Model(&models.TripMember{}).
+		Where("trip_id = ? AND user_id = ?", tripID, userID).
+		Count(&count).Error
+	return count > 0, result
+}
+
+// GetTripIDsByUserID - Kullanıcının katılımcı olarak eklendiği gezilerin ID'leri
+func (r *memberRepository) GetTripIDsByUserID(userID uint) ([]uint, error) {
+	var tripIDs []uint
+	result := r.db.Model(&models.TripMember{}).
+		Where("user_id = ?", userID).
+		Pluck("trip_id", &tripIDs).Error
+	return tripIDs, result
+}
+
+func (r *memberRepository) RemoveMember(tripID, userID uint) error {
+	result := r.db.Where("trip_id = ? AND user_id = ?", tripID, userID).Delete(&models.TripMember{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
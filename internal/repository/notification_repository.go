@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"time"
+	"travel-platform/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type NotificationRepository interface {
+	CreateNotification(notification *models.Notification) error
+	GetNotifications(userID uint, unreadOnly bool, limit int) ([]models.Notification, error)
+	CountUnread(userID uint) (int64, error)
+	MarkRead(userID, id uint) error
+	MarkAllRead(userID uint) (int64, error)
+	GetSettings(userID uint) (*models.NotificationSettings, error)
+	SaveSettings(settings *models.NotificationSettings) error
+	GetBudgetAlerts(tripID uint) ([]models.BudgetAlert, error)
+	CreateBudgetAlert(alert *models.BudgetAlert) error
+	DeleteBudgetAlert(id uint) error
+}
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+func (r *notificationRepository) CreateNotification(notification *models.Notification) error {
+	return r.db.Create(notification).Error
+}
+
+// GetNotifications - En yeni bildirimler önce
+func (r *notificationRepository) GetNotifications(userID uint, unreadOnly bool, limit int) ([]models.Notification, error) {
+	var notifications []models.Notification
+	query := r.db.Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+	result := query.Order("created_at DESC, id DESC").Limit(limit).Find(&notifications).Error
+	if result != nil {
+		return nil, result
+	}
+	return notifications, nil
+}
+
+func (r *notificationRepository) CountUnread(userID uint) (int64, error) {
+	var count int64
+	result := r.db.Model(&models.Notification{}).Where("user_id = ? AND read_at IS NULL", userID).Count(&count).Error
+	return count, result
+}
+
+// MarkRead - Bildirim kullanıcıya ait değilse gorm.ErrRecordNotFound döner
+func (r *notificationRepository) MarkRead(userID, id uint) error {
+	result := r.db.Model(&models.Notification{}).
+		Where("id = ? AND user_id = ? AND read_at IS NULL", id, userID).
+		Update("read_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		var count int64
+		r.db.Model(&models.Notification{}).Where("id = ? AND user_id = ?", id, userID).Count(&count)
+		if count == 0 {
+			return gorm.ErrRecordNotFound
+		}
+	}
+	return nil
+}
+
+func (r *notificationRepository) MarkAllRead(userID uint) (int64, error) {
+	result := r.db.Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", time.Now())
+	return result.RowsAffected, result.Error
+}
+
+func (r *notificationRepository) GetSettings(userID uint) (*models.NotificationSettings, error) {
+	var settings models.NotificationSettings
+	result := r.db.Where("user_id = ?", userID).First(&settings).Error
+	if result != nil {
+		return nil, result
+	}
+	return &settings, nil
+}
+
+func (r *notificationRepository) SaveSettings(settings *models.NotificationSettings) error {
+	return r.db.Save(settings).Error
+}
+
+func (r *notificationRepository) GetBudgetAlerts(tripID uint) ([]models.BudgetAlert, error) {
+	var alerts []models.BudgetAlert
+	result := r.db.Where("trip_id = ?", tripID).Find(&alerts).Error
+	if result != nil {
+		return nil, result
+	}
+	return alerts, nil
+}
+
+func (r *notificationRepository) CreateBudgetAlert(alert *models.BudgetAlert) error {
+	return r.db.Create(alert).Error
+}
+
+func (r *notificationRepository) DeleteBudgetAlert(id uint) error {
+	return r.db.Delete(&models.BudgetAlert{}, id).Error
+}
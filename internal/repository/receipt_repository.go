@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"travel-platform/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type ReceiptRepository interface {
+	CreateReceipt(receipt *models.Receipt) error
+	GetReceiptByID(id uint) (*models.Receipt, error)
+	GetReceiptsByExpenseID(expenseID uint) ([]models.Receipt, error)
+	GetStorageKeys() ([]string, error)
+	DeleteReceipt(id uint) error
+}
+
+type receiptRepository struct {
+	db *gorm.DB
+}
+
+func NewReceiptRepository(db *gorm.DB) ReceiptRepository {
+	return &receiptRepository{db: db}
+}
+
+func (r *receiptRepository) CreateReceipt(receipt *models.Receipt) error {
+	return r.db.Create(receipt).Error
+}
+
+func (r *receiptRepository) GetReceiptByID(id uint) (*models.Receipt, error) {
+	var receipt models.Receipt
+	result := r.db.First(&receipt, id).Error
+	if result != nil {
+		return nil, result
+	}
+	return &receipt, nil
+}
+
+func (r *receiptRepository) GetReceiptsByExpenseID(expenseID uint) ([]models.Receipt, error) {
+	var receipts []models.Receipt
+	result := r.db.Where("expense_id = ?", expenseID).Order("id").Find(&receipts).Error
+	if result != nil {
+		return nil, result
+	}
+	return receipts, nil
+}
+
+// GetStorageKeys - Kayıtlı tüm fişlerin dosya anahtarları (sahipsiz dosyaları bulmak için)
+func (r *receiptRepository) GetStorageKeys() ([]string, error) {
+	var keys []string
+	if err := r.db.Model(&models.Receipt{}).Pluck("storage_key", &keys).Error; err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (r *receiptRepository) DeleteReceipt(id uint) error {
+	return r.db.Delete(&models.Receipt{}, id).Error
+}
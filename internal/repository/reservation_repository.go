@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"travel-platform/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type ReservationRepository interface {
+	CreateReservation(reservation *models.Reservation) error
+	GetReservationsByTripID(tripID uint) ([]models.Reservation, error)
+	GetReservationByID(id uint) (*models.Reservation, error)
+	UpdateReservation(reservation *models.Reservation) error
+	DeleteReservation(id uint) error
+}
+
+type reservationRepository struct {
+	db *gorm.DB
+}
+
+func NewReservationRepository(db *gorm.DB) ReservationRepository {
+	return &reservationRepository{db: db}
+}
+
+func (r *reservationRepository) CreateReservation(reservation *models.Reservation) error {
+	return r.db.Omit("Expense").Create(reservation).Error
+}
+
+// GetReservationsByTripID - Gezinin rezervasyonları, başlangıç zamanına göre sıralı
+func (r *reservationRepository) GetReservationsByTripID(tripID uint) ([]models.Reservation, error) {
+	var reservations []models.Reservation
+	result := r.db.Where("trip_id = ?", tripID).
+		Order("start_at, id").
+		Find(&reservations).Error
+	if result != nil {
+		return nil, result
+	}
+	return reservations, nil
+}
+
+func (r *reservationRepository) GetReservationByID(id uint) (*models.Reservation, error) {
+	var reservation models.Reservation
+	result := r.db.First(&reservation, id).Error
+	if result != nil {
+		return nil, result
+	}
+	return &reservation, nil
+}
+
+func (r *reservationRepository) UpdateReservation(reservation *models.Reservation) error {
+	return r.db.Omit("Expense").Save(reservation).Error
+}
+
+func (r *reservationRepository) DeleteReservation(id uint) error {
+	return r.db.Delete(&models.Reservation{}, id).Error
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"travel-platform/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type SettlementRepository interface {
+	CreateSettlement(settlement *models.Settlement) error
+	GetSettlementsByTripID(tripID uint) ([]models.Settlement, error)
+	GetSettlementByID(id uint) (*models.Settlement, error)
+	DeleteSettlement(id uint) error
+}
+
+type settlementRepository struct {
+	db *gorm.DB
+}
+
+func NewSettlementRepository(db *gorm.DB) SettlementRepository {
+	return &settlementRepository{db: db}
+}
+
+func (r *settlementRepository) CreateSettlement(settlement *models.Settlement) error {
+	return r.db.Omit("FromUser", "ToUser").Create(settlement).Error
+}
+
+// GetSettlementsByTripID - Gezinin ödeme kayıtları, ödeme tarihine göre sıralı
+func (r *settlementRepository) GetSettlementsByTripID(tripID uint) ([]models.Settlement, error) {
+	var settlements []models.Settlement
+	result := r.db.Preload("FromUser").
+		Preload("ToUser").
+		Where("trip_id = ?", tripID).
+		Order("paid_at, id").
+		Find(&settlements).Error
+	if result != nil {
+		return nil, result
+	}
+	return settlements, nil
+}
+
+func (r *settlementRepository) GetSettlementByID(id uint) (*models.Settlement, error) {
+	var settlement models.Settlement
+	result := r.db.First(&settlement, id).Error
+	if result != nil {
+		return nil, result
+	}
+	return &settlement, nil
+}
+
+func (r *settlementRepository) DeleteSettlement(id uint) error {
+	return r.db.Delete(&models.Settlement{}, id).Error
+}
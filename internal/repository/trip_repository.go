@@ -1,9 +1,12 @@
 package repository
 
 import (
+	"fmt"
+	"time"
 	"travel-platform/internal/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type TripRepository interface {
@@ -14,6 +17,23 @@ type TripRepository interface {
 	GetByDestination(destination string) ([]models.Trip, error)
 	UpdateTrip(trip *models.Trip) error
 	DeleteTrip(id uint) error
+	UpdateActivity(activity *models.Activity) error
+	GetDeletedTripsByUserID(userID uint) ([]models.Trip, error)
+	GetDeletedTripByID(id uint) (*models.Trip, error)
+	RestoreTrip(id uint) error
+	PurgeTrip(id uint) error
+	PurgeDeletedBefore(cutoff time.Time) (int, error)
+	ReplaceTripState(trip *models.Trip) error
+	GetTripsByUserIDAndStatus(userID uint, statuses []string) ([]models.Trip, error)
+	GetTripsByStatus(statuses []string) ([]models.Trip, error)
+	UpdateTripStatus(id uint, status string) error
+	CreateActivity(activity *models.Activity) error
+	GetActivityByID(id uint) (*models.Activity, error)
+	DeleteActivity(id uint) error
+	CreateExpense(expense *models.Expense) error
+	GetExpenseByID(id uint) (*models.Expense, error)
+	UpdateExpense(expense *models.Expense) error
+	DeleteExpense(id uint) error
 }
 
 type tripRepository struct {
@@ -25,13 +45,25 @@ func NewTripRepository(db *gorm.DB) TripRepository {
 }
 
 func (r *tripRepository) CreateTrip(trip *models.Trip) error {
-	return r.db.Create(trip).Error
+	// is_public varsayılanı true; GORM false'u sıfır değer sayıp varsayılanı yazdığı için ayrıca güncellenir
+	isPublic := trip.IsPublic
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(trip).Error; err != nil {
+			return err
+		}
+		if !isPublic {
+			return tx.Model(trip).Update("is_public", false).Error
+		}
+		return nil
+	})
 }
 
 func (r *tripRepository) GetTripByID(id uint) (*models.Trip, error) {
 	var trip models.Trip
 	result := r.db.Preload("Activities").
 		Preload("Expenses").
+		Preload("Expenses.Splits").
+		Preload("Expenses.Receipts").
 		Preload("User").
 		First(&trip, id).Error
 	if result != nil {
@@ -52,6 +84,32 @@ func (r *tripRepository) GetTripByUserID(userID uint) ([]models.Trip, error) {
 	return trips, nil
 }
 
+func (r *tripRepository) GetTripsByUserIDAndStatus(userID uint, statuses []string) ([]models.Trip, error) {
+	var trips []models.Trip
+	result := r.db.Preload("Activities").
+		Preload("Expenses").
+		Where("user_id = ? AND status IN ?", userID, statuses).
+		Find(&trips).Error
+	if result != nil {
+		return nil, result
+	}
+	return trips, nil
+}
+
+// GetTripsByStatus - Tüm kullanıcıların verilen durumdaki gezileri (alt kayıtlar yüklenmez)
+func (r *tripRepository) GetTripsByStatus(statuses []string) ([]models.Trip, error) {
+	var trips []models.Trip
+	result := r.db.Where("status IN ?", statuses).Find(&trips).Error
+	if result != nil {
+		return nil, result
+	}
+	return trips, nil
+}
+
+func (r *tripRepository) UpdateTripStatus(id uint, status string) error {
+	return r.db.Model(&models.Trip{}).Where("id = ?", id).Update("status", status).Error
+}
+
 func (r *tripRepository) GetPublicTrips() ([]models.Trip, error) {
 	var trips []models.Trip
 	result := r.db.Preload("User").
@@ -82,6 +140,253 @@ func (r *tripRepository) UpdateTrip(trip *models.Trip) error {
 	return r.db.Save(trip).Error
 }
 
+// DeleteTrip - Geziyi aktivite ve harcamalarıyla birlikte çöp kutusuna taşır (soft delete)
+// Hepsine aynı deleted_at yazılır; RestoreTrip bununla birlikte silinenleri ayırt eder
 func (r *tripRepository) DeleteTrip(id uint) error {
-	return r.db.Delete(&models.Trip{}, id).Error
+	now := time.Now()
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Trip{}).Where("id = ?", id).Update("deleted_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if err := tx.Model(&models.Activity{}).Where("trip_id = ?", id).Update("deleted_at", now).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Expense{}).Where("trip_id = ?", id).Update("deleted_at", now).Error
+	})
+}
+
+func (r *tripRepository) UpdateActivity(activity *models.Activity) error {
+	return r.db.Omit("Trip").Save(activity).Error
+}
+
+func (r *tripRepository) CreateActivity(activity *models.Activity) error {
+	return r.db.Omit("Trip").Create(activity).Error
+}
+
+func (r *tripRepository) GetActivityByID(id uint) (*models.Activity, error) {
+	var activity models.Activity
+	result := r.db.First(&activity, id).Error
+	if result != nil {
+		return nil, result
+	}
+	return &activity, nil
+}
+
+func (r *tripRepository) DeleteActivity(id uint) error {
+	return r.db.Delete(&models.Activity{}, id).Error
+}
+
+func (r *tripRepository) CreateExpense(expense *models.Expense) error {
+	return r.db.Omit("Trip").Create(expense).Error
+}
+
+func (r *tripRepository) GetExpenseByID(id uint) (*models.Expense, error) {
+	var expense models.Expense
+	result := r.db.Preload("Splits").First(&expense, id).Error
+	if result != nil {
+		return nil, result
+	}
+	return &expense, nil
+}
+
+// UpdateExpense - Harcamayı kaydeder; Splits nil değilse paylar verilen listeyle değiştirilir
+func (r *tripRepository) UpdateExpense(expense *models.Expense) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Trip", "Splits", "Receipts").Save(expense).Error; err != nil {
+			return err
+		}
+		if expense.Splits == nil {
+			return nil
+		}
+		return replaceSplits(tx, expense)
+	})
+}
+
+func replaceSplits(tx *gorm.DB, expense *models.Expense) error {
+	if err := tx.Where("expense_id = ?", expense.ID).Delete(&models.ExpenseSplit{}).Error; err != nil {
+		return err
+	}
+	for i := range expense.Splits {
+		expense.Splits[i].ExpenseID = expense.ID
+	}
+	if len(expense.Splits) == 0 {
+		return nil
+	}
+	return tx.Create(&expense.Splits).Error
+}
+
+func (r *tripRepository) DeleteExpense(id uint) error {
+	return r.db.Delete(&models.Expense{}, id).Error
+}
+
+// ReplaceTripState - Geziyi, aktivite ve harcamalarıyla birlikte verilen hale getirir (revert)
+// Listede olan silinmiş kayıtlar geri gelir, listede olmayanlar silinir
+func (r *tripRepository) ReplaceTripState(trip *models.Trip) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(trip).Error; err != nil {
+			return err
+		}
+
+		activityIDs := []uint{0}
+		for i := range trip.Activities {
+			activity := &trip.Activities[i]
+			activity.DeletedAt = gorm.DeletedAt{}
+			if err := tx.Unscoped().Omit("Trip").Save(activity).Error; err != nil {
+				return err
+			}
+			activityIDs = append(activityIDs, activity.ID)
+		}
+		if err := tx.Where("trip_id = ? AND id NOT IN ?", trip.ID, activityIDs).
+			Delete(&models.Activity{}).Error; err != nil {
+			return err
+		}
+
+		expenseIDs := []uint{0}
+		for i := range trip.Expenses {
+			expense := &trip.Expenses[i]
+			expense.DeletedAt = gorm.DeletedAt{}
+			if err := tx.Unscoped().Omit("Trip", "Splits", "Receipts").Save(expense).Error; err != nil {
+				return err
+			}
+			if expense.Splits == nil {
+				expense.Splits = []models.ExpenseSplit{}
+			}
+			if err := replaceSplits(tx, expense); err != nil {
+				return err
+			}
+			expenseIDs = append(expenseIDs, expense.ID)
+		}
+		return tx.Where("trip_id = ? AND id NOT IN ?", trip.ID, expenseIDs).
+			Delete(&models.Expense{}).Error
+	})
+}
+
+func (r *tripRepository) GetDeletedTripsByUserID(userID uint) ([]models.Trip, error) {
+	var trips []models.Trip
+	result := r.db.Unscoped().
+		Where("user_id = ? AND deleted_at IS NOT NULL", userID).
+		Order("deleted_at DESC").
+		Find(&trips).Error
+	if result != nil {
+		return nil, result
+	}
+	return trips, nil
+}
+
+func (r *tripRepository) GetDeletedTripByID(id uint) (*models.Trip, error) {
+	var trip models.Trip
+	result := r.db.Unscoped().
+		Where("deleted_at IS NOT NULL").
+		First(&trip, id).Error
+	if result != nil {
+		return nil, result
+	}
+	return &trip, nil
+}
+
+// RestoreTrip - Geziyi ve onunla birlikte silinen aktivite/harcamaları geri getirir
+// Gezi silinmeden önce tek tek silinmiş kayıtlar silinmiş kalır
+func (r *tripRepository) RestoreTrip(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var trip models.Trip
+		if err := tx.Unscoped().Where("deleted_at IS NOT NULL").First(&trip, id).Error; err != nil {
+			return err
+		}
+		// DeleteTrip'in yazdığı değerin birebir aynısı; veritabanındaki değerle karşılaştırılır
+		deletedAt := tx.Unscoped().Model(&models.Trip{}).Select("deleted_at").Where("id = ?", id)
+
+		if err := tx.Unscoped().Model(&models.Activity{}).
+			Where("trip_id = ? AND deleted_at = (?)", id, deletedAt).
+			Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&models.Expense{}).
+			Where("trip_id = ? AND deleted_at = (?)", id, deletedAt).
+			Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Model(&models.Trip{}).Where("id = ?", id).Update("deleted_at", nil).Error
+	})
+}
+
+// PurgeTrip - Çöp kutusundaki geziyi tüm alt kayıtlarıyla kalıcı olarak siler
+func (r *tripRepository) PurgeTrip(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var trip models.Trip
+		if err := tx.Unscoped().Where("deleted_at IS NOT NULL").First(&trip, id).Error; err != nil {
+			return fmt.Errorf("trip %d is not in the trash: %w", id, err)
+		}
+		return purgeTrip(tx, id)
+	})
+}
+
+// PurgeDeletedBefore - cutoff'tan önce çöp kutusuna atılmış gezileri kalıcı siler
+func (r *tripRepository) PurgeDeletedBefore(cutoff time.Time) (int, error) {
+	var ids []uint
+	if err := r.db.Unscoped().Model(&models.Trip{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Pluck("id", &ids).Error; err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, id := range ids {
+		if err := r.db.Transaction(func(tx *gorm.DB) error { return purgeTrip(tx, id) }); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+func purgeTrip(tx *gorm.DB, id uint) error {
+	if err := tx.Unscoped().Where("trip_id = ?", id).Delete(&models.Activity{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("expense_id IN (?)", tx.Unscoped().Model(&models.Expense{}).Select("id").Where("trip_id = ?", id)).
+		Delete(&models.ExpenseSplit{}).Error; err != nil {
+		return err
+	}
+	// Fiş dosyaları BlobStore'da kalır, kayıtsız dosyaları receipt-cleanup işi siler
+	if err := tx.Where("expense_id IN (?)", tx.Unscoped().Model(&models.Expense{}).Select("id").Where("trip_id = ?", id)).
+		Delete(&models.Receipt{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Unscoped().Where("trip_id = ?", id).Delete(&models.Expense{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("trip_id = ?", id).Delete(&models.Settlement{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("trip_id = ?", id).Delete(&models.CategoryBudget{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("trip_id = ?", id).Delete(&models.BudgetAlert{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("trip_id = ?", id).Delete(&models.TripAuditEntry{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("checklist_id IN (?)", tx.Model(&models.Checklist{}).Select("id").Where("trip_id = ?", id)).
+		Delete(&models.ChecklistItem{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("trip_id = ?", id).Delete(&models.Checklist{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("trip_id = ?", id).Delete(&models.TripMember{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("trip_id = ?", id).Delete(&models.Reservation{}).Error; err != nil {
+		return err
+	}
+	// Belgeler kullanıcıya aittir, sadece gezi bağlantısı kaldırılır
+	if err := tx.Model(&models.TravelDocument{}).Where("trip_id = ?", id).Update("trip_id", nil).Error; err != nil {
+		return err
+	}
+	return tx.Unscoped().Delete(&models.Trip{}, id).Error
 }
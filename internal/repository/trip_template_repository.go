@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"travel-platform/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type TripTemplateRepository interface {
+	CreateTemplate(template *models.TripTemplate) error
+	GetTemplateByID(id uint) (*models.TripTemplate, error)
+	GetVisibleTemplates(userID uint) ([]models.TripTemplate, error)
+	DeleteTemplate(id uint) error
+}
+
+type tripTemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewTripTemplateRepository(db *gorm.DB) TripTemplateRepository {
+	return &tripTemplateRepository{db: db}
+}
+
+func (r *tripTemplateRepository) CreateTemplate(template *models.TripTemplate) error {
+	return r.db.Omit("User").Create(template).Error
+}
+
+func (r *tripTemplateRepository) GetTemplateByID(id uint) (*models.TripTemplate, error) {
+	var template models.TripTemplate
+	result := r.db.Preload("Activities", func(db *gorm.DB) *gorm.DB {
+		return db.Order("day_offset, id")
+	}).
+		Preload("User").
+		First(&template, id).Error
+	if result != nil {
+		return nil, result
+	}
+	return &template, nil
+}
+
+// GetVisibleTemplates - Herkese açık şablonlar ve kullanıcının kendi şablonları (userID 0 ise sadece açık olanlar)
+func (r *tripTemplateRepository) GetVisibleTemplates(userID uint) ([]models.TripTemplate, error) {
+	var templates []models.TripTemplate
+	result := r.db.Preload("Activities").
+		Preload("User").
+		Where("is_public = ? OR user_id = ?", true, userID).
+		Order("created_at DESC").
+		Find(&templates).Error
+	if result != nil {
+		return nil, result
+	}
+	return templates, nil
+}
+
+func (r *tripTemplateRepository) DeleteTemplate(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("template_id = ?", id).Delete(&models.TemplateActivity{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.TripTemplate{}, id).Error
+	})
+}
@@ -0,0 +1,69 @@
+package scheduler
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// job - Belirli aralıklarla çalışan tek bir arka plan işi
+type job struct {
+	name     string
+	interval time.Duration
+	run      func() error
+}
+
+// Scheduler - Arka plan işlerini (çöp kutusu temizliği vb.) goroutine'lerde çalıştırır
+type Scheduler struct {
+	jobs []job
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func New() *Scheduler {
+	return &Scheduler{stop: make(chan struct{})}
+}
+
+// Every - İşi kaydeder; Start çağrıldığında hemen bir kez, sonra her interval'de çalışır
+func (s *Scheduler) Every(name string, interval time.Duration, run func() error) {
+	s.jobs = append(s.jobs, job{name: name, interval: interval, run: run})
+}
+
+func (s *Scheduler) Start() {
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(j)
+	}
+}
+
+// Stop - Tüm işlerin bitmesini bekler (çalışan iş yarıda kesilmez)
+func (s *Scheduler) Stop() {
+	close(s.stop)
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(j job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	s.runOnce(j)
+	for {
+		select {
+		case <-ticker.C:
+			s.runOnce(j)
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+func (s *Scheduler) runOnce(j job) {
+	start := time.Now()
+	if err := j.run(); err != nil {
+		log.Printf("⏰ Job %s failed: %v", j.name, err)
+		return
+	}
+	log.Printf("⏰ Job %s completed in %v", j.name, time.Since(start))
+}
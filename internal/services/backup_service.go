@@ -0,0 +1,194 @@
+package services
+
+import (
+	"fmt"
+	"travel-platform/internal/backup"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+)
+
+// IDMap - Geri yüklemede eski (arşivdeki) ID -> yeni ID eşlemeleri
+type IDMap struct {
+	Trips      map[uint]uint `json:"trips"`
+	Activities map[uint]uint `json:"activities"`
+	Expenses   map[uint]uint `json:"expenses"`
+}
+
+type RestoreResult struct {
+	Trips    int      `json:"trips"`
+	Messages int      `json:"messages"`
+	Receipts int      `json:"receipts"`
+	IDMap    IDMap    `json:"id_map"`
+	Errors   []string `json:"errors"`
+}
+
+type BackupService interface {
+	Export(trips []models.Trip) (*backup.Archive, error)
+	Restore(userID uint, archive *backup.Archive) (*RestoreResult, error)
+}
+
+type backupService struct {
+	tripService    TripService
+	receiptService ReceiptService
+	chatRepo       repository.ChatRepository
+	userRepo       repository.UserRepository
+}
+
+func NewBackupService(tripService TripService, receiptService ReceiptService, chatRepo repository.ChatRepository, userRepo repository.UserRepository) BackupService {
+	return &backupService{
+		tripService:    tripService,
+		receiptService: receiptService,
+		chatRepo:       chatRepo,
+		userRepo:       userRepo,
+	}
+}
+
+// attachmentExpense - Harcama fişlerinin arşivdeki sahip türü
+const attachmentExpense = "expense"
+
+// Export - Gezileri (aktiviteler, harcamalar, fişler ve gezi odası mesajlarıyla) arşive çevirir
+func (s *backupService) Export(trips []models.Trip) (*backup.Archive, error) {
+	archive := backup.NewArchive()
+
+	for _, trip := range trips {
+		for _, expense := range trip.Expenses {
+			if err := s.exportReceipts(archive, expense.ID); err != nil {
+				return nil, fmt.Errorf("expense %d receipts: %w", expense.ID, err)
+			}
+		}
+
+		var messages []models.ChatMessage
+		if room, err := s.chatRepo.GetRoomByTripID(trip.ID); err == nil {
+			messages, err = s.chatRepo.GetMessagesByRoomID(room.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		archive.Trips = append(archive.Trips, backup.NewTripRecord(trip, messages))
+	}
+
+	return archive, nil
+}
+
+// Restore - Arşivdeki gezileri userID'ye ait yeni kayıtlar olarak oluşturur
+// Hatalı gezi atlanır; diğerleri yine de geri yüklenir
+func (s *backupService) Restore(userID uint, archive *backup.Archive) (*RestoreResult, error) {
+	user, err := s.userRepo.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RestoreResult{
+		IDMap: IDMap{
+			Trips:      make(map[uint]uint),
+			Activities: make(map[uint]uint),
+			Expenses:   make(map[uint]uint),
+		},
+		Errors: []string{},
+	}
+
+	for _, record := range archive.Trips {
+		trip := record.ToModel(userID)
+		if err := s.tripService.CreateTrip(&trip); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("trip %d (%s): %v", record.ID, record.Title, err))
+			continue
+		}
+
+		result.Trips++
+		result.IDMap.Trips[record.ID] = trip.ID
+		for i, a := range record.Activities {
+			result.IDMap.Activities[a.ID] = trip.Activities[i].ID
+		}
+		for i, e := range record.Expenses {
+			result.IDMap.Expenses[e.ID] = trip.Expenses[i].ID
+		}
+
+		result.Receipts += s.restoreReceipts(userID, archive, record, result)
+
+		restored, err := s.restoreChat(user, trip.ID, record)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("trip %d chat: %v", record.ID, err))
+		}
+		result.Messages += restored
+	}
+
+	return result, nil
+}
+
+func (s *backupService) exportReceipts(archive *backup.Archive, expenseID uint) error {
+	receipts, err := s.receiptService.GetReceipts(expenseID)
+	if err != nil {
+		return err
+	}
+	for i := range receipts {
+		data, err := s.receiptService.OpenReceipt(&receipts[i])
+		if err != nil {
+			return err
+		}
+		archive.AddAttachment(attachmentExpense, expenseID, receipts[i].FileName, receipts[i].ContentType, data)
+	}
+	return nil
+}
+
+// restoreReceipts - Gezinin harcamalarına ait ekleri yeni harcama ID'leriyle fiş olarak yükler
+// Yüklenemeyen fiş hatalara yazılır, geri yükleme devam eder
+func (s *backupService) restoreReceipts(userID uint, archive *backup.Archive, record backup.TripRecord, result *RestoreResult) int {
+	expenses := make(map[uint]bool)
+	for _, e := range record.Expenses {
+		expenses[e.ID] = true
+	}
+
+	count := 0
+	for _, info := range archive.Manifest.Attachments {
+		if info.OwnerType != attachmentExpense || !expenses[info.OwnerID] {
+			continue
+		}
+		receipt := &models.Receipt{
+			ExpenseID:    result.IDMap.Expenses[info.OwnerID],
+			FileName:     info.FileName,
+			UploadedByID: userID,
+		}
+		if err := s.receiptService.UploadReceipt(receipt, archive.Files[info.Path]); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("receipt %s: %v", info.Path, err))
+			continue
+		}
+		count++
+	}
+	return count
+}
+
+// restoreChat - Mesajları yeni gezinin odasına yazar
+// Yazarlar hedef sistemde olmayabilir; mesajlar geri yükleyen kullanıcı adına, orijinal isimle eklenir
+func (s *backupService) restoreChat(user *models.User, tripID uint, record backup.TripRecord) (int, error) {
+	if len(record.Chat) == 0 {
+		return 0, nil
+	}
+
+	room, err := s.chatRepo.GetRoomByName(models.TripRoomName(tripID))
+	if err != nil {
+		room = &models.ChatRoom{Name: models.TripRoomName(tripID), TripID: &tripID}
+		if err := s.chatRepo.CreateRoom(room); err != nil {
+			return 0, err
+		}
+	}
+
+	ownName := user.FirstName + " " + user.LastName
+	count := 0
+	for _, m := range record.Chat {
+		text := m.Message
+		if m.AuthorName != ownName {
+			text = fmt.Sprintf("[%s] %s", m.AuthorName, m.Message)
+		}
+		message := &models.ChatMessage{
+			RoomID:    room.ID,
+			UserID:    user.ID,
+			Message:   text,
+			CreatedAt: m.CreatedAt,
+		}
+		if err := s.chatRepo.CreateMessage(message); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+)
+
+// Kategori bütçesi durumları
+const (
+	BudgetOnTrack  = "on_track"
+	BudgetAtRisk   = "at_risk"  // Bu hızla gezi sonunda bütçe aşılacak
+	BudgetExceeded = "exceeded" // Bütçe şimdiden aşıldı
+)
+
+// Spend - Tahmin için tek harcama; Amount bütçenin para birimindedir
+type Spend struct {
+	Category string
+	Date     time.Time
+	Amount   float64
+}
+
+// DailySpend - Bir günün toplam harcaması
+type DailySpend struct {
+	Date   string  `json:"date"` // YYYY-MM-DD
+	Amount float64 `json:"amount"`
+}
+
+// CategoryForecast - Bir kategorinin harcaması ve gezi sonu tahmini
+// Budget 0 ise kategoriye bütçe ayrılmamıştır
+type CategoryForecast struct {
+	Category         string  `json:"category"`
+	Budget           float64 `json:"budget"`
+	Spent            float64 `json:"spent"`
+	Remaining        float64 `json:"remaining"`
+	DailyBurn        float64 `json:"daily_burn"`
+	Projected        float64 `json:"projected"`
+	DaysOfBudgetLeft float64 `json:"days_of_budget_left"` // -1: gezide henüz harcama yok
+	Status           string  `json:"status,omitempty"`
+}
+
+// BudgetForecast - Günlük harcama hızından gezi sonu tahmini
+// DailyBurn sadece gezinin geçen günlerindeki harcamalardan hesaplanır;
+// geziden önce veya ileri tarihli ödenenler harcanmış sayılır ama hızı etkilemez
+type BudgetForecast struct {
+	Currency         string             `json:"currency"`
+	Budget           float64            `json:"budget"`
+	Spent            float64            `json:"spent"`
+	Remaining        float64            `json:"remaining"`
+	TripDays         int                `json:"trip_days"`
+	DaysElapsed      int                `json:"days_elapsed"`
+	DaysRemaining    int                `json:"days_remaining"`
+	DailyBudget      float64            `json:"daily_budget"`
+	DailyBurn        float64            `json:"daily_burn"`
+	Projected        float64            `json:"projected"`
+	DaysOfBudgetLeft float64            `json:"days_of_budget_left"` // -1: gezide henüz harcama yok
+	Daily            []DailySpend       `json:"daily"`
+	Categories       []CategoryForecast `json:"categories"`
+}
+
+type BudgetService interface {
+	GetCategoryBudgets(tripID uint) ([]models.CategoryBudget, error)
+	SetCategoryBudgets(trip *models.Trip, budgets []models.CategoryBudget) ([]models.CategoryBudget, error)
+	Forecast(trip *models.Trip, today time.Time) (*BudgetForecast, error)
+}
+
+type budgetService struct {
+	repo repository.CategoryBudgetRepository
+}
+
+func NewBudgetService(repo repository.CategoryBudgetRepository) BudgetService {
+	return &budgetService{repo: repo}
+}
+
+func (s *budgetService) GetCategoryBudgets(tripID uint) ([]models.CategoryBudget, error) {
+	return s.repo.GetCategoryBudgets(tripID)
+}
+
+// SetCategoryBudgets - Gezinin kategori bütçelerini değiştirir; boş liste hepsini kaldırır
+func (s *budgetService) SetCategoryBudgets(trip *models.Trip, budgets []models.CategoryBudget) ([]models.CategoryBudget, error) {
+	seen := make(map[string]bool)
+	var total float64
+	for i := range budgets {
+		budgets[i].Category = strings.ToLower(strings.TrimSpace(budgets[i].Category))
+		if budgets[i].Category == "" {
+			return nil, fmt.Errorf("category is required")
+		}
+		if budgets[i].Amount <= 0 {
+			return nil, fmt.Errorf("budget for %s must be greater than 0", budgets[i].Category)
+		}
+		if seen[budgets[i].Category] {
+			return nil, fmt.Errorf("%s has more than one budget", budgets[i].Category)
+		}
+		seen[budgets[i].Category] = true
+		total += budgets[i].Amount
+	}
+	if trip.Budget > 0 && currency.Round(total) > trip.Budget {
+		return nil, fmt.Errorf("category budgets add up to %.2f, more than the trip budget of %.2f", total, trip.Budget)
+	}
+
+	if err := s.repo.ReplaceCategoryBudgets(trip.ID, budgets); err != nil {
+		return nil, err
+	}
+	return s.repo.GetCategoryBudgets(trip.ID)
+}
+
+// Forecast - Gezinin harcamalarından, gezinin para biriminde tahmin
+func (s *budgetService) Forecast(trip *models.Trip, today time.Time) (*BudgetForecast, error) {
+	budgets, err := s.repo.GetCategoryBudgets(trip.ID)
+	if err != nil {
+		return nil, err
+	}
+	categoryBudgets := make(map[string]float64, len(budgets))
+	for _, budget := range budgets {
+		categoryBudgets[budget.Category] = budget.Amount
+	}
+
+	spends := make([]Spend, 0, len(trip.Expenses))
+	for _, expense := range trip.Expenses {
+		spends = append(spends, Spend{Category: expense.Category, Date: expense.ExpenseDate, Amount: HomeAmount(expense)})
+	}
+
+	forecast := ForecastBudget(trip.StartDate, trip.EndDate, today, trip.Budget, categoryBudgets, spends)
+	forecast.Currency = currency.Normalize(trip.Currency)
+	return &forecast, nil
+}
+
+// ForecastBudget - Toplam ve kategori bazında harcama hızı, gezi sonu tahmini ve bütçenin kaç gün yeteceği
+// Bütçesi olan ama harcaması olmayan kategoriler de listelenir
+func ForecastBudget(start, end, today time.Time, budget float64, categoryBudgets map[string]float64, spends []Spend) BudgetForecast {
+	tripDays := daysBetween(start, end) + 1
+	if tripDays < 1 {
+		tripDays = 1
+	}
+	elapsed := daysBetween(start, today) + 1
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	if elapsed > tripDays {
+		elapsed = tripDays
+	}
+	remainingDays := tripDays - elapsed
+
+	// Hız hesabına giren günler: gezinin ilk günü ile bugün (veya gezi sonu) arası
+	inPace := func(date time.Time) bool {
+		offset := daysBetween(start, date)
+		return offset >= 0 && offset < elapsed
+	}
+
+	var spent, paceSpent float64
+	categorySpent := make(map[string]float64)
+	categoryPace := make(map[string]float64)
+	daily := make(map[string]float64)
+	for _, spend := range spends {
+		spent += spend.Amount
+		categorySpent[spend.Category] += spend.Amount
+		if !spend.Date.IsZero() {
+			daily[spend.Date.Format("2006-01-02")] += spend.Amount
+		}
+		if inPace(spend.Date) {
+			paceSpent += spend.Amount
+			categoryPace[spend.Category] += spend.Amount
+		}
+	}
+
+	forecast := BudgetForecast{
+		Budget:        budget,
+		TripDays:      tripDays,
+		DaysElapsed:   elapsed,
+		DaysRemaining: remainingDays,
+		DailyBudget:   currency.Round(budget / float64(tripDays)),
+		Daily:         []DailySpend{},
+		Categories:    []CategoryForecast{},
+	}
+	forecast.Spent, forecast.Remaining, forecast.DailyBurn, forecast.Projected, forecast.DaysOfBudgetLeft =
+		project(budget, spent, paceSpent, elapsed, remainingDays)
+
+	for date, amount := range daily {
+		forecast.Daily = append(forecast.Daily, DailySpend{Date: date, Amount: currency.Round(amount)})
+	}
+	sort.Slice(forecast.Daily, func(i, j int) bool { return forecast.Daily[i].Date < forecast.Daily[j].Date })
+
+	categories := make(map[string]bool)
+	for category := range categorySpent {
+		categories[category] = true
+	}
+	for category := range categoryBudgets {
+		categories[category] = true
+	}
+	for category := range categories {
+		c := CategoryForecast{Category: category, Budget: categoryBudgets[category]}
+		c.Spent, c.Remaining, c.DailyBurn, c.Projected, c.DaysOfBudgetLeft =
+			project(c.Budget, categorySpent[category], categoryPace[category], elapsed, remainingDays)
+		if c.Budget > 0 {
+			c.Status = CategoryBudgetStatus(c.Budget, c.Spent, c.Projected)
+		} else {
+			c.Remaining, c.DaysOfBudgetLeft = 0, -1
+		}
+		forecast.Categories = append(forecast.Categories, c)
+	}
+	sort.Slice(forecast.Categories, func(i, j int) bool {
+		return forecast.Categories[i].Category < forecast.Categories[j].Category
+	})
+
+	return forecast
+}
+
+// CategoryBudgetStatus - Harcanan ve tahmin edilen tutarın bütçeye göre durumu
+func CategoryBudgetStatus(budget, spent, projected float64) string {
+	switch {
+	case spent > budget:
+		return BudgetExceeded
+	case projected > budget:
+		return BudgetAtRisk
+	}
+	return BudgetOnTrack
+}
+
+// project - Harcanan, kalan, günlük hız, gezi sonu tahmini ve bütçenin yeteceği gün sayısı
+func project(budget, spent, paceSpent float64, elapsed, remainingDays int) (float64, float64, float64, float64, float64) {
+	var burn float64
+	if elapsed > 0 {
+		burn = paceSpent / float64(elapsed)
+	}
+	remaining := budget - spent
+	projected := spent + burn*float64(remainingDays)
+
+	daysLeft := -1.0
+	if burn > 0 {
+		daysLeft = 0
+		if remaining > 0 {
+			daysLeft = remaining / burn
+		}
+		daysLeft = float64(int(daysLeft*10)) / 10
+	}
+	return currency.Round(spent), currency.Round(remaining), currency.Round(burn), currency.Round(projected), daysLeft
+}
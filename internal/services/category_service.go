@@ -0,0 +1,252 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+)
+
+// DefaultCategories - Herkesin kullanabildiği sistem kategorileri
+// Bütçe analizindeki ideal oranlar ve öneriler bu kayıtlardan okunur
+var DefaultCategories = []models.ExpenseCategory{
+	{Key: "accommodation", Name: "Accommodation", Color: "#8b5cf6", Icon: "hotel", IdealMin: 30, IdealMax: 40,
+		SuggestAbove: 45, Suggestion: "💡 Look for more affordable accommodation options."},
+	{Key: "food", Name: "Food", Color: "#f59e0b", Icon: "utensils", IdealMin: 25, IdealMax: 35,
+		SuggestAbove: 35, Suggestion: "💡 Food expenses are high. Try local cuisine."},
+	{Key: "transport", Name: "Transport", Color: "#3b82f6", Icon: "bus", IdealMin: 15, IdealMax: 25,
+		SuggestAbove: 30, Suggestion: "💡 Consider using public transportation."},
+	{Key: "activities", Name: "Activities", Color: "#ec4899", Icon: "ticket-alt", IdealMin: 15, IdealMax: 25},
+	{Key: "shopping", Name: "Shopping", Color: "#14b8a6", Icon: "shopping-bag"},
+	{Key: "other", Name: "Other", Color: "#6b7280", Icon: "receipt"},
+}
+
+// Özel kategorilerde renk ve ikon verilmezse kullanılır
+const (
+	defaultCategoryColor = "#6b7280"
+	defaultCategoryIcon  = "tag"
+)
+
+var (
+	categoryColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+	categoryIconPattern  = regexp.MustCompile(`^[a-z0-9-]+$`)
+	categoryKeyPattern   = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+type CategoryService interface {
+	GetCategories(userID uint) ([]models.ExpenseCategory, error)
+	CreateCategory(userID uint, category *models.ExpenseCategory) error
+	UpdateCategory(userID, id uint, changes *models.ExpenseCategory) (*models.ExpenseCategory, error)
+	DeleteCategory(userID, id uint) error
+	MergeCategories(userID uint, from, to string) (int64, error)
+	ResolveCategory(userID uint, value string) (string, error)
+}
+
+type categoryService struct {
+	repo repository.ExpenseCategoryRepository
+}
+
+func NewCategoryService(repo repository.ExpenseCategoryRepository) CategoryService {
+	return &categoryService{repo: repo}
+}
+
+// CategoryKey - Kategori adından harcamalarda saklanan anahtar: "Ski Passes" -> "ski-passes"
+func CategoryKey(name string) string {
+	return strings.Trim(categoryKeyPattern.ReplaceAllString(strings.ToLower(strings.TrimSpace(name)), "-"), "-")
+}
+
+// FindCategory - Katalogda anahtarı veya adı (büyük/küçük harf fark etmez) eşleşen kategori
+func FindCategory(categories []models.ExpenseCategory, value string) (models.ExpenseCategory, bool) {
+	value = strings.TrimSpace(value)
+	for _, category := range categories {
+		if strings.EqualFold(category.Key, value) || strings.EqualFold(category.Name, value) {
+			return category, true
+		}
+	}
+	return models.ExpenseCategory{}, false
+}
+
+// GetCategories - Sistem kategorileri ve ardından kullanıcının özel kategorileri
+func (s *categoryService) GetCategories(userID uint) ([]models.ExpenseCategory, error) {
+	categories := append([]models.ExpenseCategory{}, DefaultCategories...)
+	if userID == 0 {
+		return categories, nil
+	}
+	custom, err := s.repo.GetUserCategories(userID)
+	if err != nil {
+		return nil, err
+	}
+	return append(categories, custom...), nil
+}
+
+func (s *categoryService) CreateCategory(userID uint, category *models.ExpenseCategory) error {
+	category.ID = 0
+	category.UserID = &userID
+	if err := validateCategory(category); err != nil {
+		return err
+	}
+	if err := s.checkKeyAvailable(userID, category.Key, 0); err != nil {
+		return err
+	}
+	return s.repo.CreateCategory(category)
+}
+
+// UpdateCategory - Özel kategoriyi günceller; ad değişirse harcamalar ve bütçeler yeni anahtara taşınır
+func (s *categoryService) UpdateCategory(userID, id uint, changes *models.ExpenseCategory) (*models.ExpenseCategory, error) {
+	category, err := s.ownCategory(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	oldKey := category.Key
+	category.Name = changes.Name
+	category.Color = changes.Color
+	category.Icon = changes.Icon
+	category.IdealMin = changes.IdealMin
+	category.IdealMax = changes.IdealMax
+	category.SuggestAbove = changes.SuggestAbove
+	category.Suggestion = changes.Suggestion
+	if err := validateCategory(category); err != nil {
+		return nil, err
+	}
+
+	if category.Key != oldKey {
+		if err := s.checkKeyAvailable(userID, category.Key, category.ID); err != nil {
+			return nil, err
+		}
+		if _, err := s.repo.MoveExpenses(userID, oldKey, category.Key); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.repo.UpdateCategory(category); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// DeleteCategory - Kullanılmayan özel kategoriyi siler; kullanılanlar birleştirilmeli
+func (s *categoryService) DeleteCategory(userID, id uint) error {
+	category, err := s.ownCategory(userID, id)
+	if err != nil {
+		return err
+	}
+	count, err := s.repo.CountExpenses(userID, category.Key)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%s is used by %d expenses; merge it into another category instead", category.Name, count)
+	}
+	return s.repo.DeleteCategory(category.ID)
+}
+
+// MergeCategories - from kategorisindeki harcamaları to kategorisine taşır ve taşınan harcama sayısını döner
+// from katalogda olmayan eski bir kategori de olabilir; özel kategoriyse birleştirmeden sonra silinir
+func (s *categoryService) MergeCategories(userID uint, from, to string) (int64, error) {
+	categories, err := s.GetCategories(userID)
+	if err != nil {
+		return 0, err
+	}
+	target, found := FindCategory(categories, to)
+	if !found {
+		return 0, fmt.Errorf("unknown category %q", to)
+	}
+
+	source, found := FindCategory(categories, from)
+	fromKey := strings.TrimSpace(from)
+	if found {
+		if source.IsSystem() {
+			return 0, fmt.Errorf("system category %s cannot be merged into another category", source.Name)
+		}
+		fromKey = source.Key
+	}
+	if fromKey == "" {
+		return 0, fmt.Errorf("category to merge is required")
+	}
+	if fromKey == target.Key {
+		return 0, fmt.Errorf("cannot merge a category into itself")
+	}
+
+	moved, err := s.repo.MoveExpenses(userID, fromKey, target.Key)
+	if err != nil {
+		return 0, err
+	}
+	if found {
+		if err := s.repo.DeleteCategory(source.ID); err != nil {
+			return moved, err
+		}
+	}
+	return moved, nil
+}
+
+// ResolveCategory - Harcama kaydedilmeden önce kategoriyi doğrular ve anahtarını döner
+func (s *categoryService) ResolveCategory(userID uint, value string) (string, error) {
+	categories, err := s.GetCategories(userID)
+	if err != nil {
+		return "", err
+	}
+	category, found := FindCategory(categories, value)
+	if !found {
+		return "", fmt.Errorf("unknown category %q", value)
+	}
+	return category.Key, nil
+}
+
+func (s *categoryService) ownCategory(userID, id uint) (*models.ExpenseCategory, error) {
+	category, err := s.repo.GetCategoryByID(id)
+	if err != nil || category.UserID == nil || *category.UserID != userID {
+		return nil, fmt.Errorf("category not found")
+	}
+	return category, nil
+}
+
+// checkKeyAvailable - Anahtar sistem kategorileri ve kullanıcının diğer kategorileriyle çakışmamalı
+func (s *categoryService) checkKeyAvailable(userID uint, key string, exceptID uint) error {
+	categories, err := s.GetCategories(userID)
+	if err != nil {
+		return err
+	}
+	for _, category := range categories {
+		if category.Key == key && (category.IsSystem() || category.ID != exceptID) {
+			return fmt.Errorf("a category named %s already exists; merge them instead", category.Name)
+		}
+	}
+	return nil
+}
+
+func validateCategory(category *models.ExpenseCategory) error {
+	category.Name = strings.TrimSpace(category.Name)
+	category.Key = CategoryKey(category.Name)
+	if category.Key == "" {
+		return fmt.Errorf("category name is required")
+	}
+
+	if category.Color == "" {
+		category.Color = defaultCategoryColor
+	}
+	if !categoryColorPattern.MatchString(category.Color) {
+		return fmt.Errorf("color must look like #1a2b3c")
+	}
+	category.Color = strings.ToLower(category.Color)
+
+	category.Icon = strings.TrimPrefix(strings.TrimSpace(category.Icon), "fa-")
+	if category.Icon == "" {
+		category.Icon = defaultCategoryIcon
+	}
+	if !categoryIconPattern.MatchString(category.Icon) {
+		return fmt.Errorf("icon must be a Font Awesome icon name such as %q", defaultCategoryIcon)
+	}
+
+	if category.IdealMin < 0 || category.IdealMax > 100 || category.IdealMin > category.IdealMax {
+		return fmt.Errorf("ideal range must be between 0 and 100 percent, minimum first")
+	}
+	if category.SuggestAbove < 0 || category.SuggestAbove > 100 {
+		return fmt.Errorf("suggest_above must be between 0 and 100 percent")
+	}
+	category.Suggestion = strings.TrimSpace(category.Suggestion)
+	if category.SuggestAbove > 0 && category.Suggestion == "" {
+		return fmt.Errorf("a suggestion text is required when suggest_above is set")
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"fmt"
+	"time"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+)
+
+// ChecklistProgress - Tamamlanan madde sayısı ve yüzdesi
+type ChecklistProgress struct {
+	Total   int     `json:"total"`
+	Done    int     `json:"done"`
+	Percent float64 `json:"percent"`
+}
+
+type ChecklistService interface {
+	GetChecklists(tripID uint) ([]models.Checklist, error)
+	GetChecklistByID(id uint) (*models.Checklist, error)
+	CreateChecklist(checklist *models.Checklist) error
+	DeleteChecklist(id uint) error
+	AddItem(trip *models.Trip, item *models.ChecklistItem) error
+	UpdateItem(trip *models.Trip, item *models.ChecklistItem, actorID uint) error
+	DeleteItem(id uint) error
+	SaveAsTemplate(checklist *models.Checklist, userID uint, title string) (*models.ChecklistTemplate, error)
+	GetTemplates(userID uint) ([]models.ChecklistTemplate, error)
+	GetTemplateByID(id uint) (*models.ChecklistTemplate, error)
+	CreateFromTemplate(template *models.ChecklistTemplate, tripID uint) (*models.Checklist, error)
+	DeleteTemplate(id uint) error
+}
+
+type checklistService struct {
+	repo          repository.ChecklistRepository
+	memberService MemberService
+}
+
+func NewChecklistService(repo repository.ChecklistRepository, memberService MemberService) ChecklistService {
+	return &checklistService{repo: repo, memberService: memberService}
+}
+
+// Progress - Listelerdeki tüm maddeler üzerinden ilerleme (madde yoksa %0)
+func Progress(checklists []models.Checklist) ChecklistProgress {
+	var progress ChecklistProgress
+	for _, checklist := range checklists {
+		for _, item := range checklist.Items {
+			progress.Total++
+			if item.Done {
+				progress.Done++
+			}
+		}
+	}
+	if progress.Total > 0 {
+		progress.Percent = float64(progress.Done) / float64(progress.Total) * 100
+	}
+	return progress
+}
+
+func (s *checklistService) GetChecklists(tripID uint) ([]models.Checklist, error) {
+	return s.repo.GetChecklistsByTripID(tripID)
+}
+
+func (s *checklistService) GetChecklistByID(id uint) (*models.Checklist, error) {
+	return s.repo.GetChecklistByID(id)
+}
+
+func (s *checklistService) CreateChecklist(checklist *models.Checklist) error {
+	if err := validateChecklist(checklist.Title, &checklist.Kind); err != nil {
+		return err
+	}
+	for i := range checklist.Items {
+		checklist.Items[i].Position = i
+	}
+	return s.repo.CreateChecklist(checklist)
+}
+
+func (s *checklistService) DeleteChecklist(id uint) error {
+	return s.repo.DeleteChecklist(id)
+}
+
+// AddItem - Listenin sonuna madde ekler; atanan kişi gezinin katılımcısı olmalı
+func (s *checklistService) AddItem(trip *models.Trip, item *models.ChecklistItem) error {
+	if err := s.validateItem(trip, item); err != nil {
+		return err
+	}
+
+	checklist, err := s.repo.GetChecklistByID(item.ChecklistID)
+	if err != nil {
+		return err
+	}
+	item.Position = len(checklist.Items)
+
+	return s.repo.CreateItem(item)
+}
+
+// UpdateItem - Metin, atama veya tamamlanma durumunu günceller; tamamlayan kişi ve zaman kaydedilir
+func (s *checklistService) UpdateItem(trip *models.Trip, item *models.ChecklistItem, actorID uint) error {
+	if err := s.validateItem(trip, item); err != nil {
+		return err
+	}
+
+	switch {
+	case item.Done && item.DoneAt == nil:
+		now := time.Now()
+		item.DoneAt = &now
+		item.DoneByID = &actorID
+	case !item.Done:
+		item.DoneAt = nil
+		item.DoneByID = nil
+	}
+
+	return s.repo.UpdateItem(item)
+}
+
+func (s *checklistService) DeleteItem(id uint) error {
+	return s.repo.DeleteItem(id)
+}
+
+// SaveAsTemplate - Listeyi maddeleriyle (atama ve tamamlanma olmadan) şablon olarak kaydeder
+func (s *checklistService) SaveAsTemplate(checklist *models.Checklist, userID uint, title string) (*models.ChecklistTemplate, error) {
+	if title == "" {
+		title = checklist.Title
+	}
+
+	template := &models.ChecklistTemplate{UserID: userID, Title: title, Kind: checklist.Kind}
+	for i, item := range checklist.Items {
+		template.Items = append(template.Items, models.ChecklistTemplateItem{Text: item.Text, Position: i})
+	}
+
+	if err := s.repo.CreateTemplate(template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func (s *checklistService) GetTemplates(userID uint) ([]models.ChecklistTemplate, error) {
+	return s.repo.GetTemplatesByUserID(userID)
+}
+
+func (s *checklistService) GetTemplateByID(id uint) (*models.ChecklistTemplate, error) {
+	return s.repo.GetTemplateByID(id)
+}
+
+// CreateFromTemplate - Şablondaki maddelerle geziye yeni bir liste ekler
+func (s *checklistService) CreateFromTemplate(template *models.ChecklistTemplate, tripID uint) (*models.Checklist, error) {
+	checklist := &models.Checklist{TripID: tripID, Title: template.Title, Kind: template.Kind}
+	for _, item := range template.Items {
+		checklist.Items = append(checklist.Items, models.ChecklistItem{Text: item.Text})
+	}
+
+	if err := s.CreateChecklist(checklist); err != nil {
+		return nil, err
+	}
+	return checklist, nil
+}
+
+func (s *checklistService) DeleteTemplate(id uint) error {
+	return s.repo.DeleteTemplate(id)
+}
+
+func (s *checklistService) validateItem(trip *models.Trip, item *models.ChecklistItem) error {
+	if item.Text == "" {
+		return fmt.Errorf("item text is required")
+	}
+	if item.AssigneeID != nil && !s.memberService.IsParticipant(trip, *item.AssigneeID) {
+		return fmt.Errorf("items can only be assigned to trip members")
+	}
+	return nil
+}
+
+// validateChecklist - Başlık zorunlu; tür boşsa "other" olur
+func validateChecklist(title string, kind *string) error {
+	if title == "" {
+		return fmt.Errorf("checklist title is required")
+	}
+	switch *kind {
+	case "":
+		*kind = models.ChecklistOther
+	case models.ChecklistPacking, models.ChecklistDocuments, models.ChecklistBookings, models.ChecklistOther:
+	default:
+		return fmt.Errorf("unknown checklist kind %q", *kind)
+	}
+	return nil
+}
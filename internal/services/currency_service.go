@@ -0,0 +1,107 @@
+package services
+
+import (
+	"fmt"
+	"time"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+)
+
+// rateMaxAge - Veritabanındaki kur bu süreden eskiyse önce sağlayıcıya sorulur
+// (hafta sonu ve tatillerde yayınlanmayan kurlar için birkaç günlük tolerans)
+const rateMaxAge = 7 * 24 * time.Hour
+
+type CurrencyService interface {
+	Rate(from, to string, date time.Time) (float64, error)
+	Convert(amount float64, from, to string, date time.Time) (float64, float64, error)
+	Currencies() []string
+}
+
+type currencyService struct {
+	repo     repository.ExchangeRateRepository
+	provider currency.RateProvider
+}
+
+// NewCurrencyService - provider offline tablo, HTTP servisi veya bunların zinciri olabilir
+// Sağlayıcıdan alınan kurlar veritabanına yazılır; sonraki sorgular oradan cevaplanır
+func NewCurrencyService(repo repository.ExchangeRateRepository, provider currency.RateProvider) CurrencyService {
+	return &currencyService{repo: repo, provider: provider}
+}
+
+// Rate - Önce veritabanı, sonra sağlayıcı; sağlayıcı hata verirse eski kayıtlı kur kullanılır
+func (s *currencyService) Rate(from, to string, date time.Time) (float64, error) {
+	from, to = currency.Normalize(from), currency.Normalize(to)
+	if !currency.IsValidCode(from) || !currency.IsValidCode(to) {
+		return 0, fmt.Errorf("invalid currency code %s/%s", from, to)
+	}
+	if from == to {
+		return 1, nil
+	}
+
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	if stored, err := s.repo.FindRate(from, to, day.Add(-rateMaxAge), day); err == nil {
+		return stored.Rate, nil
+	}
+
+	rate, err := s.provider.Rate(from, to, day)
+	if err != nil {
+		if stored, storedErr := s.repo.FindRate(from, to, time.Time{}, day); storedErr == nil {
+			return stored.Rate, nil
+		}
+		return 0, err
+	}
+
+	// Önbelleğe yazılamaması kuru kullanmaya engel değil
+	s.repo.SaveRate(&models.ExchangeRate{Base: from, Quote: to, Date: day, Rate: rate, Source: "provider"})
+	return rate, nil
+}
+
+func (s *currencyService) Convert(amount float64, from, to string, date time.Time) (float64, float64, error) {
+	return currency.Convert(s, amount, from, to, date)
+}
+
+// Currencies - Sağlayıcının listeleyebildiği para birimleri (yoksa yalnızca varsayılan)
+func (s *currencyService) Currencies() []string {
+	if lister, ok := s.provider.(currency.Lister); ok {
+		if codes := lister.Currencies(); len(codes) > 0 {
+			return codes
+		}
+	}
+	return []string{currency.Base}
+}
+
+// Spending - Gezinin harcamalarının, gezinin para birimindeki özeti
+type Spending struct {
+	Currency   string             `json:"currency"`
+	Budget     float64            `json:"budget"`
+	Total      float64            `json:"total"`
+	Remaining  float64            `json:"remaining"`
+	ByCategory map[string]float64 `json:"by_category"`
+}
+
+// HomeAmount - Harcamanın gezi para birimindeki tutarı
+// Kur yakalanmadan önce kaydedilmiş harcamalar gezinin para biriminde kabul edilir
+func HomeAmount(expense models.Expense) float64 {
+	if expense.ExchangeRate == 0 {
+		return expense.Amount
+	}
+	return expense.HomeAmount
+}
+
+// SummarizeSpending - Toplamlar her harcamanın kendi tarihindeki kurla çevrilmiş tutarından hesaplanır
+func SummarizeSpending(trip *models.Trip) Spending {
+	spending := Spending{
+		Currency:   currency.Normalize(trip.Currency),
+		Budget:     trip.Budget,
+		ByCategory: make(map[string]float64),
+	}
+	for _, expense := range trip.Expenses {
+		amount := HomeAmount(expense)
+		spending.Total += amount
+		spending.ByCategory[expense.Category] += amount
+	}
+	spending.Total = currency.Round(spending.Total)
+	spending.Remaining = currency.Round(trip.Budget - spending.Total)
+	return spending
+}
@@ -0,0 +1,408 @@
+package services
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"travel-platform/internal/geo"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+)
+
+//go:embed destinations.csv
+var destinationsCSV string
+
+// Otomatik tamamlama sonuç sayısı
+const (
+	DefaultAutocompleteLimit = 10
+	MaxAutocompleteLimit     = 25
+)
+
+type DestinationService interface {
+	Seed(locations []geo.Location) (int, error)
+	LinkTrips() (int, error)
+	GetDestinations() ([]models.Destination, error)
+	GetDestination(id uint) (*models.Destination, error)
+	GetFeatured() ([]models.Destination, error)
+	Match(query string) (*models.Destination, bool)
+	Autocomplete(query string, limit int) ([]models.Destination, error)
+	Geocode(query string) (*geo.Location, error) // geo.Geocoder
+}
+
+type destinationService struct {
+	repo repository.DestinationRepository
+
+	mu      sync.RWMutex
+	catalog []models.Destination // Seed'e kadar nil; katalog sadece Seed ile değişir
+	index   map[string]int       // normalize edilmiş isim/alias -> catalog index
+}
+
+func NewDestinationService(repo repository.DestinationRepository) DestinationService {
+	return &destinationService{repo: repo}
+}
+
+// destinationDetails - Gömülü destinations.csv satırı (açıklama, bütçe, öne çıkanlar)
+type destinationDetails struct {
+	description string
+	budget      float64
+	highlights  string
+	featured    bool
+}
+
+// Seed - Katalogda olmayan yerleri ekler; açıklamalar gömülü veriden gelir
+// Mevcut kayıtlar değiştirilmez, eklenen kayıt sayısı döner
+func (s *destinationService) Seed(locations []geo.Location) (int, error) {
+	details, err := loadDestinationDetails(destinationsCSV)
+	if err != nil {
+		return 0, fmt.Errorf("embedded destination data is invalid: %w", err)
+	}
+
+	existing, err := s.repo.GetDestinations()
+	if err != nil {
+		return 0, err
+	}
+	known := make(map[string]bool, len(existing))
+	for _, d := range existing {
+		known[normalizePlace(d.DisplayName())] = true
+	}
+
+	added := 0
+	for _, loc := range locations {
+		destination := models.Destination{
+			Name:      loc.Name,
+			Country:   loc.Country,
+			Latitude:  loc.Latitude,
+			Longitude: loc.Longitude,
+			Aliases:   strings.Join(loc.Aliases, "|"),
+		}
+		if known[normalizePlace(destination.DisplayName())] {
+			continue
+		}
+		if d, exists := details[strings.ToLower(loc.Name)]; exists {
+			destination.Description = d.description
+			destination.EstimatedBudget = d.budget
+			destination.Highlights = d.highlights
+			destination.Featured = d.featured
+		}
+		if err := s.repo.CreateDestination(&destination); err != nil {
+			return added, err
+		}
+		added++
+	}
+
+	s.mu.Lock()
+	s.catalog = nil // Sonraki okumada yeniden yüklenir
+	s.mu.Unlock()
+	return added, nil
+}
+
+// LinkTrips - Kataloğa bağlanmamış mevcut gezileri bulanık eşleştirme ile bağlar
+func (s *destinationService) LinkTrips() (int, error) {
+	trips, err := s.repo.GetUnlinkedTrips()
+	if err != nil {
+		return 0, err
+	}
+
+	linked := 0
+	for _, trip := range trips {
+		destination, ok := s.Match(trip.Destination)
+		if !ok {
+			continue
+		}
+		if err := s.repo.LinkTrip(trip.ID, destination.ID); err != nil {
+			return linked, err
+		}
+		linked++
+	}
+	return linked, nil
+}
+
+func (s *destinationService) GetDestinations() ([]models.Destination, error) {
+	catalog, _, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	return append([]models.Destination(nil), catalog...), nil
+}
+
+func (s *destinationService) GetDestination(id uint) (*models.Destination, error) {
+	return s.repo.GetDestinationByID(id)
+}
+
+// GetFeatured - Öne çıkan destinasyonlar (topluluk gezisi yokken öneriler)
+func (s *destinationService) GetFeatured() ([]models.Destination, error) {
+	catalog, _, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	var featured []models.Destination
+	for _, d := range catalog {
+		if d.Featured {
+			featured = append(featured, d)
+		}
+	}
+	return featured, nil
+}
+
+// Match - Serbest metni katalog kaydına eşler: "Paris", "paris", "Paris, France", "Pariss" aynı yerdir
+// Önce tam eşleşme, sonra virgülle ayrılmış parçalar (ör. "Eiffel Tower, Paris"), sonra yazım hatası toleransı
+func (s *destinationService) Match(query string) (*models.Destination, bool) {
+	catalog, index, err := s.load()
+	if err != nil {
+		return nil, false
+	}
+
+	q := normalizePlace(query)
+	if q == "" {
+		return nil, false
+	}
+	if pos, ok := index[q]; ok {
+		return copyDestination(catalog[pos]), true
+	}
+
+	parts := strings.Split(q, ",")
+	candidates := []string{q}
+	for i := len(parts) - 1; i >= 0; i-- {
+		part := strings.TrimSpace(parts[i])
+		if pos, ok := index[part]; ok {
+			return copyDestination(catalog[pos]), true
+		}
+		candidates = append(candidates, part)
+	}
+
+	best, bestDistance := -1, -1
+	for _, candidate := range candidates {
+		for key, pos := range index {
+			distance := editDistance(candidate, key)
+			if distance > typoTolerance(key) {
+				continue
+			}
+			if best < 0 || distance < bestDistance || (distance == bestDistance && pos < best) {
+				best, bestDistance = pos, distance
+			}
+		}
+	}
+	if best < 0 {
+		return nil, false
+	}
+	return copyDestination(catalog[best]), true
+}
+
+// copyDestination - Önbellekteki kayıt çağıranlar tarafından değiştirilemesin
+func copyDestination(d models.Destination) *models.Destination {
+	return &d
+}
+
+// Autocomplete - Sıra: ad öneki, alias öneki, addaki kelime öneki, ülke öneki, yazım hatalı önek
+// Aynı sıradakiler public gezi sayısına, sonra ada göre sıralanır
+func (s *destinationService) Autocomplete(query string, limit int) ([]models.Destination, error) {
+	if limit <= 0 {
+		limit = DefaultAutocompleteLimit
+	}
+	if limit > MaxAutocompleteLimit {
+		limit = MaxAutocompleteLimit
+	}
+
+	catalog, _, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	counts, err := s.repo.CountTripsByDestination()
+	if err != nil {
+		return nil, err
+	}
+
+	q := normalizePlace(query)
+	type ranked struct {
+		destination models.Destination
+		rank        int
+	}
+	var matches []ranked
+	for _, d := range catalog {
+		if rank, ok := autocompleteRank(d, q); ok {
+			matches = append(matches, ranked{destination: d, rank: rank})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.rank != b.rank {
+			return a.rank < b.rank
+		}
+		if counts[a.destination.ID] != counts[b.destination.ID] {
+			return counts[a.destination.ID] > counts[b.destination.ID]
+		}
+		return a.destination.Name < b.destination.Name
+	})
+
+	results := []models.Destination{}
+	for i := 0; i < len(matches) && i < limit; i++ {
+		results = append(results, matches[i].destination)
+	}
+	return results, nil
+}
+
+// Geocode - Katalog geo.Geocoder olarak da kullanılabilir
+func (s *destinationService) Geocode(query string) (*geo.Location, error) {
+	destination, ok := s.Match(query)
+	if !ok {
+		return nil, geo.ErrNotFound
+	}
+	return &geo.Location{
+		Name:      destination.Name,
+		Country:   destination.Country,
+		Latitude:  destination.Latitude,
+		Longitude: destination.Longitude,
+		Aliases:   destination.AliasList(),
+	}, nil
+}
+
+// load - Katalog ve arama indeksi bellekte tutulur
+func (s *destinationService) load() ([]models.Destination, map[string]int, error) {
+	s.mu.RLock()
+	catalog, index := s.catalog, s.index
+	s.mu.RUnlock()
+	if catalog != nil {
+		return catalog, index, nil
+	}
+
+	catalog, err := s.repo.GetDestinations()
+	if err != nil {
+		return nil, nil, err
+	}
+	index = make(map[string]int)
+	for i, d := range catalog {
+		keys := append([]string{d.Name, d.DisplayName(), d.Name + " " + d.Country}, d.AliasList()...)
+		for _, key := range keys {
+			if _, exists := index[normalizePlace(key)]; !exists {
+				index[normalizePlace(key)] = i
+			}
+		}
+	}
+
+	s.mu.Lock()
+	s.catalog, s.index = catalog, index
+	s.mu.Unlock()
+	return catalog, index, nil
+}
+
+// autocompleteRank - Sorgu boşsa tüm katalog aynı sırada eşleşir
+func autocompleteRank(d models.Destination, q string) (int, bool) {
+	if q == "" {
+		return 0, true
+	}
+
+	name := normalizePlace(d.Name)
+	if strings.HasPrefix(name, q) {
+		return 0, true
+	}
+	for _, alias := range d.AliasList() {
+		if strings.HasPrefix(normalizePlace(alias), q) {
+			return 1, true
+		}
+	}
+	for _, word := range strings.Fields(name) {
+		if strings.HasPrefix(word, q) {
+			return 2, true
+		}
+	}
+	if strings.HasPrefix(normalizePlace(d.Country), q) {
+		return 3, true
+	}
+
+	// "barsel" -> Barcelona: aynı uzunluktaki ad öneki ile bir harf farkı
+	runes := []rune(name)
+	if n := len([]rune(q)); n >= 4 && n <= len(runes) && editDistance(q, string(runes[:n])) <= 1 {
+		return 4, true
+	}
+	return 0, false
+}
+
+// placeFolder - Aksanlı harfleri sadeleştirir (münchen -> munchen, göreme -> goreme)
+var placeFolder = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a", "å", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i", "ı", "i",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o", "ø", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ş", "s", "ğ", "g", "ñ", "n", "ß", "ss",
+)
+
+// normalizePlace - Küçük harf, sade harfler, noktalama yerine boşluk (virgül korunur)
+func normalizePlace(s string) string {
+	s = placeFolder.Replace(strings.ToLower(s))
+	s = strings.Map(func(r rune) rune {
+		if r == ',' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r > 127 {
+			return r
+		}
+		return ' '
+	}, s)
+
+	parts := strings.Split(s, ",")
+	for i, part := range parts {
+		parts[i] = strings.Join(strings.Fields(part), " ")
+	}
+	return strings.Trim(strings.Join(parts, ", "), ", ")
+}
+
+// typoTolerance - Kısa isimlerde daha az yazım hatasına izin verilir
+func typoTolerance(key string) int {
+	switch n := len([]rune(key)); {
+	case n < 4:
+		return 0
+	case n <= 6:
+		return 1
+	}
+	return 2
+}
+
+// editDistance - Levenshtein mesafesi
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current := make([]int, len(rb)+1)
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(rb)]
+}
+
+// loadDestinationDetails - "name,description,estimated_budget,highlights,featured" başlıklı CSV
+func loadDestinationDetails(data string) (map[string]destinationDetails, error) {
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	details := make(map[string]destinationDetails)
+	for i, rec := range records[1:] {
+		if len(rec) != 5 {
+			return nil, fmt.Errorf("line %d: expected 5 columns", i+2)
+		}
+		budget, err := strconv.ParseFloat(rec[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid estimated_budget", i+2)
+		}
+		featured, err := strconv.ParseBool(rec[4])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid featured flag", i+2)
+		}
+		details[strings.ToLower(rec[0])] = destinationDetails{description: rec[1], budget: budget, highlights: rec[3], featured: featured}
+	}
+	return details, nil
+}
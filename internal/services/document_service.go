@@ -0,0 +1,254 @@
+package services
+
+import (
+	"fmt"
+	"time"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/vault"
+)
+
+// Hatırlatma kuralları
+const (
+	DocumentReminderWindow = 60 * 24 * time.Hour // Bu süre içinde dolacak belgeler hatırlatılır
+	PassportValidityMonths = 6                   // Pasaport dönüşten sonra en az bu kadar geçerli olmalı
+)
+
+// DocumentReminder - Süresi dolmuş, yakında dolacak veya gezi için yetersiz kalan belge
+type DocumentReminder struct {
+	DocumentID    uint      `json:"document_id"`
+	DocumentTitle string    `json:"document_title"`
+	DocumentType  string    `json:"document_type"`
+	ExpiresAt     time.Time `json:"expires_at"`
+	DaysLeft      int       `json:"days_left"`
+	TripID        uint      `json:"trip_id,omitempty"`
+	TripTitle     string    `json:"trip_title,omitempty"`
+	Message       string    `json:"message"`
+}
+
+type DocumentService interface {
+	UploadDocument(document *models.TravelDocument, data []byte) error
+	GetDocuments(userID uint) ([]models.TravelDocument, error)
+	GetDocumentByID(id uint) (*models.TravelDocument, error)
+	OpenDocument(document *models.TravelDocument) ([]byte, error)
+	UpdateDocument(document *models.TravelDocument) error
+	DeleteDocument(id uint) error
+	GetReminders(userID uint, now time.Time) ([]DocumentReminder, error)
+}
+
+type documentService struct {
+	repo          repository.DocumentRepository
+	tripService   TripService
+	memberService MemberService // Üye olunan gezilere bağlı belgelerin hatırlatmaları için
+	cipher        *vault.Cipher
+}
+
+func NewDocumentService(repo repository.DocumentRepository, tripService TripService, memberService MemberService, cipher *vault.Cipher) DocumentService {
+	return &documentService{repo: repo, tripService: tripService, memberService: memberService, cipher: cipher}
+}
+
+// UploadDocument - Belge numarasını ve dosyayı sahibine bağlı olarak şifreleyip kaydeder
+func (s *documentService) UploadDocument(document *models.TravelDocument, data []byte) error {
+	if err := validateDocument(document); err != nil {
+		return err
+	}
+
+	encrypted, err := s.cipher.Encrypt(data, ownerData(document.UserID))
+	if err != nil {
+		return err
+	}
+	document.EncryptedData = encrypted
+	document.Size = int64(len(data))
+
+	if err := s.encryptNumber(document); err != nil {
+		return err
+	}
+	return s.repo.CreateDocument(document)
+}
+
+// GetDocuments - Kullanıcının belgeleri (numaralar çözülmüş, dosyalar olmadan)
+func (s *documentService) GetDocuments(userID uint) ([]models.TravelDocument, error) {
+	documents, err := s.repo.GetDocumentsByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range documents {
+		if err := s.decryptNumber(&documents[i]); err != nil {
+			return nil, err
+		}
+	}
+	return documents, nil
+}
+
+func (s *documentService) GetDocumentByID(id uint) (*models.TravelDocument, error) {
+	document, err := s.repo.GetDocumentByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.decryptNumber(document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// OpenDocument - Dosyanın çözülmüş içeriği
+func (s *documentService) OpenDocument(document *models.TravelDocument) ([]byte, error) {
+	return s.cipher.Decrypt(document.EncryptedData, ownerData(document.UserID))
+}
+
+// UpdateDocument - Belge bilgilerini günceller (dosya değişmez)
+func (s *documentService) UpdateDocument(document *models.TravelDocument) error {
+	if err := validateDocument(document); err != nil {
+		return err
+	}
+	if err := s.encryptNumber(document); err != nil {
+		return err
+	}
+	return s.repo.UpdateDocument(document)
+}
+
+func (s *documentService) DeleteDocument(id uint) error {
+	return s.repo.DeleteDocument(id)
+}
+
+// GetReminders - Kullanıcının belgelerini yaklaşan ve devam eden gezilerine göre kontrol eder
+// Sahip olduğu gezilerin yanında üye olarak katıldığı geziler de dahildir
+func (s *documentService) GetReminders(userID uint, now time.Time) ([]DocumentReminder, error) {
+	documents, err := s.repo.GetDocumentsByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	trips, err := s.tripService.GetTripsByStatus(userID, []string{models.TripStatusUpcoming, models.TripStatusInProgress})
+	if err != nil {
+		return nil, err
+	}
+
+	tripIDs, err := s.memberService.GetMemberTripIDs(userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, tripID := range tripIDs {
+		trip, err := s.tripService.GetTripByID(tripID)
+		if err != nil {
+			continue // çöp kutusundaki geziler
+		}
+		if trip.Status == models.TripStatusUpcoming || trip.Status == models.TripStatusInProgress {
+			trips = append(trips, *trip)
+		}
+	}
+	return DocumentReminders(documents, trips, now), nil
+}
+
+// DocumentReminders - Hatırlatma kuralları:
+//   - süresi dolmuş veya DocumentReminderWindow içinde dolacak her belge
+//   - pasaport, vize, sigorta ve kimlik için gezi bitmeden dolan belgeler
+//     (pasaport dönüşten sonra PassportValidityMonths ay geçerli olmalı)
+//
+// Bir geziye bağlı belge sadece o geziyle karşılaştırılır
+func DocumentReminders(documents []models.TravelDocument, trips []models.Trip, now time.Time) []DocumentReminder {
+	reminders := []DocumentReminder{}
+
+	for _, document := range documents {
+		if document.ExpiresAt == nil {
+			continue
+		}
+		expiresAt := *document.ExpiresAt
+		reminder := DocumentReminder{
+			DocumentID:    document.ID,
+			DocumentTitle: document.Title,
+			DocumentType:  document.Type,
+			ExpiresAt:     expiresAt,
+			DaysLeft:      int(expiresAt.Sub(now).Hours() / 24),
+		}
+
+		if expiresAt.Before(now) {
+			reminder.Message = fmt.Sprintf("%s expired on %s", document.Title, expiresAt.Format("Jan 2, 2006"))
+			reminders = append(reminders, reminder)
+			continue
+		}
+
+		flagged := false
+		if document.Type != models.DocumentOther {
+			for _, trip := range trips {
+				if trip.EndDate.Before(now) || (document.TripID != nil && *document.TripID != trip.ID) {
+					continue
+				}
+
+				required := trip.EndDate
+				if document.Type == models.DocumentPassport {
+					required = required.AddDate(0, PassportValidityMonths, 0)
+				}
+				if !expiresAt.Before(required) {
+					continue
+				}
+
+				tripReminder := reminder
+				tripReminder.TripID = trip.ID
+				tripReminder.TripTitle = trip.Title
+				if document.Type == models.DocumentPassport {
+					tripReminder.Message = fmt.Sprintf("%s expires on %s; it should be valid for %d months after %s ends",
+						document.Title, expiresAt.Format("Jan 2, 2006"), PassportValidityMonths, trip.Title)
+				} else {
+					tripReminder.Message = fmt.Sprintf("%s expires on %s, before %s ends",
+						document.Title, expiresAt.Format("Jan 2, 2006"), trip.Title)
+				}
+				reminders = append(reminders, tripReminder)
+				flagged = true
+			}
+		}
+
+		if !flagged && expiresAt.Sub(now) <= DocumentReminderWindow {
+			reminder.Message = fmt.Sprintf("%s expires in %d days", document.Title, reminder.DaysLeft)
+			reminders = append(reminders, reminder)
+		}
+	}
+
+	return reminders
+}
+
+func (s *documentService) encryptNumber(document *models.TravelDocument) error {
+	document.EncryptedNumber = nil
+	if document.Number == "" {
+		return nil
+	}
+	encrypted, err := s.cipher.Encrypt([]byte(document.Number), ownerData(document.UserID))
+	if err != nil {
+		return err
+	}
+	document.EncryptedNumber = encrypted
+	return nil
+}
+
+func (s *documentService) decryptNumber(document *models.TravelDocument) error {
+	if len(document.EncryptedNumber) == 0 {
+		return nil
+	}
+	number, err := s.cipher.Decrypt(document.EncryptedNumber, ownerData(document.UserID))
+	if err != nil {
+		return err
+	}
+	document.Number = string(number)
+	return nil
+}
+
+// ownerData - Şifreli veriyi sahibine bağlar; başka kullanıcının kaydına taşınan veri çözülemez
+func ownerData(userID uint) []byte {
+	return []byte(fmt.Sprintf("user:%d", userID))
+}
+
+func validateDocument(document *models.TravelDocument) error {
+	switch document.Type {
+	case "":
+		document.Type = models.DocumentOther
+	case models.DocumentPassport, models.DocumentVisa, models.DocumentInsurance, models.DocumentIDCard, models.DocumentOther:
+	default:
+		return fmt.Errorf("unknown document type %q", document.Type)
+	}
+	if document.Title == "" {
+		document.Title = document.FileName
+	}
+	if document.Title == "" {
+		return fmt.Errorf("document title is required")
+	}
+	return nil
+}
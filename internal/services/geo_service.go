@@ -0,0 +1,80 @@
+package services
+
+import (
+	"travel-platform/internal/geo"
+	"travel-platform/internal/models"
+)
+
+type GeoService interface {
+	Geocode(query string) (*geo.Location, error)
+	GeocodeTrip(trip *models.Trip) []string
+	LinkDestination(trip *models.Trip) bool
+}
+
+type geoService struct {
+	geocoder     geo.Geocoder
+	destinations DestinationService // nil ise geziler kataloğa bağlanmaz
+}
+
+func NewGeoService(geocoder geo.Geocoder, destinations DestinationService) GeoService {
+	return &geoService{geocoder: geocoder, destinations: destinations}
+}
+
+func (s *geoService) Geocode(query string) (*geo.Location, error) {
+	return s.geocoder.Geocode(query)
+}
+
+// GeocodeTrip - Koordinatı olmayan gezi ve aktivitelere koordinat atar
+// Kullanıcının girdiği koordinatlar korunur; çözülemeyen yer adları döndürülür
+func (s *geoService) GeocodeTrip(trip *models.Trip) []string {
+	var unresolved []string
+
+	s.LinkDestination(trip)
+	if trip.Latitude == nil || trip.Longitude == nil {
+		if loc, err := s.geocoder.Geocode(trip.Destination); err == nil {
+			trip.Latitude, trip.Longitude = &loc.Latitude, &loc.Longitude
+		} else {
+			unresolved = append(unresolved, trip.Destination)
+		}
+	}
+
+	for i := range trip.Activities {
+		activity := &trip.Activities[i]
+		if activity.Location == "" || (activity.Latitude != nil && activity.Longitude != nil) {
+			continue
+		}
+
+		loc, err := s.geocoder.Geocode(activity.Location)
+		if err != nil {
+			// "Louvre" gibi yer adları tek başına bulunamazsa destinasyonla birlikte dene
+			// (offline gazetteer'da bu durumda şehir merkezine düşer)
+			loc, err = s.geocoder.Geocode(activity.Location + ", " + trip.Destination)
+		}
+		if err != nil {
+			unresolved = append(unresolved, activity.Location)
+			continue
+		}
+		activity.Latitude, activity.Longitude = &loc.Latitude, &loc.Longitude
+	}
+
+	return unresolved
+}
+
+// LinkDestination - Geziyi katalogdaki destinasyona bağlar; koordinatı yoksa katalogdakini kullanır
+// Zaten bağlı gezi değişmez; destinasyon metni değiştiğinde çağıran DestinationID'yi sıfırlamalıdır
+func (s *geoService) LinkDestination(trip *models.Trip) bool {
+	if s.destinations == nil || trip.DestinationID != nil {
+		return false
+	}
+
+	destination, ok := s.destinations.Match(trip.Destination)
+	if !ok {
+		return false
+	}
+	trip.DestinationID = &destination.ID
+	if trip.Latitude == nil || trip.Longitude == nil {
+		lat, lon := destination.Latitude, destination.Longitude
+		trip.Latitude, trip.Longitude = &lat, &lon
+	}
+	return true
+}
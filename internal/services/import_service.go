@@ -0,0 +1,265 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"time"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/importer"
+	"travel-platform/internal/models"
+)
+
+// ImportReport - Import sonucu (dry-run'da sadece önizleme, kayıt yok)
+type ImportReport struct {
+	DryRun   bool                 `json:"dry_run"`
+	Imported int                  `json:"imported"`
+	Trips    []models.Trip        `json:"trips"`
+	Errors   []importer.ItemError `json:"errors"`
+}
+
+// Ekstre hareketlerinin önizlemedeki durumu
+const (
+	StatementNew        = "new"
+	StatementDuplicate  = "duplicate"    // Gezide aynı harcama zaten var veya dosyada tekrar ediyor
+	StatementOutOfRange = "out_of_range" // Gezi tarihlerinin dışında
+)
+
+// StatementLine - Önizlemedeki tek hareket; sadece "new" olanlar onaylanabilir
+type StatementLine struct {
+	importer.Transaction
+	Category    string `json:"category"` // Açıklamadan önerilen kategori
+	Status      string `json:"status"`
+	DuplicateOf uint   `json:"duplicate_of,omitempty"` // Eşleşen harcamanın ID'si
+}
+
+// StatementPreview - Ekstre önizlemesi; hiçbir kayıt oluşturulmaz
+type StatementPreview struct {
+	Format     string               `json:"format"`
+	Lines      []StatementLine      `json:"lines"`
+	New        int                  `json:"new"`
+	Duplicates int                  `json:"duplicates"`
+	OutOfRange int                  `json:"out_of_range"`
+	Errors     []importer.ItemError `json:"errors"`
+}
+
+// StatementImport - Kullanıcının onayladığı hareketlerden oluşturulan harcamalar
+type StatementImport struct {
+	Imported int                  `json:"imported"`
+	Expenses []models.Expense     `json:"expenses"`
+	Errors   []importer.ItemError `json:"errors"`
+}
+
+type ImportService interface {
+	ImportICS(userID uint, data []byte, opts importer.TripOptions, dryRun bool) (*ImportReport, error)
+	ImportBundle(userID uint, data []byte, dryRun bool) (*ImportReport, error)
+	PreviewStatement(trip *models.Trip, data []byte, format string, mapping importer.CSVMapping) (*StatementPreview, error)
+	ConfirmStatement(trip *models.Trip, actorID uint, lines []StatementLine) (*StatementImport, error)
+}
+
+type importService struct {
+	tripService TripService
+	geoService  GeoService
+}
+
+func NewImportService(tripService TripService, geoService GeoService) ImportService {
+	return &importService{tripService: tripService, geoService: geoService}
+}
+
+// ImportICS - .ics dosyasındaki event'lerden tek bir gezi oluşturur
+func (s *importService) ImportICS(userID uint, data []byte, opts importer.TripOptions, dryRun bool) (*ImportReport, error) {
+	events, itemErrors, err := importer.ParseICS(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ImportReport{DryRun: dryRun, Trips: []models.Trip{}, Errors: itemErrors}
+
+	trip, err := importer.TripFromEvents(userID, events, opts)
+	if err != nil {
+		report.Errors = append(report.Errors, importer.ItemError{Item: "calendar", Message: err.Error()})
+		return report, nil
+	}
+
+	s.save(report, "calendar", []models.Trip{*trip})
+	return report, nil
+}
+
+// ImportBundle - TravelMate JSON bundle'ındaki tüm gezileri içe aktarır
+func (s *importService) ImportBundle(userID uint, data []byte, dryRun bool) (*ImportReport, error) {
+	bundle, err := importer.ParseBundle(data)
+	if err != nil {
+		return nil, err
+	}
+
+	trips, itemErrors := bundle.ToTrips(userID)
+	report := &ImportReport{DryRun: dryRun, Trips: []models.Trip{}, Errors: itemErrors}
+
+	s.save(report, "trips", trips)
+	return report, nil
+}
+
+// save - Her geziyi doğrular, dry-run değilse kaydeder; hatalar rapora eklenir
+func (s *importService) save(report *ImportReport, label string, trips []models.Trip) {
+	for i := range trips {
+		trip := &trips[i]
+		item := fmt.Sprintf("%s[%d] %q", label, i, trip.Title)
+
+		if err := ValidateTrip(trip); err != nil {
+			report.Errors = append(report.Errors, importer.ItemError{Item: item, Message: err.Error()})
+			continue
+		}
+		s.geoService.GeocodeTrip(trip)
+
+		if !report.DryRun {
+			if err := s.tripService.CreateTrip(trip); err != nil {
+				report.Errors = append(report.Errors, importer.ItemError{Item: item, Message: err.Error()})
+				continue
+			}
+			report.Imported++
+		}
+		report.Trips = append(report.Trips, *trip)
+	}
+}
+
+// PreviewStatement - CSV veya OFX ekstresini okur, her harcamaya kategori önerir ve
+// gezi tarihleri dışındakileri ve mevcut harcamalarla çakışanları işaretler
+func (s *importService) PreviewStatement(trip *models.Trip, data []byte, format string, mapping importer.CSVMapping) (*StatementPreview, error) {
+	var (
+		transactions []importer.Transaction
+		itemErrors   []importer.ItemError
+		err          error
+	)
+	switch format {
+	case "ofx":
+		transactions, itemErrors, err = importer.ParseOFX(data)
+	case "csv":
+		transactions, itemErrors, err = importer.ParseCSVStatement(data, mapping)
+	default:
+		return nil, fmt.Errorf("unsupported statement format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &StatementPreview{Format: format, Lines: []StatementLine{}, Errors: itemErrors}
+	if preview.Errors == nil {
+		preview.Errors = []importer.ItemError{}
+	}
+
+	seen := newStatementIndex(trip)
+	for _, tx := range transactions {
+		line := StatementLine{Transaction: tx, Category: importer.SuggestCategory(tx.Description)}
+		if line.Currency == "" {
+			line.Currency = trip.Currency
+		}
+
+		line.Status, line.DuplicateOf = seen.classify(trip, line.Transaction)
+		switch line.Status {
+		case StatementDuplicate:
+			preview.Duplicates++
+		case StatementOutOfRange:
+			preview.OutOfRange++
+		default:
+			preview.New++
+			seen.add(line.Transaction, 0)
+		}
+		preview.Lines = append(preview.Lines, line)
+	}
+	return preview, nil
+}
+
+// ConfirmStatement - Onaylanan hareketleri harcama olarak ekler
+// Önizleme sunucuda saklanmadığı için tarih aralığı ve tekrar kontrolleri burada yeniden yapılır
+func (s *importService) ConfirmStatement(trip *models.Trip, actorID uint, lines []StatementLine) (*StatementImport, error) {
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("select at least one transaction to import")
+	}
+
+	result := &StatementImport{Expenses: []models.Expense{}, Errors: []importer.ItemError{}}
+	seen := newStatementIndex(trip)
+	for i, line := range lines {
+		item := fmt.Sprintf("transactions[%d] %q", i, line.Description)
+		if line.Currency == "" {
+			line.Currency = trip.Currency
+		}
+
+		switch status, _ := seen.classify(trip, line.Transaction); status {
+		case StatementDuplicate:
+			result.Errors = append(result.Errors, importer.ItemError{Item: item, Message: "transaction was already imported"})
+			continue
+		case StatementOutOfRange:
+			result.Errors = append(result.Errors, importer.ItemError{Item: item, Message: "transaction date is outside the trip dates"})
+			continue
+		}
+
+		category := line.Category
+		if category == "" {
+			category = importer.SuggestCategory(line.Description)
+		}
+		expense := models.Expense{
+			TripID:      trip.ID,
+			Category:    category,
+			Amount:      line.Amount,
+			Currency:    line.Currency,
+			ExpenseDate: line.Date,
+			Description: line.Description,
+			ImportRef:   line.Ref,
+		}
+		if err := s.tripService.AddExpense(&expense, actorID); err != nil {
+			result.Errors = append(result.Errors, importer.ItemError{Item: item, Message: err.Error()})
+			continue
+		}
+		seen.add(line.Transaction, expense.ID)
+		result.Imported++
+		result.Expenses = append(result.Expenses, expense)
+	}
+	return result, nil
+}
+
+// statementIndex - Tekrar kontrolü için gezideki harcamaların ve işlenen hareketlerin anahtarları
+type statementIndex struct {
+	refs    map[string]uint
+	entries map[string]uint // gün|tutar(cent)|para birimi
+}
+
+func newStatementIndex(trip *models.Trip) *statementIndex {
+	index := &statementIndex{refs: make(map[string]uint), entries: make(map[string]uint)}
+	for _, e := range trip.Expenses {
+		if e.ImportRef != "" {
+			index.refs[e.ImportRef] = e.ID
+		}
+		expenseCurrency := e.Currency
+		if expenseCurrency == "" {
+			expenseCurrency = trip.Currency
+		}
+		index.entries[statementKey(e.ExpenseDate, e.Amount, expenseCurrency)] = e.ID
+	}
+	return index
+}
+
+// classify - Hareketin durumu ve varsa eşleştiği harcama
+// Aynı gün aynı tutar aynı para birimindeki harcama, elle girilmiş olsa da tekrar sayılır
+func (x *statementIndex) classify(trip *models.Trip, tx importer.Transaction) (string, uint) {
+	if daysBetween(trip.StartDate, tx.Date) < 0 || daysBetween(tx.Date, trip.EndDate) < 0 {
+		return StatementOutOfRange, 0
+	}
+	if id, ok := x.refs[tx.Ref]; ok && tx.Ref != "" {
+		return StatementDuplicate, id
+	}
+	if id, ok := x.entries[statementKey(tx.Date, tx.Amount, tx.Currency)]; ok {
+		return StatementDuplicate, id
+	}
+	return StatementNew, 0
+}
+
+func (x *statementIndex) add(tx importer.Transaction, expenseID uint) {
+	if tx.Ref != "" {
+		x.refs[tx.Ref] = expenseID
+	}
+	x.entries[statementKey(tx.Date, tx.Amount, tx.Currency)] = expenseID
+}
+
+func statementKey(date time.Time, amount float64, code string) string {
+	return fmt.Sprintf("%s|%d|%s", date.Format("2006-01-02"), int64(math.Round(amount*100)), currency.Normalize(code))
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"travel-platform/internal/importer"
+	"travel-platform/internal/models"
+)
+
+// ItineraryEntry - Gün planındaki tek satır (aktivite veya rezervasyon olayı)
+type ItineraryEntry struct {
+	Time          time.Time `json:"time"`
+	AllDay        bool      `json:"all_day"` // Saati olmayan aktiviteler
+	Kind          string    `json:"kind"`    // activity, flight, check_in, check_out, rail, car_pickup, car_return
+	Title         string    `json:"title"`
+	Location      string    `json:"location,omitempty"`
+	Confirmation  string    `json:"confirmation,omitempty"`
+	ActivityID    uint      `json:"activity_id,omitempty"`
+	ReservationID uint      `json:"reservation_id,omitempty"`
+}
+
+// ItineraryDay - Bir günün sıralı planı
+type ItineraryDay struct {
+	Date    time.Time        `json:"date"`
+	Entries []ItineraryEntry `json:"entries"`
+}
+
+// BuildItinerary - Aktiviteleri ve rezervasyonları gün gün, zaman sırasına göre birleştirir
+// Konaklama ve araç kiralama giriş ve çıkış olarak iki ayrı satır üretir
+func BuildItinerary(trip *models.Trip, reservations []models.Reservation) []ItineraryDay {
+	var entries []ItineraryEntry
+
+	for _, a := range trip.Activities {
+		entries = append(entries, ItineraryEntry{
+			Time:       a.Date,
+			AllDay:     isMidnight(a.Date),
+			Kind:       "activity",
+			Title:      a.Name,
+			Location:   a.Location,
+			ActivityID: a.ID,
+		})
+	}
+
+	for _, r := range reservations {
+		entries = append(entries, reservationEntries(r)...)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+
+	var days []ItineraryDay
+	for _, entry := range entries {
+		date := time.Date(entry.Time.Year(), entry.Time.Month(), entry.Time.Day(), 0, 0, 0, 0, entry.Time.Location())
+		if len(days) == 0 || !days[len(days)-1].Date.Equal(date) {
+			days = append(days, ItineraryDay{Date: date})
+		}
+		days[len(days)-1].Entries = append(days[len(days)-1].Entries, entry)
+	}
+	return days
+}
+
+func reservationEntries(r models.Reservation) []ItineraryEntry {
+	entry := func(t time.Time, kind, title, location string) ItineraryEntry {
+		return ItineraryEntry{Time: t, Kind: kind, Title: title, Location: location,
+			Confirmation: r.ConfirmationNumber, ReservationID: r.ID}
+	}
+
+	switch r.Type {
+	case models.ReservationFlight:
+		return []ItineraryEntry{entry(r.StartAt, "flight", reservationTitle(r), r.DepartureAirport)}
+	case models.ReservationRail:
+		return []ItineraryEntry{entry(r.StartAt, "rail", reservationTitle(r), r.Origin)}
+	case models.ReservationLodging:
+		entries := []ItineraryEntry{entry(r.StartAt, "check_in", "Check in: "+r.Provider, r.Address)}
+		if r.EndAt != nil {
+			entries = append(entries, entry(*r.EndAt, "check_out", "Check out: "+r.Provider, r.Address))
+		}
+		return entries
+	case models.ReservationCar:
+		entries := []ItineraryEntry{entry(r.StartAt, "car_pickup", "Pick up rental car: "+r.Provider, firstNonEmpty(r.Origin, r.Address))}
+		if r.EndAt != nil {
+			entries = append(entries, entry(*r.EndAt, "car_return", "Return rental car: "+r.Provider, firstNonEmpty(r.Destination, r.Origin, r.Address)))
+		}
+		return entries
+	}
+	return nil
+}
+
+// reservationTitle - Rezervasyonun kısa başlığı ("Flight TK1861 IST → FCO" gibi)
+func reservationTitle(r models.Reservation) string {
+	switch r.Type {
+	case models.ReservationFlight:
+		return fmt.Sprintf("Flight %s %s → %s", r.FlightNumber, r.DepartureAirport, r.ArrivalAirport)
+	case models.ReservationRail:
+		return strings.TrimSpace(fmt.Sprintf("Train %s → %s", r.Origin, r.Destination))
+	case models.ReservationLodging:
+		return "Stay: " + r.Provider
+	case models.ReservationCar:
+		return "Rental car: " + r.Provider
+	}
+	return r.Type
+}
+
+// CalendarEvents - Gezi, aktiviteler ve rezervasyonlar için takvim event'leri (ICS export)
+func CalendarEvents(trip *models.Trip, reservations []models.Reservation) []importer.Event {
+	events := []importer.Event{{
+		UID:         fmt.Sprintf("trip-%d@travelmate", trip.ID),
+		Summary:     trip.Title,
+		Description: trip.Description,
+		Location:    trip.Destination,
+		Latitude:    trip.Latitude,
+		Longitude:   trip.Longitude,
+		Start:       trip.StartDate,
+		End:         trip.EndDate,
+		AllDay:      true,
+	}}
+
+	for _, a := range trip.Activities {
+		event := importer.Event{
+			UID:         fmt.Sprintf("activity-%d@travelmate", a.ID),
+			Summary:     a.Name,
+			Description: a.Description,
+			Location:    a.Location,
+			Latitude:    a.Latitude,
+			Longitude:   a.Longitude,
+			Start:       a.Date,
+			End:         a.Date,
+			AllDay:      isMidnight(a.Date),
+		}
+		if !event.AllDay {
+			event.End = a.Date.Add(time.Hour)
+		}
+		events = append(events, event)
+	}
+
+	for _, r := range reservations {
+		event := importer.Event{
+			UID:         fmt.Sprintf("reservation-%d@travelmate", r.ID),
+			Summary:     reservationTitle(r),
+			Description: reservationDescription(r),
+			Location:    firstNonEmpty(r.Address, r.DepartureAirport, r.Origin),
+			Start:       r.StartAt,
+			End:         r.StartAt.Add(time.Hour),
+		}
+		if r.EndAt != nil {
+			event.End = *r.EndAt
+		}
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func reservationDescription(r models.Reservation) string {
+	var lines []string
+	if r.Provider != "" {
+		lines = append(lines, "Provider: "+r.Provider)
+	}
+	if r.ConfirmationNumber != "" {
+		lines = append(lines, "Confirmation: "+r.ConfirmationNumber)
+	}
+	if r.Notes != "" {
+		lines = append(lines, r.Notes)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func isMidnight(t time.Time) bool {
+	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"fmt"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+)
+
+type MemberService interface {
+	AddMember(trip *models.Trip, email string) (*models.TripMember, error)
+	RemoveMember(tripID, userID uint) error
+	GetMembers(tripID uint) ([]models.TripMember, error)
+	IsParticipant(trip *models.Trip, userID uint) bool
+	GetMemberTripIDs(userID uint) ([]uint, error)
+}
+
+type memberService struct {
+	repo     repository.MemberRepository
+	userRepo repository.UserRepository
+}
+
+func NewMemberService(repo repository.MemberRepository, userRepo repository.UserRepository) MemberService {
+	return &memberService{repo: repo, userRepo: userRepo}
+}
+
+// AddMember - Kayıtlı bir kullanıcıyı e-posta adresiyle geziye ekler
+func (s *memberService) AddMember(trip *models.Trip, email string) (*models.TripMember, error) {
+	user, err := s.userRepo.GetUserByEmail(email)
+	if err != nil {
+		return nil, fmt.Errorf("no user registered with email %s", email)
+	}
+	if s.IsParticipant(trip, user.ID) {
+		return nil, fmt.Errorf("%s is already on this trip", email)
+	}
+
+	member := &models.TripMember{TripID: trip.ID, UserID: user.ID}
+	if err := s.repo.AddMember(member); err != nil {
+		return nil, err
+	}
+	member.User = *user
+	return member, nil
+}
+
+func (s *memberService) RemoveMember(tripID, userID uint) error {
+	return s.repo.RemoveMember(tripID, userID)
+}
+
+func (s *memberService) GetMembers(tripID uint) ([]models.TripMember, error) {
+	return s.repo.GetMembersByTripID(tripID)
+}
+
+// IsParticipant - Kullanıcı gezinin sahibi veya üyesi mi
+func (s *memberService) IsParticipant(trip *models.Trip, userID uint) bool {
+	if trip.UserID == userID {
+		return true
+	}
+	ok, err := s.repo.IsMember(trip.ID, userID)
+	return err == nil && ok
+}
+
+// GetMemberTripIDs - Kullanıcının üye olduğu (sahibi olmadığı) geziler
+func (s *memberService) GetMemberTripIDs(userID uint) ([]uint, error) {
+	return s.repo.GetTripIDsByUserID(userID)
+}
@@ -0,0 +1,313 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/mailer"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+// BudgetAlertThresholds - Toplam ve kategori bütçelerinde uyarı üreten harcama oranları (%)
+var BudgetAlertThresholds = []int{75, 90, 100}
+
+// notificationLimit - Listede gösterilen en fazla bildirim
+const notificationLimit = 50
+
+// RoomBroadcaster - Sohbet odasındaki bağlı kullanıcılara anlık mesaj iletir (chat.Hub)
+type RoomBroadcaster interface {
+	Broadcast(roomID uint, message, sender string, senderID uint)
+}
+
+// BudgetAlertEvent - Yeni geçilen bütçe eşiği; Category boşsa toplam bütçedir
+type BudgetAlertEvent struct {
+	Category  string  `json:"category,omitempty"`
+	Threshold int     `json:"threshold"`
+	Budget    float64 `json:"budget"`
+	Spent     float64 `json:"spent"`
+	Currency  string  `json:"currency"`
+}
+
+// Message - Tüm kanallarda kullanılan uyarı metni
+func (e BudgetAlertEvent) Message(tripTitle string) string {
+	name := "trip budget"
+	if e.Category != "" {
+		name = e.Category + " budget"
+	}
+	icon := "⚠️"
+	if e.Threshold >= 100 {
+		icon = "🚨"
+	}
+	return fmt.Sprintf("%s %s: %d%% of the %s used (%.2f of %.2f %s).",
+		icon, tripTitle, e.Threshold, name, e.Spent, e.Budget, e.Currency)
+}
+
+type NotificationService interface {
+	GetNotifications(userID uint, unreadOnly bool) ([]models.Notification, error)
+	CountUnread(userID uint) (int64, error)
+	MarkRead(userID, id uint) error
+	MarkAllRead(userID uint) (int64, error)
+	GetSettings(userID uint) (*models.NotificationSettings, error)
+	UpdateSettings(userID uint, settings *models.NotificationSettings) (*models.NotificationSettings, error)
+	CheckBudgetAlerts(tripID uint, today time.Time) ([]BudgetAlertEvent, error)
+	Wait()
+}
+
+type notificationService struct {
+	repo          repository.NotificationRepository
+	tripService   TripService
+	budgetService BudgetService
+	memberService MemberService
+	userRepo      repository.UserRepository
+	chatRepo      repository.ChatRepository
+	broadcaster   RoomBroadcaster
+	mailer        mailer.Mailer
+	pending       sync.WaitGroup // Arka planda gönderilen e-posta ve sohbet uyarıları
+}
+
+func NewNotificationService(repo repository.NotificationRepository, tripService TripService, budgetService BudgetService, memberService MemberService,
+	userRepo repository.UserRepository, chatRepo repository.ChatRepository, broadcaster RoomBroadcaster, mailer mailer.Mailer) NotificationService {
+	return &notificationService{
+		repo:          repo,
+		tripService:   tripService,
+		budgetService: budgetService,
+		memberService: memberService,
+		userRepo:      userRepo,
+		chatRepo:      chatRepo,
+		broadcaster:   broadcaster,
+		mailer:        mailer,
+	}
+}
+
+func (s *notificationService) GetNotifications(userID uint, unreadOnly bool) ([]models.Notification, error) {
+	return s.repo.GetNotifications(userID, unreadOnly, notificationLimit)
+}
+
+func (s *notificationService) CountUnread(userID uint) (int64, error) {
+	return s.repo.CountUnread(userID)
+}
+
+func (s *notificationService) MarkRead(userID, id uint) error {
+	if err := s.repo.MarkRead(userID, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("notification not found")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *notificationService) MarkAllRead(userID uint) (int64, error) {
+	return s.repo.MarkAllRead(userID)
+}
+
+// GetSettings - Kayıt yoksa varsayılan ayarlar döner
+func (s *notificationService) GetSettings(userID uint) (*models.NotificationSettings, error) {
+	settings, err := s.repo.GetSettings(userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		defaults := models.DefaultNotificationSettings(userID)
+		return &defaults, nil
+	}
+	return settings, err
+}
+
+func (s *notificationService) UpdateSettings(userID uint, settings *models.NotificationSettings) (*models.NotificationSettings, error) {
+	settings.UserID = userID
+	if err := s.repo.SaveSettings(settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// budgetLevel - Eşikleri kontrol edilen bir bütçe (toplam veya kategori)
+type budgetLevel struct {
+	category string
+	budget   float64
+	spent    float64
+}
+
+// CheckBudgetAlerts - Harcama değiştikten sonra çağrılır; yeni geçilen eşikleri katılımcılara bildirir
+// Bir seferde birden çok eşik geçildiyse sadece en yükseği bildirilir.
+// Harcama eşiğin altına düşerse (silme, düzenleme) veya bütçe kaldırılırsa eşik sıfırlanır ve tekrar geçildiğinde yine uyarı verir
+func (s *notificationService) CheckBudgetAlerts(tripID uint, today time.Time) ([]BudgetAlertEvent, error) {
+	trip, err := s.tripService.GetTripByID(tripID)
+	if err != nil {
+		return nil, err
+	}
+	forecast, err := s.budgetService.Forecast(trip, today)
+	if err != nil {
+		return nil, err
+	}
+
+	var levels []budgetLevel
+	if trip.Budget > 0 {
+		levels = append(levels, budgetLevel{budget: trip.Budget, spent: forecast.Spent})
+	}
+	for _, c := range forecast.Categories {
+		if c.Budget > 0 {
+			levels = append(levels, budgetLevel{category: c.Category, budget: c.Budget, spent: c.Spent})
+		}
+	}
+
+	alerts, err := s.repo.GetBudgetAlerts(trip.ID)
+	if err != nil {
+		return nil, err
+	}
+	active := make(map[string]bool)
+	for _, level := range levels {
+		active[level.category] = true
+	}
+	sent := make(map[string]map[int]models.BudgetAlert)
+	for _, alert := range alerts {
+		if !active[alert.Category] {
+			if err := s.repo.DeleteBudgetAlert(alert.ID); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if sent[alert.Category] == nil {
+			sent[alert.Category] = make(map[int]models.BudgetAlert)
+		}
+		sent[alert.Category][alert.Threshold] = alert
+	}
+
+	var events []BudgetAlertEvent
+	for _, level := range levels {
+		percentage := level.spent / level.budget * 100
+		crossed := 0
+		for _, threshold := range BudgetAlertThresholds {
+			alert, recorded := sent[level.category][threshold]
+			if percentage < float64(threshold) {
+				if recorded {
+					if err := s.repo.DeleteBudgetAlert(alert.ID); err != nil {
+						return nil, err
+					}
+				}
+				continue
+			}
+			crossed = threshold
+			if !recorded {
+				if err := s.repo.CreateBudgetAlert(&models.BudgetAlert{TripID: trip.ID, Category: level.category, Threshold: threshold}); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if _, recorded := sent[level.category][crossed]; crossed > 0 && !recorded {
+			events = append(events, BudgetAlertEvent{
+				Category:  level.category,
+				Threshold: crossed,
+				Budget:    level.budget,
+				Spent:     math.Round(level.spent*100) / 100,
+				Currency:  currency.Normalize(trip.Currency),
+			})
+		}
+	}
+
+	if len(events) > 0 {
+		s.deliver(trip, events)
+	}
+	return events, nil
+}
+
+// Wait - Arka planda gönderilen uyarıların bitmesini bekler
+func (s *notificationService) Wait() {
+	s.pending.Wait()
+}
+
+// deliver - Uyarıları her katılımcıya kendi ayarlarındaki kanallardan gönderir
+// Uygulama içi bildirimler hemen yazılır; e-posta ve sohbet mesajları SMTP yavaşsa harcama
+// kaydını bekletmesin diye arka planda gönderilir. Teslim hataları loglanır
+func (s *notificationService) deliver(trip *models.Trip, events []BudgetAlertEvent) {
+	recipients := []models.User{}
+	if owner, err := s.userRepo.GetUserByID(trip.UserID); err == nil {
+		recipients = append(recipients, *owner)
+	}
+	if members, err := s.memberService.GetMembers(trip.ID); err == nil {
+		for _, member := range members {
+			recipients = append(recipients, member.User)
+		}
+	}
+
+	title := "Budget alert: " + trip.Title
+	var emails []string
+	postToChat := false
+	for _, user := range recipients {
+		settings, err := s.GetSettings(user.ID)
+		if err != nil {
+			log.Printf("🔔 Notification settings for user %d: %v", user.ID, err)
+			continue
+		}
+
+		for _, event := range events {
+			message := event.Message(trip.Title)
+			if settings.BudgetInApp {
+				if err := s.repo.CreateNotification(&models.Notification{
+					UserID:  user.ID,
+					TripID:  &trip.ID,
+					Type:    models.NotificationBudgetAlert,
+					Title:   title,
+					Message: message,
+					Link:    fmt.Sprintf("/trips/%d", trip.ID),
+				}); err != nil {
+					log.Printf("🔔 Notification for user %d: %v", user.ID, err)
+				}
+			}
+		}
+
+		if settings.BudgetEmail && user.Email != "" {
+			emails = append(emails, user.Email)
+		}
+		if user.ID == trip.UserID && settings.BudgetChat {
+			postToChat = true
+		}
+	}
+
+	if len(emails) == 0 && !postToChat {
+		return
+	}
+	tripID, tripTitle := trip.ID, trip.Title
+	s.pending.Add(1)
+	go func() {
+		defer s.pending.Done()
+		for _, email := range emails {
+			for _, event := range events {
+				if err := s.mailer.Send(email, title, event.Message(tripTitle)); err != nil {
+					log.Printf("🔔 Budget alert email to %s: %v", email, err)
+				}
+			}
+		}
+		if postToChat {
+			s.postToTripRoom(tripID, events, tripTitle)
+		}
+	}()
+}
+
+// postToTripRoom - Uyarıları gezinin sohbet odasına sistem mesajı olarak yazar (oda yoksa oluşturulur)
+func (s *notificationService) postToTripRoom(tripID uint, events []BudgetAlertEvent, tripTitle string) {
+	room, err := s.chatRepo.GetRoomByName(models.TripRoomName(tripID))
+	if err != nil {
+		room = &models.ChatRoom{Name: models.TripRoomName(tripID), TripID: &tripID}
+		if err := s.chatRepo.CreateRoom(room); err != nil {
+			log.Printf("🔔 Chat room for trip %d: %v", tripID, err)
+			return
+		}
+	}
+
+	for _, event := range events {
+		message := &models.ChatMessage{RoomID: room.ID, UserID: models.SystemUserID, Message: event.Message(tripTitle)}
+		if err := s.chatRepo.CreateMessage(message); err != nil {
+			log.Printf("🔔 Chat alert for trip %d: %v", tripID, err)
+			continue
+		}
+		if s.broadcaster != nil {
+			s.broadcaster.Broadcast(room.ID, message.Message, models.SystemSenderName, models.SystemUserID)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/storage"
+)
+
+// MaxReceiptSize - Yüklenebilecek en büyük fiş dosyası (10 MB)
+const MaxReceiptSize = 10 << 20
+
+// ReceiptOrphanGrace - Bu süreden yeni dosyalar kaydı olmasa da silinmez; yükleme sürerken
+// dosya yazılmış ama kaydı henüz oluşturulmamış olabilir
+const ReceiptOrphanGrace = time.Hour
+
+// receiptPrefix - Fiş dosyalarının BlobStore'daki anahtar öneki
+const receiptPrefix = "receipts/"
+
+// receiptTypes - Kabul edilen dosya türleri (içerikten tespit edilir, istemcinin beyanına güvenilmez)
+var receiptTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+type ReceiptService interface {
+	UploadReceipt(receipt *models.Receipt, data []byte) error
+	GetReceipts(expenseID uint) ([]models.Receipt, error)
+	GetReceiptByID(id uint) (*models.Receipt, error)
+	OpenReceipt(receipt *models.Receipt) ([]byte, error)
+	DeleteReceipt(receipt *models.Receipt) error
+	PurgeOrphans(grace time.Duration) (int, error)
+}
+
+type receiptService struct {
+	repo  repository.ReceiptRepository
+	store storage.BlobStore
+}
+
+func NewReceiptService(repo repository.ReceiptRepository, store storage.BlobStore) ReceiptService {
+	return &receiptService{repo: repo, store: store}
+}
+
+// UploadReceipt - Dosyayı BlobStore'a yazar ve harcamaya bağlı kaydı oluşturur
+// receipt.ExpenseID, FileName ve UploadedByID dolu olmalı; türü ve boyutu burada belirlenir
+func (s *receiptService) UploadReceipt(receipt *models.Receipt, data []byte) error {
+	if receipt.ExpenseID == 0 {
+		return fmt.Errorf("receipt must belong to an expense")
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("receipt file is empty")
+	}
+	if len(data) > MaxReceiptSize {
+		return fmt.Errorf("receipt is larger than %d MB", MaxReceiptSize>>20)
+	}
+
+	contentType := DetectReceiptType(data)
+	if !receiptTypes[contentType] {
+		return fmt.Errorf("unsupported receipt type %q, upload an image or a PDF", contentType)
+	}
+
+	receipt.FileName = path.Base(strings.ReplaceAll(receipt.FileName, "\\", "/"))
+	if receipt.FileName == "." || receipt.FileName == "/" {
+		receipt.FileName = "receipt"
+	}
+	receipt.ContentType = contentType
+	receipt.Size = int64(len(data))
+
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+	receipt.StorageKey = fmt.Sprintf("%s%d/%s-%s", receiptPrefix, receipt.ExpenseID,
+		hex.EncodeToString(suffix), unsafeFileChars.ReplaceAllString(receipt.FileName, "_"))
+
+	if err := s.store.Put(receipt.StorageKey, data); err != nil {
+		return err
+	}
+	if err := s.repo.CreateReceipt(receipt); err != nil {
+		s.store.Delete(receipt.StorageKey)
+		return err
+	}
+	return nil
+}
+
+// DetectReceiptType - Dosya içeriğinden MIME türü (parametreler olmadan)
+func DetectReceiptType(data []byte) string {
+	contentType := http.DetectContentType(data)
+	if i := strings.Index(contentType, ";"); i >= 0 {
+		contentType = contentType[:i]
+	}
+	return contentType
+}
+
+func (s *receiptService) GetReceipts(expenseID uint) ([]models.Receipt, error) {
+	return s.repo.GetReceiptsByExpenseID(expenseID)
+}
+
+func (s *receiptService) GetReceiptByID(id uint) (*models.Receipt, error) {
+	return s.repo.GetReceiptByID(id)
+}
+
+func (s *receiptService) OpenReceipt(receipt *models.Receipt) ([]byte, error) {
+	return s.store.Get(receipt.StorageKey)
+}
+
+// DeleteReceipt - Önce kayıt silinir; dosya silinemezse receipt-cleanup işi sonra temizler
+func (s *receiptService) DeleteReceipt(receipt *models.Receipt) error {
+	if err := s.repo.DeleteReceipt(receipt.ID); err != nil {
+		return err
+	}
+	s.store.Delete(receipt.StorageKey)
+	return nil
+}
+
+// PurgeOrphans - Kaydı olmayan fiş dosyalarını siler (ör. kalıcı silinen gezilerin fişleri)
+// Kayıtlar dosyalardan önce okunur ve grace süresinden yeni dosyalara dokunulmaz;
+// böylece devam eden bir yüklemenin dosyası kaydı yazılmadan silinmez
+func (s *receiptService) PurgeOrphans(grace time.Duration) (int, error) {
+	known, err := s.repo.GetStorageKeys()
+	if err != nil {
+		return 0, err
+	}
+	referenced := make(map[string]bool, len(known))
+	for _, key := range known {
+		referenced[key] = true
+	}
+	keys, err := s.store.List(receiptPrefix)
+	if err != nil {
+		return 0, err
+	}
+
+	cutoff := time.Now().Add(-grace)
+	purged := 0
+	for _, key := range keys {
+		if referenced[key] {
+			continue
+		}
+		modified, err := s.store.ModTime(key)
+		if errors.Is(err, storage.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return purged, err
+		}
+		if modified.After(cutoff) {
+			continue
+		}
+		if err := s.store.Delete(key); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
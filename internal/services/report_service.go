@@ -0,0 +1,110 @@
+package services
+
+import (
+	"fmt"
+	"time"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/models"
+	"travel-platform/internal/report"
+)
+
+type ReportService interface {
+	TripReport(trip *models.Trip, from, to time.Time) (*report.ExpenseReport, error)
+	UserReport(userID uint, from, to time.Time, currencyCode string) (*report.ExpenseReport, error)
+}
+
+type reportService struct {
+	tripService     TripService
+	currencyService CurrencyService
+}
+
+func NewReportService(tripService TripService, currencyService CurrencyService) ReportService {
+	return &reportService{tripService: tripService, currencyService: currencyService}
+}
+
+// TripReport - Gezinin harcamaları, gezinin para biriminde
+// from/to sıfır değilse sadece o günler (dahil) rapora girer
+func (s *reportService) TripReport(trip *models.Trip, from, to time.Time) (*report.ExpenseReport, error) {
+	if err := validateReportRange(from, to); err != nil {
+		return nil, err
+	}
+
+	home := currency.Normalize(trip.Currency)
+	lines := []report.Line{}
+	for _, expense := range trip.Expenses {
+		if !inReportRange(expense.ExpenseDate, from, to) {
+			continue
+		}
+		lines = append(lines, reportLine(trip, expense, HomeAmount(expense)))
+	}
+
+	return report.New("Expense report: "+trip.Title, home, from, to, lines), nil
+}
+
+// UserReport - Kullanıcının tüm gezilerindeki harcamalar tek para biriminde
+// Her gezinin tutarı harcama tarihindeki kurla istenen para birimine çevrilir
+func (s *reportService) UserReport(userID uint, from, to time.Time, currencyCode string) (*report.ExpenseReport, error) {
+	if err := validateReportRange(from, to); err != nil {
+		return nil, err
+	}
+	target := currency.Normalize(currencyCode)
+	if !currency.IsValidCode(target) {
+		return nil, fmt.Errorf("invalid currency code %q", currencyCode)
+	}
+
+	trips, err := s.tripService.GetTripByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []report.Line{}
+	for i := range trips {
+		trip := &trips[i]
+		for _, expense := range trip.Expenses {
+			if !inReportRange(expense.ExpenseDate, from, to) {
+				continue
+			}
+			amount, _, err := s.currencyService.Convert(HomeAmount(expense), trip.Currency, target, expense.ExpenseDate)
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert %s expenses of %q to %s: %v", currency.Normalize(trip.Currency), trip.Title, target, err)
+			}
+			lines = append(lines, reportLine(trip, expense, amount))
+		}
+	}
+
+	return report.New("Expense report: all trips", target, from, to, lines), nil
+}
+
+func reportLine(trip *models.Trip, expense models.Expense, homeAmount float64) report.Line {
+	expenseCurrency := expense.Currency
+	if expenseCurrency == "" {
+		expenseCurrency = currency.Normalize(trip.Currency)
+	}
+	return report.Line{
+		Date:        expense.ExpenseDate,
+		Trip:        trip.Title,
+		Category:    expense.Category,
+		Description: expense.Description,
+		Amount:      expense.Amount,
+		Currency:    expenseCurrency,
+		HomeAmount:  currency.Round(homeAmount),
+	}
+}
+
+func validateReportRange(from, to time.Time) error {
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return fmt.Errorf("report end date must be on or after the start date")
+	}
+	return nil
+}
+
+// inReportRange - Gün bazında karşılaştırma; sıfır sınır açık uç demektir
+func inReportRange(date, from, to time.Time) bool {
+	if !from.IsZero() && daysBetween(from, date) < 0 {
+		return false
+	}
+	if !to.IsZero() && daysBetween(date, to) < 0 {
+		return false
+	}
+	return true
+}
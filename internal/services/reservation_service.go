@@ -0,0 +1,165 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+)
+
+type ReservationService interface {
+	GetReservations(tripID uint) ([]models.Reservation, error)
+	GetReservationByID(id uint) (*models.Reservation, error)
+	CreateReservation(trip *models.Trip, reservation *models.Reservation, actorID uint) error
+	UpdateReservation(trip *models.Trip, reservation *models.Reservation, actorID uint) error
+	DeleteReservation(trip *models.Trip, reservation *models.Reservation, actorID uint) error
+}
+
+type reservationService struct {
+	repo        repository.ReservationRepository
+	tripService TripService
+}
+
+func NewReservationService(repo repository.ReservationRepository, tripService TripService) ReservationService {
+	return &reservationService{repo: repo, tripService: tripService}
+}
+
+func (s *reservationService) GetReservations(tripID uint) ([]models.Reservation, error) {
+	return s.repo.GetReservationsByTripID(tripID)
+}
+
+func (s *reservationService) GetReservationByID(id uint) (*models.Reservation, error) {
+	return s.repo.GetReservationByID(id)
+}
+
+// CreateReservation - Rezervasyonu kaydeder; ücreti varsa geziye harcama olarak eklenir
+func (s *reservationService) CreateReservation(trip *models.Trip, reservation *models.Reservation, actorID uint) error {
+	reservation.TripID = trip.ID
+	if reservation.Currency == "" {
+		reservation.Currency = trip.Currency
+	}
+	if err := validateReservation(reservation); err != nil {
+		return err
+	}
+	if err := s.syncExpense(trip, reservation, actorID); err != nil {
+		return err
+	}
+	return s.repo.CreateReservation(reservation)
+}
+
+// UpdateReservation - Rezervasyonu ve bağlı harcamayı günceller (ücret 0 olursa harcama silinir)
+func (s *reservationService) UpdateReservation(trip *models.Trip, reservation *models.Reservation, actorID uint) error {
+	if reservation.Currency == "" {
+		reservation.Currency = trip.Currency
+	}
+	if err := validateReservation(reservation); err != nil {
+		return err
+	}
+	if err := s.syncExpense(trip, reservation, actorID); err != nil {
+		return err
+	}
+	return s.repo.UpdateReservation(reservation)
+}
+
+// DeleteReservation - Rezervasyonu bağlı harcamasıyla birlikte siler
+func (s *reservationService) DeleteReservation(trip *models.Trip, reservation *models.Reservation, actorID uint) error {
+	if expense := linkedExpense(trip, reservation); expense != nil {
+		if err := s.tripService.DeleteExpense(expense.ID, actorID); err != nil {
+			return err
+		}
+	}
+	return s.repo.DeleteReservation(reservation.ID)
+}
+
+// syncExpense - Bağlı harcamayı rezervasyonun ücretine göre oluşturur, günceller veya siler
+// Harcama ayrıca silinmişse (ör. revert ile) yenisi oluşturulur
+func (s *reservationService) syncExpense(trip *models.Trip, reservation *models.Reservation, actorID uint) error {
+	expense := linkedExpense(trip, reservation)
+
+	if reservation.Cost == 0 {
+		if expense != nil {
+			if err := s.tripService.DeleteExpense(expense.ID, actorID); err != nil {
+				return err
+			}
+		}
+		reservation.ExpenseID = nil
+		return nil
+	}
+
+	if expense == nil {
+		expense = &models.Expense{TripID: trip.ID}
+		fillReservationExpense(expense, reservation)
+		if err := s.tripService.AddExpense(expense, actorID); err != nil {
+			return err
+		}
+		reservation.ExpenseID = &expense.ID
+		return nil
+	}
+
+	fillReservationExpense(expense, reservation)
+	return s.tripService.UpdateExpense(expense, actorID)
+}
+
+func linkedExpense(trip *models.Trip, reservation *models.Reservation) *models.Expense {
+	if reservation.ExpenseID == nil {
+		return nil
+	}
+	for i := range trip.Expenses {
+		if trip.Expenses[i].ID == *reservation.ExpenseID {
+			return &trip.Expenses[i]
+		}
+	}
+	return nil
+}
+
+func fillReservationExpense(expense *models.Expense, reservation *models.Reservation) {
+	expense.Category = "transport"
+	if reservation.Type == models.ReservationLodging {
+		expense.Category = "accommodation"
+	}
+	expense.Amount = reservation.Cost
+	expense.Currency = reservation.Currency
+	expense.ExpenseDate = reservation.StartAt
+}
+
+// validateReservation - Türe göre zorunlu alanlar; havalimanı kodları büyük harfe çevrilir
+func validateReservation(reservation *models.Reservation) error {
+	if reservation.StartAt.IsZero() {
+		return fmt.Errorf("start_at is required")
+	}
+	if reservation.EndAt != nil && reservation.EndAt.Before(reservation.StartAt) {
+		return fmt.Errorf("end_at must be after start_at")
+	}
+	if reservation.Cost < 0 {
+		return fmt.Errorf("cost cannot be negative")
+	}
+	reservation.Currency = currency.Normalize(reservation.Currency)
+	if !currency.IsValidCode(reservation.Currency) {
+		return fmt.Errorf("invalid currency code %q", reservation.Currency)
+	}
+
+	switch reservation.Type {
+	case models.ReservationFlight:
+		reservation.DepartureAirport = strings.ToUpper(strings.TrimSpace(reservation.DepartureAirport))
+		reservation.ArrivalAirport = strings.ToUpper(strings.TrimSpace(reservation.ArrivalAirport))
+		if reservation.FlightNumber == "" || reservation.DepartureAirport == "" || reservation.ArrivalAirport == "" {
+			return fmt.Errorf("flights need flight_number, departure_airport and arrival_airport")
+		}
+	case models.ReservationLodging:
+		if reservation.Provider == "" || reservation.EndAt == nil {
+			return fmt.Errorf("lodging needs provider and end_at (check-out)")
+		}
+	case models.ReservationRail:
+		if reservation.Origin == "" || reservation.Destination == "" {
+			return fmt.Errorf("rail reservations need origin and destination")
+		}
+	case models.ReservationCar:
+		if reservation.Provider == "" || reservation.EndAt == nil {
+			return fmt.Errorf("car rentals need provider and end_at (drop-off)")
+		}
+	default:
+		return fmt.Errorf("unknown reservation type %q", reservation.Type)
+	}
+	return nil
+}
@@ -0,0 +1,338 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+)
+
+// splitTolerance - Kesin tutar ve yüzde toplamlarında kabul edilen yuvarlama farkı
+const splitTolerance = 0.01
+
+// Balance - Katılımcının gezideki net durumu (gezi para biriminde)
+// Net > 0 ise alacaklı, Net < 0 ise borçlu
+type Balance struct {
+	UserID   uint    `json:"user_id"`
+	Name     string  `json:"name"`
+	Paid     float64 `json:"paid"`     // Ödediği paylaşımlı harcamalar
+	Share    float64 `json:"share"`    // Paylaşımlı harcamalardaki kendi payı
+	Sent     float64 `json:"sent"`     // Borç kapatmak için yaptığı ödemeler
+	Received float64 `json:"received"` // Aldığı ödemeler
+	Net      float64 `json:"net"`
+}
+
+// Transfer - Borçları kapatmak için önerilen ödeme
+type Transfer struct {
+	FromUserID uint    `json:"from_user_id"`
+	FromName   string  `json:"from_name"`
+	ToUserID   uint    `json:"to_user_id"`
+	ToName     string  `json:"to_name"`
+	Amount     float64 `json:"amount"`
+}
+
+// TripBalances - Gezinin hesaplaşma özeti
+type TripBalances struct {
+	Currency     string     `json:"currency"`
+	Balances     []Balance  `json:"balances"`
+	Transfers    []Transfer `json:"transfers"`
+	UnsplitTotal float64    `json:"unsplit_total"` // Payı tanımlanmamış harcamaların toplamı
+}
+
+type SplitService interface {
+	SetSplit(trip *models.Trip, expense *models.Expense, payerID *uint, splitType string, splits []models.ExpenseSplit, actorID uint) error
+	GetBalances(trip *models.Trip) (*TripBalances, error)
+	GetSettlements(tripID uint) ([]models.Settlement, error)
+	GetSettlementByID(id uint) (*models.Settlement, error)
+	RecordSettlement(trip *models.Trip, settlement *models.Settlement, actorID uint) error
+	DeleteSettlement(settlement *models.Settlement) error
+}
+
+type splitService struct {
+	repo            repository.SettlementRepository
+	tripService     TripService
+	memberService   MemberService
+	currencyService CurrencyService
+}
+
+func NewSplitService(repo repository.SettlementRepository, tripService TripService, memberService MemberService, currencyService CurrencyService) SplitService {
+	return &splitService{repo: repo, tripService: tripService, memberService: memberService, currencyService: currencyService}
+}
+
+// SetSplit - Harcamanın ödeyenini ve paylarını belirler; splitType boşsa paylaşım kaldırılır
+// Eşit bölmede kişi verilmezse gezinin tüm katılımcıları kullanılır
+func (s *splitService) SetSplit(trip *models.Trip, expense *models.Expense, payerID *uint, splitType string, splits []models.ExpenseSplit, actorID uint) error {
+	if payerID != nil && !s.memberService.IsParticipant(trip, *payerID) {
+		return fmt.Errorf("payer %d is not on this trip", *payerID)
+	}
+
+	if splitType == models.SplitEqual && len(splits) == 0 {
+		participants, err := s.participants(trip)
+		if err != nil {
+			return err
+		}
+		for _, userID := range participants {
+			splits = append(splits, models.ExpenseSplit{UserID: userID})
+		}
+	}
+	for _, split := range splits {
+		if !s.memberService.IsParticipant(trip, split.UserID) {
+			return fmt.Errorf("user %d is not on this trip", split.UserID)
+		}
+	}
+
+	expense.PayerID = payerID
+	expense.SplitType = splitType
+	expense.Splits = splits
+	if splits == nil {
+		expense.Splits = []models.ExpenseSplit{}
+	}
+	return s.tripService.UpdateExpense(expense, actorID)
+}
+
+// participants - Gezinin sahibi ve üyeleri
+func (s *splitService) participants(trip *models.Trip) ([]uint, error) {
+	members, err := s.memberService.GetMembers(trip.ID)
+	if err != nil {
+		return nil, err
+	}
+	ids := []uint{trip.UserID}
+	for _, member := range members {
+		ids = append(ids, member.UserID)
+	}
+	return ids, nil
+}
+
+// GetBalances - Paylaşımlı harcamalar ve kayıtlı ödemelerden net durumları ve önerilen transferleri hesaplar
+// Paylar harcamanın kaydedildiği kurla, ödemeler ödeme tarihindeki kurla gezi para birimine çevrilir
+func (s *splitService) GetBalances(trip *models.Trip) (*TripBalances, error) {
+	result := &TripBalances{Currency: currency.Normalize(trip.Currency)}
+	balances := make(map[uint]*Balance)
+	names := make(map[uint]string)
+
+	names[trip.UserID] = trip.User.FirstName + " " + trip.User.LastName
+	balances[trip.UserID] = &Balance{UserID: trip.UserID}
+	members, err := s.memberService.GetMembers(trip.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		names[member.UserID] = member.User.FirstName + " " + member.User.LastName
+		balances[member.UserID] = &Balance{UserID: member.UserID}
+	}
+	balanceOf := func(userID uint) *Balance {
+		if balances[userID] == nil {
+			balances[userID] = &Balance{UserID: userID}
+		}
+		return balances[userID]
+	}
+
+	for _, expense := range trip.Expenses {
+		home := HomeAmount(expense)
+		if expense.SplitType == "" || expense.PayerID == nil || len(expense.Splits) == 0 {
+			result.UnsplitTotal += home
+			continue
+		}
+		balanceOf(*expense.PayerID).Paid += home
+		for _, split := range expense.Splits {
+			balanceOf(split.UserID).Share += home * split.Amount / expense.Amount
+		}
+	}
+
+	settlements, err := s.repo.GetSettlementsByTripID(trip.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, settlement := range settlements {
+		amount, _, err := s.currencyService.Convert(settlement.Amount, settlement.Currency, result.Currency, settlement.PaidAt)
+		if err != nil {
+			return nil, fmt.Errorf("settlement %d: %w", settlement.ID, err)
+		}
+		balanceOf(settlement.FromUserID).Sent += amount
+		balanceOf(settlement.ToUserID).Received += amount
+		if names[settlement.FromUserID] == "" {
+			names[settlement.FromUserID] = settlement.FromUser.FirstName + " " + settlement.FromUser.LastName
+		}
+		if names[settlement.ToUserID] == "" {
+			names[settlement.ToUserID] = settlement.ToUser.FirstName + " " + settlement.ToUser.LastName
+		}
+	}
+
+	for _, balance := range balances {
+		balance.Name = names[balance.UserID]
+		balance.Paid = currency.Round(balance.Paid)
+		balance.Share = currency.Round(balance.Share)
+		balance.Sent = currency.Round(balance.Sent)
+		balance.Received = currency.Round(balance.Received)
+		balance.Net = currency.Round(balance.Paid - balance.Share + balance.Sent - balance.Received)
+		result.Balances = append(result.Balances, *balance)
+	}
+	sort.Slice(result.Balances, func(i, j int) bool { return result.Balances[i].UserID < result.Balances[j].UserID })
+
+	result.UnsplitTotal = currency.Round(result.UnsplitTotal)
+	result.Transfers = SettleUp(result.Balances)
+	return result, nil
+}
+
+// SettleUp - Net durumları kapatan transfer listesi
+// Her adımda en çok borçlu en çok alacaklıya öder; n kişi için en fazla n-1 transfer üretir
+func SettleUp(balances []Balance) []Transfer {
+	type position struct {
+		userID uint
+		name   string
+		cents  int64
+	}
+	var debtors, creditors []position
+	for _, balance := range balances {
+		cents := int64(math.Round(balance.Net * 100))
+		switch {
+		case cents < 0:
+			debtors = append(debtors, position{balance.UserID, balance.Name, -cents})
+		case cents > 0:
+			creditors = append(creditors, position{balance.UserID, balance.Name, cents})
+		}
+	}
+
+	transfers := []Transfer{}
+	for len(debtors) > 0 && len(creditors) > 0 {
+		sort.SliceStable(debtors, func(i, j int) bool { return debtors[i].cents > debtors[j].cents })
+		sort.SliceStable(creditors, func(i, j int) bool { return creditors[i].cents > creditors[j].cents })
+
+		debtor, creditor := &debtors[0], &creditors[0]
+		amount := debtor.cents
+		if creditor.cents < amount {
+			amount = creditor.cents
+		}
+		transfers = append(transfers, Transfer{
+			FromUserID: debtor.userID,
+			FromName:   debtor.name,
+			ToUserID:   creditor.userID,
+			ToName:     creditor.name,
+			Amount:     float64(amount) / 100,
+		})
+
+		debtor.cents -= amount
+		creditor.cents -= amount
+		if debtor.cents == 0 {
+			debtors = debtors[1:]
+		}
+		if creditor.cents == 0 {
+			creditors = creditors[1:]
+		}
+	}
+	return transfers
+}
+
+func (s *splitService) GetSettlements(tripID uint) ([]models.Settlement, error) {
+	return s.repo.GetSettlementsByTripID(tripID)
+}
+
+func (s *splitService) GetSettlementByID(id uint) (*models.Settlement, error) {
+	return s.repo.GetSettlementByID(id)
+}
+
+// RecordSettlement - Katılımcılar arasında yapılan ödemeyi kaydeder (para birimi boşsa gezininki)
+func (s *splitService) RecordSettlement(trip *models.Trip, settlement *models.Settlement, actorID uint) error {
+	settlement.TripID = trip.ID
+	settlement.CreatedByID = actorID
+	if settlement.Currency == "" {
+		settlement.Currency = trip.Currency
+	}
+	settlement.Currency = currency.Normalize(settlement.Currency)
+	if settlement.PaidAt.IsZero() {
+		settlement.PaidAt = time.Now()
+	}
+
+	if settlement.Amount <= 0 {
+		return fmt.Errorf("settlement amount must be positive")
+	}
+	if settlement.FromUserID == settlement.ToUserID {
+		return fmt.Errorf("payer and recipient must be different people")
+	}
+	if !currency.IsValidCode(settlement.Currency) {
+		return fmt.Errorf("invalid currency code %q", settlement.Currency)
+	}
+	if !s.memberService.IsParticipant(trip, settlement.FromUserID) || !s.memberService.IsParticipant(trip, settlement.ToUserID) {
+		return fmt.Errorf("both people must be on this trip")
+	}
+	// Kur şimdi kontrol edilir ki bakiyeler sonradan hesaplanamaz hale gelmesin
+	if _, err := s.currencyService.Rate(settlement.Currency, trip.Currency, settlement.PaidAt); err != nil {
+		return fmt.Errorf("no exchange rate from %s to %s: %w", settlement.Currency, trip.Currency, err)
+	}
+	return s.repo.CreateSettlement(settlement)
+}
+
+func (s *splitService) DeleteSettlement(settlement *models.Settlement) error {
+	return s.repo.DeleteSettlement(settlement.ID)
+}
+
+// ComputeSplit - Harcama tutarını bölme türüne göre paylara dağıtır ve her payın Amount'unu yazar
+// Kuruş yuvarlamasından kalan fark ilk kişiye eklenir, böylece paylar toplamı tutara eşit olur
+func ComputeSplit(amount float64, splitType string, splits []models.ExpenseSplit) ([]models.ExpenseSplit, error) {
+	if len(splits) == 0 {
+		return nil, fmt.Errorf("a split needs at least one person")
+	}
+	seen := make(map[uint]bool)
+	total := 0.0
+	for _, split := range splits {
+		if split.UserID == 0 {
+			return nil, fmt.Errorf("every split needs a user")
+		}
+		if seen[split.UserID] {
+			return nil, fmt.Errorf("user %d appears more than once in the split", split.UserID)
+		}
+		seen[split.UserID] = true
+		if split.Value < 0 {
+			return nil, fmt.Errorf("split values cannot be negative")
+		}
+		total += split.Value
+	}
+
+	raw := make([]float64, len(splits))
+	switch splitType {
+	case models.SplitEqual:
+		for i := range splits {
+			raw[i] = amount / float64(len(splits))
+		}
+	case models.SplitShares:
+		for _, split := range splits {
+			if split.Value <= 0 {
+				return nil, fmt.Errorf("shares must be positive")
+			}
+		}
+		for i, split := range splits {
+			raw[i] = amount * split.Value / total
+		}
+	case models.SplitExact:
+		if math.Abs(total-amount) > splitTolerance {
+			return nil, fmt.Errorf("exact amounts add up to %.2f, expected %.2f", total, amount)
+		}
+		for i, split := range splits {
+			raw[i] = split.Value
+		}
+	case models.SplitPercentage:
+		if math.Abs(total-100) > splitTolerance {
+			return nil, fmt.Errorf("percentages add up to %.2f, expected 100", total)
+		}
+		for i, split := range splits {
+			raw[i] = amount * split.Value / 100
+		}
+	default:
+		return nil, fmt.Errorf("invalid split type %q", splitType)
+	}
+
+	result := make([]models.ExpenseSplit, len(splits))
+	allocated := int64(0)
+	for i, split := range splits {
+		result[i] = split
+		cents := int64(math.Floor(raw[i]*100 + 1e-6))
+		result[i].Amount = float64(cents) / 100
+		allocated += cents
+	}
+	remainder := int64(math.Round(amount*100)) - allocated
+	result[0].Amount = float64(int64(math.Round(result[0].Amount*100))+remainder) / 100
+	return result, nil
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"time"
+	"travel-platform/internal/models"
+)
+
+// record - Audit kaydını yazar; kayıt hatası asıl işlemi geri almaz, sadece loglanır
+func (s *tripService) record(actorID uint, action, entityType string, entityID uint, changes []models.FieldChange, state *models.Trip) {
+	entry := &models.TripAuditEntry{
+		TripID:     state.ID,
+		ActorID:    actorID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Changes:    changes,
+		Snapshot:   models.NewTripSnapshot(state),
+	}
+	if err := s.audit.CreateEntry(entry); err != nil {
+		log.Printf("Audit entry for trip %d could not be saved: %v", state.ID, err)
+	}
+}
+
+// recordCurrent - Snapshot için gezinin güncel halini yükleyip kaydı yazar
+func (s *tripService) recordCurrent(actorID uint, action, entityType string, entityID uint, changes []models.FieldChange, tripID uint) {
+	state, err := s.repo.GetTripByID(tripID)
+	if err != nil {
+		log.Printf("Audit entry for trip %d could not be saved: %v", tripID, err)
+		return
+	}
+	s.record(actorID, action, entityType, entityID, changes, state)
+}
+
+// Audit'te izlenen alanlar (JSON isimleriyle)
+func tripFields(t *models.Trip) map[string]interface{} {
+	return map[string]interface{}{
+		"title":       t.Title,
+		"destination": t.Destination,
+		"latitude":    auditValue(t.Latitude),
+		"longitude":   auditValue(t.Longitude),
+		"start_date":  auditValue(t.StartDate),
+		"end_date":    auditValue(t.EndDate),
+		"description": t.Description,
+		"budget":      t.Budget,
+		"currency":    t.Currency,
+		"is_public":   t.IsPublic,
+		"status":      t.Status,
+	}
+}
+
+func activityFields(a *models.Activity) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        a.Name,
+		"description": a.Description,
+		"location":    a.Location,
+		"latitude":    auditValue(a.Latitude),
+		"longitude":   auditValue(a.Longitude),
+		"date":        auditValue(a.Date),
+	}
+}
+
+func expenseFields(e *models.Expense) map[string]interface{} {
+	return map[string]interface{}{
+		"category":     e.Category,
+		"amount":       e.Amount,
+		"currency":     e.Currency,
+		"expense_date": auditValue(e.ExpenseDate),
+		"description":  e.Description,
+		"payer_id":     auditValue(e.PayerID),
+		"split_type":   e.SplitType,
+		"splits":       splitSummary(e.Splits),
+	}
+}
+
+// splitSummary - Payları "kullanıcı:tutar" listesi olarak özetler (kayıt ID'leri farkı etkilemesin)
+func splitSummary(splits []models.ExpenseSplit) interface{} {
+	if len(splits) == 0 {
+		return nil
+	}
+	summary := make([]string, 0, len(splits))
+	for _, split := range splits {
+		summary = append(summary, fmt.Sprintf("%d:%.2f", split.UserID, split.Amount))
+	}
+	sort.Strings(summary)
+	return summary
+}
+
+// auditValue - Pointer ve tarihleri karşılaştırılabilir, JSON'da okunur değerlere çevirir
+func auditValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case *float64:
+		if value == nil {
+			return nil
+		}
+		return *value
+	case *uint:
+		if value == nil {
+			return nil
+		}
+		return *value
+	case time.Time:
+		return value.UTC().Format(time.RFC3339)
+	}
+	return v
+}
+
+// diffFields - İki alan kümesi arasındaki farklar, alan adına göre sıralı
+// before nil ise oluşturma, after nil ise silme kabul edilir
+func diffFields(before, after map[string]interface{}) []models.FieldChange {
+	keys := make(map[string]bool)
+	for k := range before {
+		keys[k] = true
+	}
+	for k := range after {
+		keys[k] = true
+	}
+
+	names := make([]string, 0, len(keys))
+	for k := range keys {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	changes := []models.FieldChange{}
+	for _, name := range names {
+		oldValue, newValue := before[name], after[name]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, models.FieldChange{Field: name, Old: oldValue, New: newValue})
+	}
+	return changes
+}
+
+// diffTrip - Gezinin tamamı için fark (revert); alt kayıt alanları "activities[ID].alan" şeklindedir
+func diffTrip(before, after *models.Trip) []models.FieldChange {
+	changes := diffFields(tripFields(before), tripFields(after))
+
+	beforeActivities := make(map[string]interface{})
+	afterActivities := make(map[string]interface{})
+	for i := range before.Activities {
+		addPrefixed(beforeActivities, fmt.Sprintf("activities[%d]", before.Activities[i].ID), activityFields(&before.Activities[i]))
+	}
+	for i := range after.Activities {
+		addPrefixed(afterActivities, fmt.Sprintf("activities[%d]", after.Activities[i].ID), activityFields(&after.Activities[i]))
+	}
+	changes = append(changes, diffFields(beforeActivities, afterActivities)...)
+
+	beforeExpenses := make(map[string]interface{})
+	afterExpenses := make(map[string]interface{})
+	for i := range before.Expenses {
+		addPrefixed(beforeExpenses, fmt.Sprintf("expenses[%d]", before.Expenses[i].ID), expenseFields(&before.Expenses[i]))
+	}
+	for i := range after.Expenses {
+		addPrefixed(afterExpenses, fmt.Sprintf("expenses[%d]", after.Expenses[i].ID), expenseFields(&after.Expenses[i]))
+	}
+	return append(changes, diffFields(beforeExpenses, afterExpenses)...)
+}
+
+func addPrefixed(target map[string]interface{}, prefix string, fields map[string]interface{}) {
+	for k, v := range fields {
+		target[prefix+"."+k] = v
+	}
+}
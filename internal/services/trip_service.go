@@ -2,6 +2,8 @@ package services
 
 import (
 	"fmt"
+	"time"
+	"travel-platform/internal/currency"
 	"travel-platform/internal/models"
 	"travel-platform/internal/repository"
 )
@@ -10,31 +12,80 @@ type TripService interface {
 	CreateTrip(trip *models.Trip) error
 	GetTripByID(id uint) (*models.Trip, error) //iki değer döndürür//bulunan trip//hata
 	GetTripByUserID(userID uint) ([]models.Trip, error)
-	UpdateTrip(trip *models.Trip) error
-	DeleteTrip(id uint) error
+	UpdateTrip(trip *models.Trip, actorID uint) error
+	DeleteTrip(id uint, actorID uint) error
 	GetPublicTrips() ([]models.Trip, error)
 	SearchByDestination(destination string) ([]models.Trip, error)
+	AddActivity(activity *models.Activity, actorID uint) error
+	UpdateActivity(activity *models.Activity, actorID uint) error
+	DeleteActivity(id uint, actorID uint) error
+	AddExpense(expense *models.Expense, actorID uint) error
+	UpdateExpense(expense *models.Expense, actorID uint) error
+	DeleteExpense(id uint, actorID uint) error
+	GetTrash(userID uint) ([]models.Trip, error)
+	GetDeletedTripByID(id uint) (*models.Trip, error)
+	RestoreTrip(id uint, actorID uint) error
+	PurgeTrip(id uint) error
+	PurgeExpiredTrash(retention time.Duration) (int, error)
+	GetTripsByStatus(userID uint, statuses []string) ([]models.Trip, error)
+	ChangeTripStatus(tripID uint, status string, actorID uint) (*models.Trip, error)
+	AdvanceTripStatuses(now time.Time) (int, error)
+	GetTripHistory(tripID uint) ([]models.TripAuditEntry, error)
+	RevertTrip(tripID, entryID, actorID uint) (*models.Trip, error)
 }
 
+// TrashRetention - Silinen geziler bu süre sonunda kalıcı olarak temizlenir
+const TrashRetention = 30 * 24 * time.Hour
+
 type tripService struct { // sadece ayni paket icinden erisilebilir
-	repo repository.TripRepository
+	repo  repository.TripRepository
+	audit repository.AuditRepository
+	rates currency.RateProvider // Harcamaları gezinin para birimine çevirmek için
 }
 
 // TripService dönüs tipi *tripService döndürüyor
 // Ama TripService interface’i olarak
-func NewTripService(repo repository.TripRepository) TripService { // constructor
-	return &tripService{repo: repo} //& → pointer döndürür
+func NewTripService(repo repository.TripRepository, audit repository.AuditRepository, rates currency.RateProvider) TripService { // constructor
+	return &tripService{repo: repo, audit: audit, rates: rates} //& → pointer döndürür
 }
 
-func (s *tripService) CreateTrip(trip *models.Trip) error {
-	// Validation
+// ValidateTrip - Gezi kaydedilmeden önceki ortak kontroller (create ve import)
+func ValidateTrip(trip *models.Trip) error {
 	if trip.Title == "" || trip.Destination == "" {
 		return fmt.Errorf("title and destination are required")
 	}
 	if trip.StartDate.After(trip.EndDate) {
 		return fmt.Errorf("start date must be before end date")
 	}
-	return s.repo.CreateTrip(trip)
+	trip.Currency = currency.Normalize(trip.Currency)
+	if !currency.IsValidCode(trip.Currency) {
+		return fmt.Errorf("invalid currency code %q", trip.Currency)
+	}
+	return nil
+}
+
+func (s *tripService) CreateTrip(trip *models.Trip) error {
+	// Validation
+	if err := ValidateTrip(trip); err != nil {
+		return err
+	}
+	if trip.Status == "" {
+		trip.Status = StatusForDates(trip.StartDate, trip.EndDate, time.Now())
+	} else if !IsValidTripStatus(trip.Status) {
+		return fmt.Errorf("unknown trip status %q", trip.Status)
+	}
+	for i := range trip.Expenses {
+		if err := s.captureRate(&trip.Expenses[i], trip.Currency); err != nil {
+			return err
+		}
+	}
+	if err := s.repo.CreateTrip(trip); err != nil {
+		return err
+	}
+
+	// Geziyi oluşturan kullanıcı sahibidir
+	s.record(trip.UserID, models.AuditCreate, models.AuditEntityTrip, trip.ID, diffFields(nil, tripFields(trip)), trip)
+	return nil
 }
 
 func (s *tripService) GetTripByID(id uint) (*models.Trip, error) {
@@ -45,12 +96,64 @@ func (s *tripService) GetTripByUserID(userID uint) ([]models.Trip, error) {
 	return s.repo.GetTripByUserID(userID)
 }
 
-func (s *tripService) UpdateTrip(trip *models.Trip) error {
-	return s.repo.UpdateTrip(trip)
+func (s *tripService) UpdateTrip(trip *models.Trip, actorID uint) error {
+	before, err := s.repo.GetTripByID(trip.ID)
+	if err != nil {
+		return err
+	}
+	if trip.Status == "" {
+		trip.Status = before.Status
+	} else if trip.Status != before.Status {
+		if err := checkTransition(before.Status, trip.Status); err != nil {
+			return err
+		}
+	}
+	if trip.Currency == "" {
+		trip.Currency = before.Currency
+	}
+	trip.Currency = currency.Normalize(trip.Currency)
+	if !currency.IsValidCode(trip.Currency) {
+		return fmt.Errorf("invalid currency code %q", trip.Currency)
+	}
+
+	// Para birimi değiştiyse harcamalar kendi tarihlerindeki kurla yeniden çevrilir
+	currencyChanged := trip.Currency != before.Currency
+	if currencyChanged {
+		for i := range trip.Expenses {
+			if err := s.captureRate(&trip.Expenses[i], trip.Currency); err != nil {
+				return err
+			}
+		}
+	}
+	if err := s.repo.UpdateTrip(trip); err != nil {
+		return err
+	}
+	if currencyChanged {
+		for i := range trip.Expenses {
+			if err := s.repo.UpdateExpense(&trip.Expenses[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	if changes := diffFields(tripFields(before), tripFields(trip)); len(changes) > 0 {
+		s.recordCurrent(actorID, models.AuditUpdate, models.AuditEntityTrip, trip.ID, changes, trip.ID)
+	}
+	return nil
 }
 
-func (s *tripService) DeleteTrip(id uint) error {
-	return s.repo.DeleteTrip(id)
+func (s *tripService) DeleteTrip(id uint, actorID uint) error {
+	before, err := s.repo.GetTripByID(id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteTrip(id); err != nil {
+		return err
+	}
+
+	// Silinen gezinin son hali saklanır
+	s.record(actorID, models.AuditDelete, models.AuditEntityTrip, id, diffFields(tripFields(before), nil), before)
+	return nil
 }
 
 func (s *tripService) GetPublicTrips() ([]models.Trip, error) {
@@ -60,3 +163,228 @@ func (s *tripService) GetPublicTrips() ([]models.Trip, error) {
 func (s *tripService) SearchByDestination(destination string) ([]models.Trip, error) {
 	return s.repo.GetByDestination(destination)
 }
+
+func (s *tripService) AddActivity(activity *models.Activity, actorID uint) error {
+	if err := validateActivity(activity); err != nil {
+		return err
+	}
+	if err := s.repo.CreateActivity(activity); err != nil {
+		return err
+	}
+
+	s.recordCurrent(actorID, models.AuditCreate, models.AuditEntityActivity, activity.ID,
+		diffFields(nil, activityFields(activity)), activity.TripID)
+	return nil
+}
+
+func (s *tripService) UpdateActivity(activity *models.Activity, actorID uint) error {
+	if err := validateActivity(activity); err != nil {
+		return err
+	}
+	before, err := s.repo.GetActivityByID(activity.ID)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.UpdateActivity(activity); err != nil {
+		return err
+	}
+
+	if changes := diffFields(activityFields(before), activityFields(activity)); len(changes) > 0 {
+		s.recordCurrent(actorID, models.AuditUpdate, models.AuditEntityActivity, activity.ID, changes, activity.TripID)
+	}
+	return nil
+}
+
+func (s *tripService) DeleteActivity(id uint, actorID uint) error {
+	before, err := s.repo.GetActivityByID(id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteActivity(id); err != nil {
+		return err
+	}
+
+	s.recordCurrent(actorID, models.AuditDelete, models.AuditEntityActivity, id,
+		diffFields(activityFields(before), nil), before.TripID)
+	return nil
+}
+
+func (s *tripService) AddExpense(expense *models.Expense, actorID uint) error {
+	if err := validateExpense(expense); err != nil {
+		return err
+	}
+	if err := s.captureTripRate(expense); err != nil {
+		return err
+	}
+	if err := s.repo.CreateExpense(expense); err != nil {
+		return err
+	}
+
+	s.recordCurrent(actorID, models.AuditCreate, models.AuditEntityExpense, expense.ID,
+		diffFields(nil, expenseFields(expense)), expense.TripID)
+	return nil
+}
+
+func (s *tripService) UpdateExpense(expense *models.Expense, actorID uint) error {
+	before, err := s.repo.GetExpenseByID(expense.ID)
+	if err != nil {
+		return err
+	}
+	// Paylar verilmemişse kayıtlı paylar yeni tutara göre yeniden hesaplanır
+	if expense.Splits == nil && expense.SplitType != "" {
+		expense.Splits = append([]models.ExpenseSplit{}, before.Splits...)
+	}
+	if err := validateExpense(expense); err != nil {
+		return err
+	}
+	if err := s.captureTripRate(expense); err != nil {
+		return err
+	}
+	if err := s.repo.UpdateExpense(expense); err != nil {
+		return err
+	}
+
+	if changes := diffFields(expenseFields(before), expenseFields(expense)); len(changes) > 0 {
+		s.recordCurrent(actorID, models.AuditUpdate, models.AuditEntityExpense, expense.ID, changes, expense.TripID)
+	}
+	return nil
+}
+
+func (s *tripService) DeleteExpense(id uint, actorID uint) error {
+	before, err := s.repo.GetExpenseByID(id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteExpense(id); err != nil {
+		return err
+	}
+
+	s.recordCurrent(actorID, models.AuditDelete, models.AuditEntityExpense, id,
+		diffFields(expenseFields(before), nil), before.TripID)
+	return nil
+}
+
+func validateActivity(activity *models.Activity) error {
+	if activity.Name == "" {
+		return fmt.Errorf("activity name is required")
+	}
+	return nil
+}
+
+func validateExpense(expense *models.Expense) error {
+	if expense.Category == "" || expense.Amount <= 0 {
+		return fmt.Errorf("category and a positive amount are required")
+	}
+	// Para birimi boşsa captureRate gezinin para birimini kullanır
+	if expense.Currency != "" {
+		expense.Currency = currency.Normalize(expense.Currency)
+		if !currency.IsValidCode(expense.Currency) {
+			return fmt.Errorf("invalid currency code %q", expense.Currency)
+		}
+	}
+	if expense.SplitType == "" {
+		if len(expense.Splits) > 0 {
+			expense.Splits = []models.ExpenseSplit{}
+		}
+		return nil
+	}
+	if expense.PayerID == nil {
+		return fmt.Errorf("a split expense needs a payer")
+	}
+	splits, err := ComputeSplit(expense.Amount, expense.SplitType, expense.Splits)
+	if err != nil {
+		return err
+	}
+	expense.Splits = splits
+	return nil
+}
+
+// captureTripRate - Harcamanın ait olduğu gezinin para birimini bulup kuru yakalar
+func (s *tripService) captureTripRate(expense *models.Expense) error {
+	trip, err := s.repo.GetTripByID(expense.TripID)
+	if err != nil {
+		return err
+	}
+	return s.captureRate(expense, trip.Currency)
+}
+
+// captureRate - Harcama tarihindeki kuru ve gezi para birimindeki tutarı harcamaya yazar
+// Kur kaydedildiği için sonradan değişen kurlar geçmiş toplamları etkilemez
+func (s *tripService) captureRate(expense *models.Expense, tripCurrency string) error {
+	tripCurrency = currency.Normalize(tripCurrency)
+	if expense.Currency == "" {
+		expense.Currency = tripCurrency
+	}
+	expense.Currency = currency.Normalize(expense.Currency)
+	home, rate, err := currency.Convert(s.rates, expense.Amount, expense.Currency, tripCurrency, expense.ExpenseDate)
+	if err != nil {
+		return fmt.Errorf("no exchange rate from %s to %s: %w", expense.Currency, tripCurrency, err)
+	}
+	expense.ExchangeRate, expense.HomeAmount = rate, home
+	return nil
+}
+
+func (s *tripService) GetTrash(userID uint) ([]models.Trip, error) {
+	return s.repo.GetDeletedTripsByUserID(userID)
+}
+
+func (s *tripService) GetDeletedTripByID(id uint) (*models.Trip, error) {
+	return s.repo.GetDeletedTripByID(id)
+}
+
+func (s *tripService) RestoreTrip(id uint, actorID uint) error {
+	if err := s.repo.RestoreTrip(id); err != nil {
+		return err
+	}
+
+	s.recordCurrent(actorID, models.AuditRestore, models.AuditEntityTrip, id, []models.FieldChange{}, id)
+	return nil
+}
+
+func (s *tripService) PurgeTrip(id uint) error {
+	return s.repo.PurgeTrip(id)
+}
+
+// PurgeExpiredTrash - Çöp kutusunda retention süresinden uzun kalan gezileri siler (zamanlanmış iş)
+func (s *tripService) PurgeExpiredTrash(retention time.Duration) (int, error) {
+	return s.repo.PurgeDeletedBefore(time.Now().Add(-retention))
+}
+
+func (s *tripService) GetTripHistory(tripID uint) ([]models.TripAuditEntry, error) {
+	return s.audit.GetEntriesByTripID(tripID)
+}
+
+// RevertTrip - Geziyi geçmişteki bir kaydın ardından olduğu hale döndürür
+// Geri alma da yeni bir kayıt olarak geçmişe eklenir, böylece geri alınabilir
+func (s *tripService) RevertTrip(tripID, entryID, actorID uint) (*models.Trip, error) {
+	entry, err := s.audit.GetEntryByID(entryID)
+	if err != nil {
+		return nil, err
+	}
+	if entry.TripID != tripID {
+		return nil, fmt.Errorf("history entry %d does not belong to trip %d", entryID, tripID)
+	}
+
+	before, err := s.repo.GetTripByID(tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Durum geçmişten geri alınmaz, sadece içerik
+	reverted := &models.Trip{ID: before.ID, UserID: before.UserID, Status: before.Status, CreatedAt: before.CreatedAt,
+		Destination: before.Destination, DestinationID: before.DestinationID}
+	entry.Snapshot.ApplyTo(reverted)
+	if err := ValidateTrip(reverted); err != nil {
+		return nil, err
+	}
+	if err := s.repo.ReplaceTripState(reverted); err != nil {
+		return nil, err
+	}
+
+	after, err := s.repo.GetTripByID(tripID)
+	if err != nil {
+		return nil, err
+	}
+	s.record(actorID, models.AuditRevert, models.AuditEntityTrip, tripID, diffTrip(before, after), after)
+	return after, nil
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"fmt"
+	"time"
+	"travel-platform/internal/models"
+)
+
+// allowedTransitions - Durumlar arası izin verilen geçişler
+// Tamamlanan gezi son durumdur; iptal edilen gezi yeniden planlanabilir
+var allowedTransitions = map[string][]string{
+	models.TripStatusUpcoming:   {models.TripStatusInProgress, models.TripStatusCancelled},
+	models.TripStatusInProgress: {models.TripStatusCompleted, models.TripStatusCancelled},
+	models.TripStatusCancelled:  {models.TripStatusUpcoming},
+	models.TripStatusCompleted:  {},
+}
+
+// IsValidTripStatus - Bilinen bir durum mu
+func IsValidTripStatus(status string) bool {
+	_, ok := allowedTransitions[status]
+	return ok
+}
+
+// CanTransition - from durumundan to durumuna geçilebilir mi
+func CanTransition(from, to string) bool {
+	for _, next := range allowedTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusForDates - Tarihlere göre olması gereken durum; bitiş günü boyunca gezi devam ediyor sayılır
+func StatusForDates(start, end, now time.Time) string {
+	endOfTrip := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location()).AddDate(0, 0, 1)
+	switch {
+	case !now.Before(endOfTrip):
+		return models.TripStatusCompleted
+	case !now.Before(start):
+		return models.TripStatusInProgress
+	default:
+		return models.TripStatusUpcoming
+	}
+}
+
+func (s *tripService) GetTripsByStatus(userID uint, statuses []string) ([]models.Trip, error) {
+	for _, status := range statuses {
+		if !IsValidTripStatus(status) {
+			return nil, fmt.Errorf("unknown trip status %q", status)
+		}
+	}
+	return s.repo.GetTripsByUserIDAndStatus(userID, statuses)
+}
+
+// ChangeTripStatus - Kullanıcının elle yaptığı durum değişikliği (örn. iptal)
+func (s *tripService) ChangeTripStatus(tripID uint, status string, actorID uint) (*models.Trip, error) {
+	trip, err := s.repo.GetTripByID(tripID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTransition(trip.Status, status); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateTripStatus(tripID, status); err != nil {
+		return nil, err
+	}
+
+	changes := []models.FieldChange{{Field: "status", Old: trip.Status, New: status}}
+	trip.Status = status
+	s.record(actorID, models.AuditUpdate, models.AuditEntityTrip, tripID, changes, trip)
+	return trip, nil
+}
+
+// AdvanceTripStatuses - Başlayan gezileri in_progress, biten gezileri completed yapar (zamanlanmış iş)
+// Kaçırılan çalıştırmalarda upcoming -> completed da olabilir; iptal edilen geziler değişmez
+// Geçişler geçmişe actor 0 (sistem) ile yazılır
+func (s *tripService) AdvanceTripStatuses(now time.Time) (int, error) {
+	trips, err := s.repo.GetTripsByStatus([]string{models.TripStatusUpcoming, models.TripStatusInProgress})
+	if err != nil {
+		return 0, err
+	}
+
+	changed := 0
+	for _, trip := range trips {
+		status := StatusForDates(trip.StartDate, trip.EndDate, now)
+		// Tarihler ileri alınmış olsa bile otomatik geçiş geriye gitmez
+		if status == trip.Status || status == models.TripStatusUpcoming {
+			continue
+		}
+
+		if err := s.repo.UpdateTripStatus(trip.ID, status); err != nil {
+			return changed, err
+		}
+		changed++
+
+		changes := []models.FieldChange{{Field: "status", Old: trip.Status, New: status}}
+		s.recordCurrent(0, models.AuditUpdate, models.AuditEntityTrip, trip.ID, changes, trip.ID)
+	}
+
+	return changed, nil
+}
+
+func checkTransition(from, to string) error {
+	if !IsValidTripStatus(to) {
+		return fmt.Errorf("unknown trip status %q", to)
+	}
+	if !CanTransition(from, to) {
+		return fmt.Errorf("trip status cannot change from %s to %s", from, to)
+	}
+	return nil
+}
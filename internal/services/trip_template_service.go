@@ -0,0 +1,195 @@
+package services
+
+import (
+	"fmt"
+	"time"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+)
+
+type TripTemplateService interface {
+	DuplicateTrip(trip *models.Trip, userID uint, startDate time.Time, title string) (*models.Trip, error)
+	CloneTrip(trip *models.Trip, userID uint, startDate time.Time) (*models.Trip, error)
+	PublishTemplate(trip *models.Trip, title string, isPublic bool) (*models.TripTemplate, error)
+	GetTemplates(userID uint) ([]models.TripTemplate, error)
+	GetTemplateByID(id uint) (*models.TripTemplate, error)
+	CreateTripFromTemplate(template *models.TripTemplate, userID uint, startDate time.Time) (*models.Trip, error)
+	DeleteTemplate(id uint) error
+}
+
+type tripTemplateService struct {
+	repo        repository.TripTemplateRepository
+	tripService TripService
+}
+
+func NewTripTemplateService(repo repository.TripTemplateRepository, tripService TripService) TripTemplateService {
+	return &tripTemplateService{repo: repo, tripService: tripService}
+}
+
+// DuplicateTrip - Geziyi aktivite ve harcamalarıyla kopyalar, tüm tarihler yeni başlangıca kaydırılır
+func (s *tripTemplateService) DuplicateTrip(trip *models.Trip, userID uint, startDate time.Time, title string) (*models.Trip, error) {
+	if title == "" {
+		title = trip.Title + " (copy)"
+	}
+
+	duplicate := shiftedCopy(trip, userID, startDate, true)
+	duplicate.Title = title
+	duplicate.IsPublic = trip.IsPublic
+
+	if err := s.tripService.CreateTrip(duplicate); err != nil {
+		return nil, err
+	}
+	return duplicate, nil
+}
+
+// CloneTrip - Başka bir kullanıcının gezisini harcamaları olmadan kullanıcının hesabına kopyalar
+// Kopya gizli başlar, kullanıcı isterse paylaşır
+func (s *tripTemplateService) CloneTrip(trip *models.Trip, userID uint, startDate time.Time) (*models.Trip, error) {
+	if !trip.IsPublic && trip.UserID != userID {
+		return nil, fmt.Errorf("only public trips can be cloned")
+	}
+
+	clone := shiftedCopy(trip, userID, startDate, false)
+	clone.IsPublic = false
+
+	if err := s.tripService.CreateTrip(clone); err != nil {
+		return nil, err
+	}
+	return clone, nil
+}
+
+// PublishTemplate - Geziden şablon oluşturur; aktivite tarihleri gün ofsetine çevrilir
+func (s *tripTemplateService) PublishTemplate(trip *models.Trip, title string, isPublic bool) (*models.TripTemplate, error) {
+	if title == "" {
+		title = trip.Title
+	}
+
+	template := &models.TripTemplate{
+		UserID:       trip.UserID,
+		SourceTripID: trip.ID,
+		Title:        title,
+		Destination:  trip.Destination,
+		Latitude:     trip.Latitude,
+		Longitude:    trip.Longitude,
+		Description:  trip.Description,
+		DurationDays: daysBetween(trip.StartDate, trip.EndDate),
+		Budget:       trip.Budget,
+		Currency:     trip.Currency,
+		IsPublic:     isPublic,
+	}
+
+	for _, a := range trip.Activities {
+		template.Activities = append(template.Activities, models.TemplateActivity{
+			DayOffset:   daysBetween(trip.StartDate, a.Date),
+			Name:        a.Name,
+			Description: a.Description,
+			Location:    a.Location,
+			Latitude:    a.Latitude,
+			Longitude:   a.Longitude,
+		})
+	}
+
+	if err := s.repo.CreateTemplate(template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func (s *tripTemplateService) GetTemplates(userID uint) ([]models.TripTemplate, error) {
+	return s.repo.GetVisibleTemplates(userID)
+}
+
+func (s *tripTemplateService) GetTemplateByID(id uint) (*models.TripTemplate, error) {
+	return s.repo.GetTemplateByID(id)
+}
+
+// CreateTripFromTemplate - Şablondan verilen tarihte başlayan yeni bir gezi oluşturur
+func (s *tripTemplateService) CreateTripFromTemplate(template *models.TripTemplate, userID uint, startDate time.Time) (*models.Trip, error) {
+	if !template.IsPublic && template.UserID != userID {
+		return nil, fmt.Errorf("template is not available")
+	}
+
+	trip := &models.Trip{
+		UserID:      userID,
+		Title:       template.Title,
+		Destination: template.Destination,
+		Latitude:    template.Latitude,
+		Longitude:   template.Longitude,
+		StartDate:   startDate,
+		EndDate:     startDate.AddDate(0, 0, template.DurationDays),
+		Description: template.Description,
+		Budget:      template.Budget,
+		Currency:    template.Currency,
+		IsPublic:    false,
+	}
+
+	for _, a := range template.Activities {
+		trip.Activities = append(trip.Activities, models.Activity{
+			Name:        a.Name,
+			Description: a.Description,
+			Location:    a.Location,
+			Latitude:    a.Latitude,
+			Longitude:   a.Longitude,
+			Date:        startDate.AddDate(0, 0, a.DayOffset),
+		})
+	}
+
+	if err := s.tripService.CreateTrip(trip); err != nil {
+		return nil, err
+	}
+	return trip, nil
+}
+
+func (s *tripTemplateService) DeleteTemplate(id uint) error {
+	return s.repo.DeleteTemplate(id)
+}
+
+// shiftedCopy - Gezinin ID'siz kopyası; tarihler gün farkı kadar kaydırılır (saatler korunur)
+func shiftedCopy(trip *models.Trip, userID uint, startDate time.Time, withExpenses bool) *models.Trip {
+	shift := daysBetween(trip.StartDate, startDate)
+
+	copied := &models.Trip{
+		UserID:      userID,
+		Title:       trip.Title,
+		Destination: trip.Destination,
+		Latitude:    trip.Latitude,
+		Longitude:   trip.Longitude,
+		StartDate:   trip.StartDate.AddDate(0, 0, shift),
+		EndDate:     trip.EndDate.AddDate(0, 0, shift),
+		Description: trip.Description,
+		Budget:      trip.Budget,
+		Currency:    trip.Currency,
+	}
+
+	for _, a := range trip.Activities {
+		copied.Activities = append(copied.Activities, models.Activity{
+			Name:        a.Name,
+			Description: a.Description,
+			Location:    a.Location,
+			Latitude:    a.Latitude,
+			Longitude:   a.Longitude,
+			Date:        a.Date.AddDate(0, 0, shift),
+		})
+	}
+
+	if withExpenses {
+		for _, e := range trip.Expenses {
+			copied.Expenses = append(copied.Expenses, models.Expense{
+				Category:    e.Category,
+				Amount:      e.Amount,
+				Currency:    e.Currency,
+				ExpenseDate: e.ExpenseDate.AddDate(0, 0, shift),
+				Description: e.Description,
+			})
+		}
+	}
+
+	return copied
+}
+
+// daysBetween - İki tarih arasındaki takvim günü farkı (saat ve saat dilimi yok sayılır)
+func daysBetween(from, to time.Time) int {
+	fromDay := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDay := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDay.Sub(fromDay).Hours() / 24)
+}
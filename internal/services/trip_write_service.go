@@ -0,0 +1,332 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"time"
+	"travel-platform/internal/models"
+)
+
+// TripInput - Gezi oluşturma ve güncellemede istemciden gelen alanlar (HTTP body ve gRPC mesajı)
+type TripInput struct {
+	Title       string   `json:"title"`
+	Destination string   `json:"destination"`
+	StartDate   string   `json:"start_date"` // YYYY-MM-DD; güncellemede boşsa değişmez
+	EndDate     string   `json:"end_date"`
+	Description string   `json:"description"`
+	Budget      float64  `json:"budget"`
+	Currency    string   `json:"currency"` // Boşsa EUR (güncellemede değişmez)
+	IsPublic    bool     `json:"is_public"`
+	Latitude    *float64 `json:"latitude"` // Verilmezse destinasyondan geocode edilir
+	Longitude   *float64 `json:"longitude"`
+
+	// Çağıranın katalogda zaten eşleştirdiği destinasyon; istemciden alınmaz
+	DestinationID *uint `json:"-"`
+
+	// Sadece oluştururken
+	Activities []ActivityInput `json:"activities,omitempty"`
+	Expenses   []ExpenseInput  `json:"expenses,omitempty"`
+}
+
+// ActivityInput - Aktivite ekleme ve güncellemede istemciden gelen alanlar
+type ActivityInput struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Location    string   `json:"location"`
+	Latitude    *float64 `json:"latitude"`
+	Longitude   *float64 `json:"longitude"`
+	Date        string   `json:"date"` // YYYY-MM-DD; güncellemede boşsa değişmez
+}
+
+// ExpenseInput - Harcama ekleme ve güncellemede istemciden gelen alanlar
+type ExpenseInput struct {
+	Category    string  `json:"category"` // Kategori anahtarı veya adı
+	Amount      float64 `json:"amount"`
+	Currency    string  `json:"currency"`     // Boşsa gezinin para birimi (güncellemede değişmez)
+	ExpenseDate string  `json:"expense_date"` // YYYY-MM-DD; güncellemede boşsa değişmez
+	Description string  `json:"description"`  // Güncellemede boşsa değişmez
+}
+
+// TripWriteService - HTTP ve gRPC'nin ortak gezi yazma akışı: tarih ve kategori kontrolü,
+// geocoding, katalog bağlantısı ve bütçe uyarıları. Sahiplik kontrolü çağırana aittir
+type TripWriteService interface {
+	CreateTrip(userID uint, input TripInput) (*models.Trip, []BudgetAlertEvent, error)
+	UpdateTrip(trip *models.Trip, actorID uint, input TripInput) ([]BudgetAlertEvent, error)
+	AddActivity(trip *models.Trip, actorID uint, input ActivityInput) (*models.Activity, error)
+	UpdateActivity(activity *models.Activity, actorID uint, input ActivityInput) error
+	AddExpense(trip *models.Trip, actorID uint, input ExpenseInput) (*models.Expense, []BudgetAlertEvent, error)
+	UpdateExpense(trip *models.Trip, expense *models.Expense, actorID uint, input ExpenseInput) ([]BudgetAlertEvent, error)
+	DeleteExpense(trip *models.Trip, expense *models.Expense, actorID uint) ([]BudgetAlertEvent, error)
+}
+
+type tripWriteService struct {
+	tripService         TripService
+	geoService          GeoService
+	categoryService     CategoryService // Harcama kategorileri kaydedilmeden önce doğrulanır
+	notificationService NotificationService
+}
+
+func NewTripWriteService(tripService TripService, geoService GeoService, categoryService CategoryService, notificationService NotificationService) TripWriteService {
+	return &tripWriteService{
+		tripService:         tripService,
+		geoService:          geoService,
+		categoryService:     categoryService,
+		notificationService: notificationService,
+	}
+}
+
+// CreateTrip - Aktivite ve harcamalarla birlikte gezi oluşturur
+// Geçersiz aktivite veya harcama atlanmaz, hangisi olduğu belirtilerek istek reddedilir
+func (s *tripWriteService) CreateTrip(userID uint, input TripInput) (*models.Trip, []BudgetAlertEvent, error) {
+	startDate, err := parseInputDate("start_date", input.StartDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	endDate, err := parseInputDate("end_date", input.EndDate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	trip := &models.Trip{
+		UserID:      userID,
+		Title:       input.Title,
+		Destination: input.Destination,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Description: input.Description,
+		Budget:      input.Budget,
+		Currency:    input.Currency,
+		IsPublic:    input.IsPublic,
+		Latitude:    input.Latitude,
+		Longitude:   input.Longitude,
+
+		DestinationID: input.DestinationID,
+	}
+
+	for i, item := range input.Activities {
+		date, err := parseInputDate("date", item.Date)
+		if err != nil {
+			return nil, nil, fmt.Errorf("activities[%d]: %w", i, err)
+		}
+		activity := models.Activity{
+			Name:        item.Name,
+			Description: item.Description,
+			Location:    item.Location,
+			Latitude:    item.Latitude,
+			Longitude:   item.Longitude,
+			Date:        date,
+		}
+		if err := validateActivity(&activity); err != nil {
+			return nil, nil, fmt.Errorf("activities[%d]: %w", i, err)
+		}
+		trip.Activities = append(trip.Activities, activity)
+	}
+
+	for i, item := range input.Expenses {
+		date, err := parseInputDate("expense_date", item.ExpenseDate)
+		if err != nil {
+			return nil, nil, fmt.Errorf("expenses[%d]: %w", i, err)
+		}
+		category, err := s.categoryService.ResolveCategory(userID, item.Category)
+		if err != nil {
+			return nil, nil, fmt.Errorf("expenses[%d]: %w", i, err)
+		}
+		expense := models.Expense{
+			Category:    category,
+			Amount:      item.Amount,
+			Currency:    item.Currency,
+			ExpenseDate: date,
+			Description: item.Description,
+		}
+		if err := validateExpense(&expense); err != nil {
+			return nil, nil, fmt.Errorf("expenses[%d]: %w", i, err)
+		}
+		trip.Expenses = append(trip.Expenses, expense)
+	}
+
+	// Koordinatı verilmeyen yerleri geocoder ile doldur (bulunamazsa boş kalır)
+	s.geoService.GeocodeTrip(trip)
+
+	if err := s.tripService.CreateTrip(trip); err != nil {
+		return nil, nil, err
+	}
+	var alerts []BudgetAlertEvent
+	if len(trip.Expenses) > 0 {
+		alerts = s.checkBudgetAlerts(trip.ID)
+	}
+	return trip, alerts, nil
+}
+
+// UpdateTrip - Gezi alanlarını değiştirir; boş tarih ve para birimi korunur
+func (s *tripWriteService) UpdateTrip(trip *models.Trip, actorID uint, input TripInput) ([]BudgetAlertEvent, error) {
+	var err error
+	if input.StartDate != "" {
+		if trip.StartDate, err = parseInputDate("start_date", input.StartDate); err != nil {
+			return nil, err
+		}
+	}
+	if input.EndDate != "" {
+		if trip.EndDate, err = parseInputDate("end_date", input.EndDate); err != nil {
+			return nil, err
+		}
+	}
+
+	// Destinasyon değiştiyse eski koordinatlar ve katalog bağlantısı geçersizdir
+	if input.Destination != trip.Destination || input.Latitude != nil {
+		trip.Latitude, trip.Longitude = input.Latitude, input.Longitude
+	}
+	if input.Destination != trip.Destination {
+		trip.DestinationID = nil
+	}
+
+	trip.Title = input.Title
+	trip.Destination = input.Destination
+	trip.Description = input.Description
+	trip.Budget = input.Budget
+	trip.IsPublic = input.IsPublic
+	if input.Currency != "" {
+		trip.Currency = input.Currency
+	}
+
+	s.geoService.LinkDestination(trip)
+	if trip.Latitude == nil || trip.Longitude == nil {
+		if loc, err := s.geoService.Geocode(trip.Destination); err == nil {
+			trip.Latitude, trip.Longitude = &loc.Latitude, &loc.Longitude
+		}
+	}
+
+	if err := s.tripService.UpdateTrip(trip, actorID); err != nil {
+		return nil, err
+	}
+	// Bütçe düşürüldüyse eşikler geçilmiş olabilir
+	return s.checkBudgetAlerts(trip.ID), nil
+}
+
+// AddActivity - Koordinatı verilmeyen aktivitenin konumu geocode edilir
+func (s *tripWriteService) AddActivity(trip *models.Trip, actorID uint, input ActivityInput) (*models.Activity, error) {
+	date, err := parseInputDate("date", input.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	activity := &models.Activity{
+		TripID:      trip.ID,
+		Name:        input.Name,
+		Description: input.Description,
+		Location:    input.Location,
+		Latitude:    input.Latitude,
+		Longitude:   input.Longitude,
+		Date:        date,
+	}
+	if activity.Latitude == nil || activity.Longitude == nil {
+		if loc, err := s.geoService.Geocode(activity.Location); err == nil {
+			activity.Latitude, activity.Longitude = &loc.Latitude, &loc.Longitude
+		}
+	}
+
+	if err := s.tripService.AddActivity(activity, actorID); err != nil {
+		return nil, err
+	}
+	return activity, nil
+}
+
+// UpdateActivity - Boş tarih korunur
+func (s *tripWriteService) UpdateActivity(activity *models.Activity, actorID uint, input ActivityInput) error {
+	// Konum değiştiyse eski koordinatlar geçersizdir
+	if input.Location != activity.Location || input.Latitude != nil {
+		activity.Latitude, activity.Longitude = input.Latitude, input.Longitude
+	}
+	activity.Name = input.Name
+	activity.Description = input.Description
+	activity.Location = input.Location
+	if input.Date != "" {
+		date, err := parseInputDate("date", input.Date)
+		if err != nil {
+			return err
+		}
+		activity.Date = date
+	}
+
+	return s.tripService.UpdateActivity(activity, actorID)
+}
+
+// AddExpense - Kategori gezi sahibinin kataloğunda olmalı; yeni bütçe uyarıları döner
+func (s *tripWriteService) AddExpense(trip *models.Trip, actorID uint, input ExpenseInput) (*models.Expense, []BudgetAlertEvent, error) {
+	date, err := parseInputDate("expense_date", input.ExpenseDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	category, err := s.categoryService.ResolveCategory(trip.UserID, input.Category)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	expense := &models.Expense{
+		TripID:      trip.ID,
+		Category:    category,
+		Amount:      input.Amount,
+		Currency:    input.Currency,
+		ExpenseDate: date,
+		Description: input.Description,
+	}
+	if err := s.tripService.AddExpense(expense, actorID); err != nil {
+		return nil, nil, err
+	}
+	return expense, s.checkBudgetAlerts(trip.ID), nil
+}
+
+// UpdateExpense - Boş tarih, para birimi ve açıklama korunur
+func (s *tripWriteService) UpdateExpense(trip *models.Trip, expense *models.Expense, actorID uint, input ExpenseInput) ([]BudgetAlertEvent, error) {
+	// Eski serbest metin kategoriler değiştirilmedikçe korunur
+	if input.Category != expense.Category {
+		category, err := s.categoryService.ResolveCategory(trip.UserID, input.Category)
+		if err != nil {
+			return nil, err
+		}
+		expense.Category = category
+	}
+	expense.Amount = input.Amount
+	if input.Currency != "" {
+		expense.Currency = input.Currency
+	}
+	if input.Description != "" {
+		expense.Description = input.Description
+	}
+	if input.ExpenseDate != "" {
+		date, err := parseInputDate("expense_date", input.ExpenseDate)
+		if err != nil {
+			return nil, err
+		}
+		expense.ExpenseDate = date
+	}
+
+	if err := s.tripService.UpdateExpense(expense, actorID); err != nil {
+		return nil, err
+	}
+	return s.checkBudgetAlerts(trip.ID), nil
+}
+
+// DeleteExpense - Harcama eşiğin altına düştüyse eşik sıfırlanır
+func (s *tripWriteService) DeleteExpense(trip *models.Trip, expense *models.Expense, actorID uint) ([]BudgetAlertEvent, error) {
+	if err := s.tripService.DeleteExpense(expense.ID, actorID); err != nil {
+		return nil, err
+	}
+	return s.checkBudgetAlerts(trip.ID), nil
+}
+
+// checkBudgetAlerts - Uyarı gönderilemezse yazma işlemi yine de başarılı sayılır, hata loglanır
+func (s *tripWriteService) checkBudgetAlerts(tripID uint) []BudgetAlertEvent {
+	events, err := s.notificationService.CheckBudgetAlerts(tripID, time.Now())
+	if err != nil {
+		log.Printf("🔔 Budget alerts for trip %d: %v", tripID, err)
+	}
+	return events
+}
+
+func parseInputDate(field, value string) (time.Time, error) {
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %q, use YYYY-MM-DD", field, value)
+	}
+	return date, nil
+}
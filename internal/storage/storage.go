@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ErrNotFound - Anahtara ait dosya yok
+var ErrNotFound = errors.New("storage: blob not found")
+
+// BlobStore - Yüklenen dosyaların (fiş, fatura vb.) saklandığı yer
+// Anahtarlar "/" ile ayrılmış göreli yollardır, ör. receipts/12/ab12-fis.jpg
+type BlobStore interface {
+	Put(key string, data []byte) error
+	Get(key string) ([]byte, error)
+	Delete(key string) error
+	List(prefix string) ([]string, error)
+	ModTime(key string) (time.Time, error)
+}
+
+// LocalStore - Dosyaları yerel dizinde saklar
+type LocalStore struct {
+	root string
+}
+
+// NewLocalStore - root dizini yoksa oluşturur
+func NewLocalStore(root string) (*LocalStore, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("storage: %w", err)
+	}
+	return &LocalStore{root: root}, nil
+}
+
+func (s *LocalStore) Put(key string, data []byte) error {
+	file, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0o750); err != nil {
+		return err
+	}
+	// Yarım yazılmış dosya görünmesin diye önce geçici dosyaya yazılır
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return err
+	}
+	return os.Rename(tmp, file)
+}
+
+func (s *LocalStore) Get(key string) ([]byte, error) {
+	file, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+// Delete - Olmayan dosyayı silmek hata değildir
+func (s *LocalStore) Delete(key string) error {
+	file, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(file); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// List - prefix ile başlayan anahtarlar
+func (s *LocalStore) List(prefix string) ([]string, error) {
+	keys := []string{}
+	err := filepath.WalkDir(s.root, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || strings.HasSuffix(file, ".tmp") {
+			return err
+		}
+		rel, err := filepath.Rel(s.root, file)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	return keys, err
+}
+
+// ModTime - Dosyanın son yazılma zamanı
+func (s *LocalStore) ModTime(key string) (time.Time, error) {
+	file, err := s.path(key)
+	if err != nil {
+		return time.Time{}, err
+	}
+	info, err := os.Stat(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return time.Time{}, ErrNotFound
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.ModTime(), nil
+}
+
+// path - Anahtarı root altındaki dosya yoluna çevirir; dışarı çıkan anahtarlar reddedilir
+func (s *LocalStore) path(key string) (string, error) {
+	clean := path.Clean(key)
+	if key == "" || clean != key || path.IsAbs(clean) || clean == "." || strings.HasPrefix(clean, "../") || clean == ".." {
+		return "", fmt.Errorf("storage: invalid key %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(clean)), nil
+}
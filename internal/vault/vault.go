@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeySize - AES-256 anahtar uzunluğu (byte)
+const KeySize = 32
+
+// ErrDecrypt - Veri bozuk, anahtar yanlış veya veri başka bir sahibe ait
+var ErrDecrypt = errors.New("vault: data could not be decrypted")
+
+// Cipher - AES-256-GCM ile şifreleme; nonce şifreli verinin başına eklenir
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+func NewCipher(key []byte) (*Cipher, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("vault: key must be %d bytes, got %d", KeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Encrypt - associatedData (ör. sahibin ID'si) şifreli veriye bağlanır,
+// çözerken aynısı verilmezse çözme başarısız olur
+func (c *Cipher) Encrypt(plaintext, associatedData []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return c.aead.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+func (c *Cipher) Decrypt(data, associatedData []byte) ([]byte, error) {
+	size := c.aead.NonceSize()
+	if len(data) < size {
+		return nil, ErrDecrypt
+	}
+	plaintext, err := c.aead.Open(nil, data[:size], data[size:], associatedData)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
+
+// LoadKey - Anahtarı VAULT_KEY ortam değişkeninden (base64) okur
+// Tanımlı değilse keyFile'dan okur; dosya yoksa yeni anahtar üretip 0600 izniyle kaydeder
+func LoadKey(keyFile string) ([]byte, error) {
+	if encoded := os.Getenv("VAULT_KEY"); encoded != "" {
+		return decodeKey(encoded)
+	}
+
+	content, err := os.ReadFile(keyFile)
+	if err == nil {
+		return decodeKey(string(content))
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func decodeKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("vault: key is not valid base64: %w", err)
+	}
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("vault: key must be %d bytes, got %d", KeySize, len(key))
+	}
+	return key, nil
+}
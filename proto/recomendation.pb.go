@@ -29,6 +29,7 @@ type TripInfo struct {
 	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	Activities    []string               `protobuf:"bytes,6,rep,name=activities,proto3" json:"activities,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"` // budget para birimi (boşsa EUR)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -105,6 +106,13 @@ func (x *TripInfo) GetActivities() []string {
 	return nil
 }
 
+func (x *TripInfo) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type UserTripHistory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -162,6 +170,8 @@ type RecommendationRequest struct {
 	UserId               uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	PreferredDestination string                 `protobuf:"bytes,2,opt,name=preferred_destination,json=preferredDestination,proto3" json:"preferred_destination,omitempty"`
 	MaxBudget            float64                `protobuf:"fixed64,3,opt,name=max_budget,json=maxBudget,proto3" json:"max_budget,omitempty"`
+	History              *UserTripHistory       `protobuf:"bytes,4,opt,name=history,proto3" json:"history,omitempty"`                             // Verilmezse user_id'nin kendi gezilerinden oluşturulur
+	TravelMonth          int32                  `protobuf:"varint,5,opt,name=travel_month,json=travelMonth,proto3" json:"travel_month,omitempty"` // 1-12; verilirse sadece o ayda gidilmesi uygun destinasyonlar (0 = hepsi)
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -217,6 +227,20 @@ func (x *RecommendationRequest) GetMaxBudget() float64 {
 	return 0
 }
 
+func (x *RecommendationRequest) GetHistory() *UserTripHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *RecommendationRequest) GetTravelMonth() int32 {
+	if x != nil {
+		return x.TravelMonth
+	}
+	return 0
+}
+
 type Recommendation struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	Destination         string                 `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
@@ -225,6 +249,7 @@ type Recommendation struct {
 	SuggestedActivities []string               `protobuf:"bytes,4,rep,name=suggested_activities,json=suggestedActivities,proto3" json:"suggested_activities,omitempty"`
 	BestSeason          string                 `protobuf:"bytes,5,opt,name=best_season,json=bestSeason,proto3" json:"best_season,omitempty"`
 	MatchScore          float64                `protobuf:"fixed64,6,opt,name=match_score,json=matchScore,proto3" json:"match_score,omitempty"`
+	Reasons             []string               `protobuf:"bytes,7,rep,name=reasons,proto3" json:"reasons,omitempty"` // Önerinin neden eşleştiği, ör. "Same country as your trip to Rome"
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -301,6 +326,13 @@ func (x *Recommendation) GetMatchScore() float64 {
 	return 0
 }
 
+func (x *Recommendation) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
 type RecommendationResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Recommendations []*Recommendation      `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
@@ -353,29 +385,32 @@ func (x *RecommendationResponse) GetMessage() string {
 	return ""
 }
 
-type BudgetAnalysisRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TripId        uint32                 `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
-	TotalBudget   float64                `protobuf:"fixed64,2,opt,name=total_budget,json=totalBudget,proto3" json:"total_budget,omitempty"`
-	Expenses      []*Expense             `protobuf:"bytes,3,rep,name=expenses,proto3" json:"expenses,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// RefineRequest - Öneri oturumunda bir adım; verilen tercihler oturumdaki tercihlerin yerine geçer
+type RefineRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                // Sadece ilk mesajda zorunlu; sonra verilirse aynı olmalı
+	History              *UserTripHistory       `protobuf:"bytes,2,opt,name=history,proto3" json:"history,omitempty"`                                                             // Sadece ilk mesajda okunur; verilmezse kullanıcının kendi gezileri
+	MaxBudget            *float64               `protobuf:"fixed64,3,opt,name=max_budget,json=maxBudget,proto3,oneof" json:"max_budget,omitempty"`                                // Verilirse bütçe tercihi değişir (0 = sınırsız)
+	PreferredDestination *string                `protobuf:"bytes,4,opt,name=preferred_destination,json=preferredDestination,proto3,oneof" json:"preferred_destination,omitempty"` // Verilirse destinasyon tercihi değişir ("" = tercih yok)
+	TravelMonth          *int32                 `protobuf:"varint,5,opt,name=travel_month,json=travelMonth,proto3,oneof" json:"travel_month,omitempty"`                           // Verilirse seyahat ayı değişir (0 = hepsi)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *BudgetAnalysisRequest) Reset() {
-	*x = BudgetAnalysisRequest{}
+func (x *RefineRequest) Reset() {
+	*x = RefineRequest{}
 	mi := &file_proto_recomendation_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BudgetAnalysisRequest) String() string {
+func (x *RefineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BudgetAnalysisRequest) ProtoMessage() {}
+func (*RefineRequest) ProtoMessage() {}
 
-func (x *BudgetAnalysisRequest) ProtoReflect() protoreflect.Message {
+func (x *RefineRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_recomendation_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -387,55 +422,75 @@ func (x *BudgetAnalysisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BudgetAnalysisRequest.ProtoReflect.Descriptor instead.
-func (*BudgetAnalysisRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefineRequest.ProtoReflect.Descriptor instead.
+func (*RefineRequest) Descriptor() ([]byte, []int) {
 	return file_proto_recomendation_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *BudgetAnalysisRequest) GetTripId() uint32 {
+func (x *RefineRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.TripId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *BudgetAnalysisRequest) GetTotalBudget() float64 {
+func (x *RefineRequest) GetHistory() *UserTripHistory {
 	if x != nil {
-		return x.TotalBudget
+		return x.History
+	}
+	return nil
+}
+
+func (x *RefineRequest) GetMaxBudget() float64 {
+	if x != nil && x.MaxBudget != nil {
+		return *x.MaxBudget
 	}
 	return 0
 }
 
-func (x *BudgetAnalysisRequest) GetExpenses() []*Expense {
-	if x != nil {
-		return x.Expenses
+func (x *RefineRequest) GetPreferredDestination() string {
+	if x != nil && x.PreferredDestination != nil {
+		return *x.PreferredDestination
 	}
-	return nil
+	return ""
 }
 
-type Expense struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RefineRequest) GetTravelMonth() int32 {
+	if x != nil && x.TravelMonth != nil {
+		return *x.TravelMonth
+	}
+	return 0
 }
 
-func (x *Expense) Reset() {
-	*x = Expense{}
+type ItineraryRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Destination      string                 `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	StartDate        string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                         // YYYY-MM-DD
+	EndDate          string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                               // YYYY-MM-DD; gün sayısı bu aralıktan hesaplanır
+	Budget           float64                `protobuf:"fixed64,4,opt,name=budget,proto3" json:"budget,omitempty"`                                              // Toplam bütçe; 0 ise topluluk gezilerinin günlük ortalamasından tahmin edilir
+	Currency         string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`                                            // Bütçe ve tahminlerin para birimi (boşsa EUR)
+	ActivitiesPerDay int32                  `protobuf:"varint,6,opt,name=activities_per_day,json=activitiesPerDay,proto3" json:"activities_per_day,omitempty"` // 0 ise 3
+	Save             bool                   `protobuf:"varint,7,opt,name=save,proto3" json:"save,omitempty"`                                                   // true ise plan token'ın kullanıcısına aktiviteleriyle yeni gezi olarak kaydedilir
+	UserId           uint32                 `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                 // Verilirse token'ın kullanıcısıyla aynı olmalı
+	Title            string                 `protobuf:"bytes,9,opt,name=title,proto3" json:"title,omitempty"`                                                  // Kaydedilen gezinin adı (boşsa "Trip to <destination>")
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ItineraryRequest) Reset() {
+	*x = ItineraryRequest{}
 	mi := &file_proto_recomendation_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Expense) String() string {
+func (x *ItineraryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Expense) ProtoMessage() {}
+func (*ItineraryRequest) ProtoMessage() {}
 
-func (x *Expense) ProtoReflect() protoreflect.Message {
+func (x *ItineraryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_recomendation_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -447,56 +502,98 @@ func (x *Expense) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Expense.ProtoReflect.Descriptor instead.
-func (*Expense) Descriptor() ([]byte, []int) {
+// Deprecated: Use ItineraryRequest.ProtoReflect.Descriptor instead.
+func (*ItineraryRequest) Descriptor() ([]byte, []int) {
 	return file_proto_recomendation_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Expense) GetCategory() string {
+func (x *ItineraryRequest) GetDestination() string {
 	if x != nil {
-		return x.Category
+		return x.Destination
 	}
 	return ""
 }
 
-func (x *Expense) GetAmount() float64 {
+func (x *ItineraryRequest) GetStartDate() string {
 	if x != nil {
-		return x.Amount
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ItineraryRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ItineraryRequest) GetBudget() float64 {
+	if x != nil {
+		return x.Budget
 	}
 	return 0
 }
 
-func (x *Expense) GetCurrency() string {
+func (x *ItineraryRequest) GetCurrency() string {
 	if x != nil {
 		return x.Currency
 	}
 	return ""
 }
 
-type CategoryAnalysis struct {
+func (x *ItineraryRequest) GetActivitiesPerDay() int32 {
+	if x != nil {
+		return x.ActivitiesPerDay
+	}
+	return 0
+}
+
+func (x *ItineraryRequest) GetSave() bool {
+	if x != nil {
+		return x.Save
+	}
+	return false
+}
+
+func (x *ItineraryRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ItineraryRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type ItineraryItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	TotalSpent    float64                `protobuf:"fixed64,2,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`
-	Percentage    float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Location      string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	EstimatedCost float64                `protobuf:"fixed64,3,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`
+	Popularity    int32                  `protobuf:"varint,4,opt,name=popularity,proto3" json:"popularity,omitempty"` // Bu aktiviteyi yapan public gezi sayısı (0 = katalogdan)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryAnalysis) Reset() {
-	*x = CategoryAnalysis{}
+func (x *ItineraryItem) Reset() {
+	*x = ItineraryItem{}
 	mi := &file_proto_recomendation_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryAnalysis) String() string {
+func (x *ItineraryItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryAnalysis) ProtoMessage() {}
+func (*ItineraryItem) ProtoMessage() {}
 
-func (x *CategoryAnalysis) ProtoReflect() protoreflect.Message {
+func (x *ItineraryItem) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_recomendation_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -508,65 +605,63 @@ func (x *CategoryAnalysis) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryAnalysis.ProtoReflect.Descriptor instead.
-func (*CategoryAnalysis) Descriptor() ([]byte, []int) {
+// Deprecated: Use ItineraryItem.ProtoReflect.Descriptor instead.
+func (*ItineraryItem) Descriptor() ([]byte, []int) {
 	return file_proto_recomendation_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CategoryAnalysis) GetCategory() string {
+func (x *ItineraryItem) GetName() string {
 	if x != nil {
-		return x.Category
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CategoryAnalysis) GetTotalSpent() float64 {
+func (x *ItineraryItem) GetLocation() string {
 	if x != nil {
-		return x.TotalSpent
+		return x.Location
 	}
-	return 0
+	return ""
 }
 
-func (x *CategoryAnalysis) GetPercentage() float64 {
+func (x *ItineraryItem) GetEstimatedCost() float64 {
 	if x != nil {
-		return x.Percentage
+		return x.EstimatedCost
 	}
 	return 0
 }
 
-func (x *CategoryAnalysis) GetStatus() string {
+func (x *ItineraryItem) GetPopularity() int32 {
 	if x != nil {
-		return x.Status
+		return x.Popularity
 	}
-	return ""
+	return 0
 }
 
-type BudgetAnalysisResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	TotalBudget       float64                `protobuf:"fixed64,1,opt,name=total_budget,json=totalBudget,proto3" json:"total_budget,omitempty"`
-	TotalSpent        float64                `protobuf:"fixed64,2,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`
-	Remaining         float64                `protobuf:"fixed64,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
-	CategoryBreakdown []*CategoryAnalysis    `protobuf:"bytes,4,rep,name=category_breakdown,json=categoryBreakdown,proto3" json:"category_breakdown,omitempty"`
-	Warnings          []string               `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
-	Suggestions       []string               `protobuf:"bytes,6,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type ItineraryDayPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           int32                  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"` // 1'den başlar
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Items         []*ItineraryItem       `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	EstimatedCost float64                `protobuf:"fixed64,4,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"` // Günlük giderler ve aktiviteler dahil
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BudgetAnalysisResponse) Reset() {
-	*x = BudgetAnalysisResponse{}
+func (x *ItineraryDayPlan) Reset() {
+	*x = ItineraryDayPlan{}
 	mi := &file_proto_recomendation_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BudgetAnalysisResponse) String() string {
+func (x *ItineraryDayPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BudgetAnalysisResponse) ProtoMessage() {}
+func (*ItineraryDayPlan) ProtoMessage() {}
 
-func (x *BudgetAnalysisResponse) ProtoReflect() protoreflect.Message {
+func (x *ItineraryDayPlan) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_recomendation_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -578,105 +673,846 @@ func (x *BudgetAnalysisResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BudgetAnalysisResponse.ProtoReflect.Descriptor instead.
-func (*BudgetAnalysisResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ItineraryDayPlan.ProtoReflect.Descriptor instead.
+func (*ItineraryDayPlan) Descriptor() ([]byte, []int) {
 	return file_proto_recomendation_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *BudgetAnalysisResponse) GetTotalBudget() float64 {
+func (x *ItineraryDayPlan) GetDay() int32 {
 	if x != nil {
-		return x.TotalBudget
+		return x.Day
 	}
 	return 0
 }
 
-func (x *BudgetAnalysisResponse) GetTotalSpent() float64 {
+func (x *ItineraryDayPlan) GetDate() string {
 	if x != nil {
-		return x.TotalSpent
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ItineraryDayPlan) GetItems() []*ItineraryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ItineraryDayPlan) GetEstimatedCost() float64 {
+	if x != nil {
+		return x.EstimatedCost
 	}
 	return 0
 }
 
-func (x *BudgetAnalysisResponse) GetRemaining() float64 {
+type ItineraryResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Destination    string                 `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Currency       string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	TotalBudget    float64                `protobuf:"fixed64,3,opt,name=total_budget,json=totalBudget,proto3" json:"total_budget,omitempty"`          // İstekteki bütçe veya tahmin
+	EstimatedTotal float64                `protobuf:"fixed64,4,opt,name=estimated_total,json=estimatedTotal,proto3" json:"estimated_total,omitempty"` // Günlerin tahmini maliyetleri toplamı
+	Days           []*ItineraryDayPlan    `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
+	Warnings       []string               `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	TripId         uint32                 `protobuf:"varint,7,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"` // save=true ise oluşturulan gezi
+	Message        string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ItineraryResponse) Reset() {
+	*x = ItineraryResponse{}
+	mi := &file_proto_recomendation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItineraryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItineraryResponse) ProtoMessage() {}
+
+func (x *ItineraryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_recomendation_proto_msgTypes[9]
 	if x != nil {
-		return x.Remaining
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItineraryResponse.ProtoReflect.Descriptor instead.
+func (*ItineraryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_recomendation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ItineraryResponse) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *ItineraryResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ItineraryResponse) GetTotalBudget() float64 {
+	if x != nil {
+		return x.TotalBudget
 	}
 	return 0
 }
 
-func (x *BudgetAnalysisResponse) GetCategoryBreakdown() []*CategoryAnalysis {
+func (x *ItineraryResponse) GetEstimatedTotal() float64 {
 	if x != nil {
-		return x.CategoryBreakdown
+		return x.EstimatedTotal
+	}
+	return 0
+}
+
+func (x *ItineraryResponse) GetDays() []*ItineraryDayPlan {
+	if x != nil {
+		return x.Days
 	}
 	return nil
 }
 
-func (x *BudgetAnalysisResponse) GetWarnings() []string {
+func (x *ItineraryResponse) GetWarnings() []string {
 	if x != nil {
 		return x.Warnings
 	}
 	return nil
 }
 
-func (x *BudgetAnalysisResponse) GetSuggestions() []string {
+func (x *ItineraryResponse) GetTripId() uint32 {
 	if x != nil {
-		return x.Suggestions
+		return x.TripId
 	}
-	return nil
+	return 0
 }
 
-var File_proto_recomendation_proto protoreflect.FileDescriptor
+func (x *ItineraryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
 
-const file_proto_recomendation_proto_rawDesc = "" +
-	"\n" +
-	"\x19proto/recomendation.proto\x12\x0erecommendation\"\xb7\x01\n" +
-	"\bTripInfo\x12\x17\n" +
-	"\atrip_id\x18\x01 \x01(\rR\x06tripId\x12 \n" +
-	"\vdestination\x18\x02 \x01(\tR\vdestination\x12\x16\n" +
-	"\x06budget\x18\x03 \x01(\x01R\x06budget\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x05 \x01(\tR\aendDate\x12\x1e\n" +
-	"\n" +
-	"activities\x18\x06 \x03(\tR\n" +
-	"activities\"c\n" +
-	"\x0fUserTripHistory\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\rR\x06userId\x127\n" +
-	"\n" +
-	"past_trips\x18\x02 \x03(\v2\x18.recommendation.TripInfoR\tpastTrips\"\x84\x01\n" +
-	"\x15RecommendationRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\rR\x06userId\x123\n" +
-	"\x15preferred_destination\x18\x02 \x01(\tR\x14preferredDestination\x12\x1d\n" +
-	"\n" +
-	"max_budget\x18\x03 \x01(\x01R\tmaxBudget\"\xf4\x01\n" +
-	"\x0eRecommendation\x12 \n" +
-	"\vdestination\x18\x01 \x01(\tR\vdestination\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12)\n" +
-	"\x10estimated_budget\x18\x03 \x01(\x01R\x0festimatedBudget\x121\n" +
-	"\x14suggested_activities\x18\x04 \x03(\tR\x13suggestedActivities\x12\x1f\n" +
-	"\vbest_season\x18\x05 \x01(\tR\n" +
-	"bestSeason\x12\x1f\n" +
-	"\vmatch_score\x18\x06 \x01(\x01R\n" +
-	"matchScore\"|\n" +
-	"\x16RecommendationResponse\x12H\n" +
-	"\x0frecommendations\x18\x01 \x03(\v2\x1e.recommendation.RecommendationR\x0frecommendations\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x88\x01\n" +
-	"\x15BudgetAnalysisRequest\x12\x17\n" +
-	"\atrip_id\x18\x01 \x01(\rR\x06tripId\x12!\n" +
-	"\ftotal_budget\x18\x02 \x01(\x01R\vtotalBudget\x123\n" +
-	"\bexpenses\x18\x03 \x03(\v2\x17.recommendation.ExpenseR\bexpenses\"Y\n" +
-	"\aExpense\x12\x1a\n" +
-	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"\x87\x01\n" +
-	"\x10CategoryAnalysis\x12\x1a\n" +
-	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1f\n" +
-	"\vtotal_spent\x18\x02 \x01(\x01R\n" +
-	"totalSpent\x12\x1e\n" +
+type BudgetAnalysisRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TripId          uint32                 `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	TotalBudget     float64                `protobuf:"fixed64,2,opt,name=total_budget,json=totalBudget,proto3" json:"total_budget,omitempty"`
+	Expenses        []*Expense             `protobuf:"bytes,3,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	Currency        string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`                                      // Bütçenin ve toplamların para birimi (boşsa EUR)
+	CategoryBudgets []*CategoryBudget      `protobuf:"bytes,5,rep,name=category_budgets,json=categoryBudgets,proto3" json:"category_budgets,omitempty"` // Kategori bütçeleri (currency cinsinden)
+	StartDate       string                 `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                   // YYYY-MM-DD; start_date ve end_date verilirse harcama hızı tahmini yapılır
+	EndDate         string                 `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Today           string                 `protobuf:"bytes,8,opt,name=today,proto3" json:"today,omitempty"`                  // YYYY-MM-DD; tahminin yapıldığı gün (boşsa bugün)
+	UserId          uint32                 `protobuf:"varint,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Verilirse token'ın kullanıcısıyla aynı olmalı; özel kategoriler token'ın kullanıcısından gelir (token yoksa sadece sistem kategorileri)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BudgetAnalysisRequest) Reset() {
+	*x = BudgetAnalysisRequest{}
+	mi := &file_proto_recomendation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetAnalysisRequest) ProtoMessage() {}
+
+func (x *BudgetAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_recomendation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*BudgetAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_recomendation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BudgetAnalysisRequest) GetTripId() uint32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *BudgetAnalysisRequest) GetTotalBudget() float64 {
+	if x != nil {
+		return x.TotalBudget
+	}
+	return 0
+}
+
+func (x *BudgetAnalysisRequest) GetExpenses() []*Expense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *BudgetAnalysisRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BudgetAnalysisRequest) GetCategoryBudgets() []*CategoryBudget {
+	if x != nil {
+		return x.CategoryBudgets
+	}
+	return nil
+}
+
+func (x *BudgetAnalysisRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *BudgetAnalysisRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *BudgetAnalysisRequest) GetToday() string {
+	if x != nil {
+		return x.Today
+	}
+	return ""
+}
+
+func (x *BudgetAnalysisRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CategoryBudget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryBudget) Reset() {
+	*x = CategoryBudget{}
+	mi := &file_proto_recomendation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryBudget) ProtoMessage() {}
+
+func (x *CategoryBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_recomendation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryBudget.ProtoReflect.Descriptor instead.
+func (*CategoryBudget) Descriptor() ([]byte, []int) {
+	return file_proto_recomendation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CategoryBudget) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategoryBudget) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type Expense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExpenseDate   string                 `protobuf:"bytes,4,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"`      // YYYY-MM-DD; kur bu tarihe göre seçilir (boşsa bugün)
+	ExchangeRate  float64                `protobuf:"fixed64,5,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"` // Önceden yakalanmış kur (1 currency = rate istek para birimi); 0 ise hesaplanır
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Expense) Reset() {
+	*x = Expense{}
+	mi := &file_proto_recomendation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Expense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expense) ProtoMessage() {}
+
+func (x *Expense) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_recomendation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expense.ProtoReflect.Descriptor instead.
+func (*Expense) Descriptor() ([]byte, []int) {
+	return file_proto_recomendation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Expense) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Expense) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Expense) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Expense) GetExpenseDate() string {
+	if x != nil {
+		return x.ExpenseDate
+	}
+	return ""
+}
+
+func (x *Expense) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+type CategoryAnalysis struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Category         string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	TotalSpent       float64                `protobuf:"fixed64,2,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`
+	Percentage       float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Budget           float64                `protobuf:"fixed64,5,opt,name=budget,proto3" json:"budget,omitempty"`                                                 // Kategori bütçesi; 0 ise ideal oranlara göre değerlendirilir
+	Projected        float64                `protobuf:"fixed64,6,opt,name=projected,proto3" json:"projected,omitempty"`                                           // Bu hızla gezi sonundaki tahmini harcama
+	DaysOfBudgetLeft float64                `protobuf:"fixed64,7,opt,name=days_of_budget_left,json=daysOfBudgetLeft,proto3" json:"days_of_budget_left,omitempty"` // Kategori bütçesinin yeteceği gün; -1 hesaplanamadı
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CategoryAnalysis) Reset() {
+	*x = CategoryAnalysis{}
+	mi := &file_proto_recomendation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAnalysis) ProtoMessage() {}
+
+func (x *CategoryAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_recomendation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAnalysis.ProtoReflect.Descriptor instead.
+func (*CategoryAnalysis) Descriptor() ([]byte, []int) {
+	return file_proto_recomendation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CategoryAnalysis) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategoryAnalysis) GetTotalSpent() float64 {
+	if x != nil {
+		return x.TotalSpent
+	}
+	return 0
+}
+
+func (x *CategoryAnalysis) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *CategoryAnalysis) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CategoryAnalysis) GetBudget() float64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *CategoryAnalysis) GetProjected() float64 {
+	if x != nil {
+		return x.Projected
+	}
+	return 0
+}
+
+func (x *CategoryAnalysis) GetDaysOfBudgetLeft() float64 {
+	if x != nil {
+		return x.DaysOfBudgetLeft
+	}
+	return 0
+}
+
+type DailySpend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailySpend) Reset() {
+	*x = DailySpend{}
+	mi := &file_proto_recomendation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailySpend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailySpend) ProtoMessage() {}
+
+func (x *DailySpend) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_recomendation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailySpend.ProtoReflect.Descriptor instead.
+func (*DailySpend) Descriptor() ([]byte, []int) {
+	return file_proto_recomendation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DailySpend) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailySpend) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type BudgetForecast struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TripDays         int32                  `protobuf:"varint,1,opt,name=trip_days,json=tripDays,proto3" json:"trip_days,omitempty"`
+	DaysElapsed      int32                  `protobuf:"varint,2,opt,name=days_elapsed,json=daysElapsed,proto3" json:"days_elapsed,omitempty"`
+	DaysRemaining    int32                  `protobuf:"varint,3,opt,name=days_remaining,json=daysRemaining,proto3" json:"days_remaining,omitempty"`
+	DailyBudget      float64                `protobuf:"fixed64,4,opt,name=daily_budget,json=dailyBudget,proto3" json:"daily_budget,omitempty"`
+	DailyBurn        float64                `protobuf:"fixed64,5,opt,name=daily_burn,json=dailyBurn,proto3" json:"daily_burn,omitempty"`                          // Gezinin geçen günlerindeki ortalama günlük harcama
+	ProjectedTotal   float64                `protobuf:"fixed64,6,opt,name=projected_total,json=projectedTotal,proto3" json:"projected_total,omitempty"`           // Bu hızla gezi sonundaki tahmini toplam
+	DaysOfBudgetLeft float64                `protobuf:"fixed64,7,opt,name=days_of_budget_left,json=daysOfBudgetLeft,proto3" json:"days_of_budget_left,omitempty"` // Kalan bütçenin yeteceği gün; -1 henüz harcama yok
+	Daily            []*DailySpend          `protobuf:"bytes,8,rep,name=daily,proto3" json:"daily,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BudgetForecast) Reset() {
+	*x = BudgetForecast{}
+	mi := &file_proto_recomendation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetForecast) ProtoMessage() {}
+
+func (x *BudgetForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_recomendation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetForecast.ProtoReflect.Descriptor instead.
+func (*BudgetForecast) Descriptor() ([]byte, []int) {
+	return file_proto_recomendation_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BudgetForecast) GetTripDays() int32 {
+	if x != nil {
+		return x.TripDays
+	}
+	return 0
+}
+
+func (x *BudgetForecast) GetDaysElapsed() int32 {
+	if x != nil {
+		return x.DaysElapsed
+	}
+	return 0
+}
+
+func (x *BudgetForecast) GetDaysRemaining() int32 {
+	if x != nil {
+		return x.DaysRemaining
+	}
+	return 0
+}
+
+func (x *BudgetForecast) GetDailyBudget() float64 {
+	if x != nil {
+		return x.DailyBudget
+	}
+	return 0
+}
+
+func (x *BudgetForecast) GetDailyBurn() float64 {
+	if x != nil {
+		return x.DailyBurn
+	}
+	return 0
+}
+
+func (x *BudgetForecast) GetProjectedTotal() float64 {
+	if x != nil {
+		return x.ProjectedTotal
+	}
+	return 0
+}
+
+func (x *BudgetForecast) GetDaysOfBudgetLeft() float64 {
+	if x != nil {
+		return x.DaysOfBudgetLeft
+	}
+	return 0
+}
+
+func (x *BudgetForecast) GetDaily() []*DailySpend {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+type BudgetAnalysisResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TotalBudget       float64                `protobuf:"fixed64,1,opt,name=total_budget,json=totalBudget,proto3" json:"total_budget,omitempty"`
+	TotalSpent        float64                `protobuf:"fixed64,2,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`
+	Remaining         float64                `protobuf:"fixed64,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	CategoryBreakdown []*CategoryAnalysis    `protobuf:"bytes,4,rep,name=category_breakdown,json=categoryBreakdown,proto3" json:"category_breakdown,omitempty"`
+	Warnings          []string               `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Suggestions       []string               `protobuf:"bytes,6,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	Currency          string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Forecast          *BudgetForecast        `protobuf:"bytes,8,opt,name=forecast,proto3" json:"forecast,omitempty"` // Sadece start_date ve end_date verildiğinde dolu
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BudgetAnalysisResponse) Reset() {
+	*x = BudgetAnalysisResponse{}
+	mi := &file_proto_recomendation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetAnalysisResponse) ProtoMessage() {}
+
+func (x *BudgetAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_recomendation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*BudgetAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_recomendation_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BudgetAnalysisResponse) GetTotalBudget() float64 {
+	if x != nil {
+		return x.TotalBudget
+	}
+	return 0
+}
+
+func (x *BudgetAnalysisResponse) GetTotalSpent() float64 {
+	if x != nil {
+		return x.TotalSpent
+	}
+	return 0
+}
+
+func (x *BudgetAnalysisResponse) GetRemaining() float64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *BudgetAnalysisResponse) GetCategoryBreakdown() []*CategoryAnalysis {
+	if x != nil {
+		return x.CategoryBreakdown
+	}
+	return nil
+}
+
+func (x *BudgetAnalysisResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *BudgetAnalysisResponse) GetSuggestions() []string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *BudgetAnalysisResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BudgetAnalysisResponse) GetForecast() *BudgetForecast {
+	if x != nil {
+		return x.Forecast
+	}
+	return nil
+}
+
+var File_proto_recomendation_proto protoreflect.FileDescriptor
+
+const file_proto_recomendation_proto_rawDesc = "" +
+	"\n" +
+	"\x19proto/recomendation.proto\x12\x0erecommendation\"\xd3\x01\n" +
+	"\bTripInfo\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\rR\x06tripId\x12 \n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\x12\x16\n" +
+	"\x06budget\x18\x03 \x01(\x01R\x06budget\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\x12\x1e\n" +
+	"\n" +
+	"activities\x18\x06 \x03(\tR\n" +
+	"activities\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\"c\n" +
+	"\x0fUserTripHistory\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x127\n" +
+	"\n" +
+	"past_trips\x18\x02 \x03(\v2\x18.recommendation.TripInfoR\tpastTrips\"\xe2\x01\n" +
+	"\x15RecommendationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x123\n" +
+	"\x15preferred_destination\x18\x02 \x01(\tR\x14preferredDestination\x12\x1d\n" +
+	"\n" +
+	"max_budget\x18\x03 \x01(\x01R\tmaxBudget\x129\n" +
+	"\ahistory\x18\x04 \x01(\v2\x1f.recommendation.UserTripHistoryR\ahistory\x12!\n" +
+	"\ftravel_month\x18\x05 \x01(\x05R\vtravelMonth\"\x8e\x02\n" +
+	"\x0eRecommendation\x12 \n" +
+	"\vdestination\x18\x01 \x01(\tR\vdestination\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12)\n" +
+	"\x10estimated_budget\x18\x03 \x01(\x01R\x0festimatedBudget\x121\n" +
+	"\x14suggested_activities\x18\x04 \x03(\tR\x13suggestedActivities\x12\x1f\n" +
+	"\vbest_season\x18\x05 \x01(\tR\n" +
+	"bestSeason\x12\x1f\n" +
+	"\vmatch_score\x18\x06 \x01(\x01R\n" +
+	"matchScore\x12\x18\n" +
+	"\areasons\x18\a \x03(\tR\areasons\"|\n" +
+	"\x16RecommendationResponse\x12H\n" +
+	"\x0frecommendations\x18\x01 \x03(\v2\x1e.recommendation.RecommendationR\x0frecommendations\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa3\x02\n" +
+	"\rRefineRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x129\n" +
+	"\ahistory\x18\x02 \x01(\v2\x1f.recommendation.UserTripHistoryR\ahistory\x12\"\n" +
+	"\n" +
+	"max_budget\x18\x03 \x01(\x01H\x00R\tmaxBudget\x88\x01\x01\x128\n" +
+	"\x15preferred_destination\x18\x04 \x01(\tH\x01R\x14preferredDestination\x88\x01\x01\x12&\n" +
+	"\ftravel_month\x18\x05 \x01(\x05H\x02R\vtravelMonth\x88\x01\x01B\r\n" +
+	"\v_max_budgetB\x18\n" +
+	"\x16_preferred_destinationB\x0f\n" +
+	"\r_travel_month\"\x93\x02\n" +
+	"\x10ItineraryRequest\x12 \n" +
+	"\vdestination\x18\x01 \x01(\tR\vdestination\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x16\n" +
+	"\x06budget\x18\x04 \x01(\x01R\x06budget\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12,\n" +
+	"\x12activities_per_day\x18\x06 \x01(\x05R\x10activitiesPerDay\x12\x12\n" +
+	"\x04save\x18\a \x01(\bR\x04save\x12\x17\n" +
+	"\auser_id\x18\b \x01(\rR\x06userId\x12\x14\n" +
+	"\x05title\x18\t \x01(\tR\x05title\"\x86\x01\n" +
+	"\rItineraryItem\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12%\n" +
+	"\x0eestimated_cost\x18\x03 \x01(\x01R\restimatedCost\x12\x1e\n" +
+	"\n" +
+	"popularity\x18\x04 \x01(\x05R\n" +
+	"popularity\"\x94\x01\n" +
+	"\x10ItineraryDayPlan\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\x05R\x03day\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x123\n" +
+	"\x05items\x18\x03 \x03(\v2\x1d.recommendation.ItineraryItemR\x05items\x12%\n" +
+	"\x0eestimated_cost\x18\x04 \x01(\x01R\restimatedCost\"\xa2\x02\n" +
+	"\x11ItineraryResponse\x12 \n" +
+	"\vdestination\x18\x01 \x01(\tR\vdestination\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12!\n" +
+	"\ftotal_budget\x18\x03 \x01(\x01R\vtotalBudget\x12'\n" +
+	"\x0festimated_total\x18\x04 \x01(\x01R\x0eestimatedTotal\x124\n" +
+	"\x04days\x18\x05 \x03(\v2 .recommendation.ItineraryDayPlanR\x04days\x12\x1a\n" +
+	"\bwarnings\x18\x06 \x03(\tR\bwarnings\x12\x17\n" +
+	"\atrip_id\x18\a \x01(\rR\x06tripId\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\"\xd8\x02\n" +
+	"\x15BudgetAnalysisRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\rR\x06tripId\x12!\n" +
+	"\ftotal_budget\x18\x02 \x01(\x01R\vtotalBudget\x123\n" +
+	"\bexpenses\x18\x03 \x03(\v2\x17.recommendation.ExpenseR\bexpenses\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12I\n" +
+	"\x10category_budgets\x18\x05 \x03(\v2\x1e.recommendation.CategoryBudgetR\x0fcategoryBudgets\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\a \x01(\tR\aendDate\x12\x14\n" +
+	"\x05today\x18\b \x01(\tR\x05today\x12\x17\n" +
+	"\auser_id\x18\t \x01(\rR\x06userId\"D\n" +
+	"\x0eCategoryBudget\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\"\xa1\x01\n" +
+	"\aExpense\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12!\n" +
+	"\fexpense_date\x18\x04 \x01(\tR\vexpenseDate\x12#\n" +
+	"\rexchange_rate\x18\x05 \x01(\x01R\fexchangeRate\"\xec\x01\n" +
+	"\x10CategoryAnalysis\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1f\n" +
+	"\vtotal_spent\x18\x02 \x01(\x01R\n" +
+	"totalSpent\x12\x1e\n" +
 	"\n" +
 	"percentage\x18\x03 \x01(\x01R\n" +
 	"percentage\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status\"\x89\x02\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06budget\x18\x05 \x01(\x01R\x06budget\x12\x1c\n" +
+	"\tprojected\x18\x06 \x01(\x01R\tprojected\x12-\n" +
+	"\x13days_of_budget_left\x18\a \x01(\x01R\x10daysOfBudgetLeft\"8\n" +
+	"\n" +
+	"DailySpend\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\"\xc3\x02\n" +
+	"\x0eBudgetForecast\x12\x1b\n" +
+	"\ttrip_days\x18\x01 \x01(\x05R\btripDays\x12!\n" +
+	"\fdays_elapsed\x18\x02 \x01(\x05R\vdaysElapsed\x12%\n" +
+	"\x0edays_remaining\x18\x03 \x01(\x05R\rdaysRemaining\x12!\n" +
+	"\fdaily_budget\x18\x04 \x01(\x01R\vdailyBudget\x12\x1d\n" +
+	"\n" +
+	"daily_burn\x18\x05 \x01(\x01R\tdailyBurn\x12'\n" +
+	"\x0fprojected_total\x18\x06 \x01(\x01R\x0eprojectedTotal\x12-\n" +
+	"\x13days_of_budget_left\x18\a \x01(\x01R\x10daysOfBudgetLeft\x120\n" +
+	"\x05daily\x18\b \x03(\v2\x1a.recommendation.DailySpendR\x05daily\"\xe1\x02\n" +
 	"\x16BudgetAnalysisResponse\x12!\n" +
 	"\ftotal_budget\x18\x01 \x01(\x01R\vtotalBudget\x12\x1f\n" +
 	"\vtotal_spent\x18\x02 \x01(\x01R\n" +
@@ -684,10 +1520,16 @@ const file_proto_recomendation_proto_rawDesc = "" +
 	"\tremaining\x18\x03 \x01(\x01R\tremaining\x12O\n" +
 	"\x12category_breakdown\x18\x04 \x03(\v2 .recommendation.CategoryAnalysisR\x11categoryBreakdown\x12\x1a\n" +
 	"\bwarnings\x18\x05 \x03(\tR\bwarnings\x12 \n" +
-	"\vsuggestions\x18\x06 \x03(\tR\vsuggestions2\xe0\x01\n" +
+	"\vsuggestions\x18\x06 \x03(\tR\vsuggestions\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12:\n" +
+	"\bforecast\x18\b \x01(\v2\x1e.recommendation.BudgetForecastR\bforecast2\xf2\x04\n" +
 	"\x15RecommendationService\x12e\n" +
 	"\x12GetRecommendations\x12%.recommendation.RecommendationRequest\x1a&.recommendation.RecommendationResponse\"\x00\x12`\n" +
-	"\rAnalyzeBudget\x12%.recommendation.BudgetAnalysisRequest\x1a&.recommendation.BudgetAnalysisResponse\"\x00B&Z$travel-platform/proto/recommendationb\x06proto3"
+	"\rAnalyzeBudget\x12%.recommendation.BudgetAnalysisRequest\x1a&.recommendation.BudgetAnalysisResponse\"\x00\x12b\n" +
+	"\x15StreamRecommendations\x12%.recommendation.RecommendationRequest\x1a\x1e.recommendation.Recommendation\"\x000\x01\x12j\n" +
+	"\x15AnalyzeExpensesStream\x12%.recommendation.BudgetAnalysisRequest\x1a&.recommendation.BudgetAnalysisResponse\"\x00(\x01\x12d\n" +
+	"\x15RefineRecommendations\x12\x1d.recommendation.RefineRequest\x1a&.recommendation.RecommendationResponse\"\x00(\x010\x01\x12Z\n" +
+	"\x11GenerateItinerary\x12 .recommendation.ItineraryRequest\x1a!.recommendation.ItineraryResponse\"\x00B&Z$travel-platform/proto/recommendationb\x06proto3"
 
 var (
 	file_proto_recomendation_proto_rawDescOnce sync.Once
@@ -701,32 +1543,55 @@ func file_proto_recomendation_proto_rawDescGZIP() []byte {
 	return file_proto_recomendation_proto_rawDescData
 }
 
-var file_proto_recomendation_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_recomendation_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_proto_recomendation_proto_goTypes = []any{
 	(*TripInfo)(nil),               // 0: recommendation.TripInfo
 	(*UserTripHistory)(nil),        // 1: recommendation.UserTripHistory
 	(*RecommendationRequest)(nil),  // 2: recommendation.RecommendationRequest
 	(*Recommendation)(nil),         // 3: recommendation.Recommendation
 	(*RecommendationResponse)(nil), // 4: recommendation.RecommendationResponse
-	(*BudgetAnalysisRequest)(nil),  // 5: recommendation.BudgetAnalysisRequest
-	(*Expense)(nil),                // 6: recommendation.Expense
-	(*CategoryAnalysis)(nil),       // 7: recommendation.CategoryAnalysis
-	(*BudgetAnalysisResponse)(nil), // 8: recommendation.BudgetAnalysisResponse
+	(*RefineRequest)(nil),          // 5: recommendation.RefineRequest
+	(*ItineraryRequest)(nil),       // 6: recommendation.ItineraryRequest
+	(*ItineraryItem)(nil),          // 7: recommendation.ItineraryItem
+	(*ItineraryDayPlan)(nil),       // 8: recommendation.ItineraryDayPlan
+	(*ItineraryResponse)(nil),      // 9: recommendation.ItineraryResponse
+	(*BudgetAnalysisRequest)(nil),  // 10: recommendation.BudgetAnalysisRequest
+	(*CategoryBudget)(nil),         // 11: recommendation.CategoryBudget
+	(*Expense)(nil),                // 12: recommendation.Expense
+	(*CategoryAnalysis)(nil),       // 13: recommendation.CategoryAnalysis
+	(*DailySpend)(nil),             // 14: recommendation.DailySpend
+	(*BudgetForecast)(nil),         // 15: recommendation.BudgetForecast
+	(*BudgetAnalysisResponse)(nil), // 16: recommendation.BudgetAnalysisResponse
 }
 var file_proto_recomendation_proto_depIdxs = []int32{
-	0, // 0: recommendation.UserTripHistory.past_trips:type_name -> recommendation.TripInfo
-	3, // 1: recommendation.RecommendationResponse.recommendations:type_name -> recommendation.Recommendation
-	6, // 2: recommendation.BudgetAnalysisRequest.expenses:type_name -> recommendation.Expense
-	7, // 3: recommendation.BudgetAnalysisResponse.category_breakdown:type_name -> recommendation.CategoryAnalysis
-	2, // 4: recommendation.RecommendationService.GetRecommendations:input_type -> recommendation.RecommendationRequest
-	5, // 5: recommendation.RecommendationService.AnalyzeBudget:input_type -> recommendation.BudgetAnalysisRequest
-	4, // 6: recommendation.RecommendationService.GetRecommendations:output_type -> recommendation.RecommendationResponse
-	8, // 7: recommendation.RecommendationService.AnalyzeBudget:output_type -> recommendation.BudgetAnalysisResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: recommendation.UserTripHistory.past_trips:type_name -> recommendation.TripInfo
+	1,  // 1: recommendation.RecommendationRequest.history:type_name -> recommendation.UserTripHistory
+	3,  // 2: recommendation.RecommendationResponse.recommendations:type_name -> recommendation.Recommendation
+	1,  // 3: recommendation.RefineRequest.history:type_name -> recommendation.UserTripHistory
+	7,  // 4: recommendation.ItineraryDayPlan.items:type_name -> recommendation.ItineraryItem
+	8,  // 5: recommendation.ItineraryResponse.days:type_name -> recommendation.ItineraryDayPlan
+	12, // 6: recommendation.BudgetAnalysisRequest.expenses:type_name -> recommendation.Expense
+	11, // 7: recommendation.BudgetAnalysisRequest.category_budgets:type_name -> recommendation.CategoryBudget
+	14, // 8: recommendation.BudgetForecast.daily:type_name -> recommendation.DailySpend
+	13, // 9: recommendation.BudgetAnalysisResponse.category_breakdown:type_name -> recommendation.CategoryAnalysis
+	15, // 10: recommendation.BudgetAnalysisResponse.forecast:type_name -> recommendation.BudgetForecast
+	2,  // 11: recommendation.RecommendationService.GetRecommendations:input_type -> recommendation.RecommendationRequest
+	10, // 12: recommendation.RecommendationService.AnalyzeBudget:input_type -> recommendation.BudgetAnalysisRequest
+	2,  // 13: recommendation.RecommendationService.StreamRecommendations:input_type -> recommendation.RecommendationRequest
+	10, // 14: recommendation.RecommendationService.AnalyzeExpensesStream:input_type -> recommendation.BudgetAnalysisRequest
+	5,  // 15: recommendation.RecommendationService.RefineRecommendations:input_type -> recommendation.RefineRequest
+	6,  // 16: recommendation.RecommendationService.GenerateItinerary:input_type -> recommendation.ItineraryRequest
+	4,  // 17: recommendation.RecommendationService.GetRecommendations:output_type -> recommendation.RecommendationResponse
+	16, // 18: recommendation.RecommendationService.AnalyzeBudget:output_type -> recommendation.BudgetAnalysisResponse
+	3,  // 19: recommendation.RecommendationService.StreamRecommendations:output_type -> recommendation.Recommendation
+	16, // 20: recommendation.RecommendationService.AnalyzeExpensesStream:output_type -> recommendation.BudgetAnalysisResponse
+	4,  // 21: recommendation.RecommendationService.RefineRecommendations:output_type -> recommendation.RecommendationResponse
+	9,  // 22: recommendation.RecommendationService.GenerateItinerary:output_type -> recommendation.ItineraryResponse
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_proto_recomendation_proto_init() }
@@ -734,13 +1599,14 @@ func file_proto_recomendation_proto_init() {
 	if File_proto_recomendation_proto != nil {
 		return
 	}
+	file_proto_recomendation_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_recomendation_proto_rawDesc), len(file_proto_recomendation_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
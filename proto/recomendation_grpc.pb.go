@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RecommendationService_GetRecommendations_FullMethodName = "/recommendation.RecommendationService/GetRecommendations"
-	RecommendationService_AnalyzeBudget_FullMethodName      = "/recommendation.RecommendationService/AnalyzeBudget"
+	RecommendationService_GetRecommendations_FullMethodName    = "/recommendation.RecommendationService/GetRecommendations"
+	RecommendationService_AnalyzeBudget_FullMethodName         = "/recommendation.RecommendationService/AnalyzeBudget"
+	RecommendationService_StreamRecommendations_FullMethodName = "/recommendation.RecommendationService/StreamRecommendations"
+	RecommendationService_AnalyzeExpensesStream_FullMethodName = "/recommendation.RecommendationService/AnalyzeExpensesStream"
+	RecommendationService_RefineRecommendations_FullMethodName = "/recommendation.RecommendationService/RefineRecommendations"
+	RecommendationService_GenerateItinerary_FullMethodName     = "/recommendation.RecommendationService/GenerateItinerary"
 )
 
 // RecommendationServiceClient is the client API for RecommendationService service.
@@ -29,6 +33,14 @@ const (
 type RecommendationServiceClient interface {
 	GetRecommendations(ctx context.Context, in *RecommendationRequest, opts ...grpc.CallOption) (*RecommendationResponse, error)
 	AnalyzeBudget(ctx context.Context, in *BudgetAnalysisRequest, opts ...grpc.CallOption) (*BudgetAnalysisResponse, error)
+	// Öneriler puanlandıkça tek tek gönderilir (sırasız; önce topluluk gezileri, sonra katalog)
+	StreamRecommendations(ctx context.Context, in *RecommendationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Recommendation], error)
+	// Büyük harcama listeleri için: ilk mesaj gezi ve bütçe bilgisini taşır, sonraki mesajlardan sadece expenses okunur
+	AnalyzeExpensesStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[BudgetAnalysisRequest, BudgetAnalysisResponse], error)
+	// Her RefineRequest için güncel sıralama döner; geçmiş profil oturum başında bir kez oluşturulur
+	RefineRecommendations(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[RefineRequest, RecommendationResponse], error)
+	// Public gezilerde görülen aktivitelerden gün gün plan; istenirse yeni gezi olarak kaydedilir
+	GenerateItinerary(ctx context.Context, in *ItineraryRequest, opts ...grpc.CallOption) (*ItineraryResponse, error)
 }
 
 type recommendationServiceClient struct {
@@ -59,12 +71,75 @@ func (c *recommendationServiceClient) AnalyzeBudget(ctx context.Context, in *Bud
 	return out, nil
 }
 
+func (c *recommendationServiceClient) StreamRecommendations(ctx context.Context, in *RecommendationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Recommendation], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RecommendationService_ServiceDesc.Streams[0], RecommendationService_StreamRecommendations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[RecommendationRequest, Recommendation]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecommendationService_StreamRecommendationsClient = grpc.ServerStreamingClient[Recommendation]
+
+func (c *recommendationServiceClient) AnalyzeExpensesStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[BudgetAnalysisRequest, BudgetAnalysisResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RecommendationService_ServiceDesc.Streams[1], RecommendationService_AnalyzeExpensesStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BudgetAnalysisRequest, BudgetAnalysisResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecommendationService_AnalyzeExpensesStreamClient = grpc.ClientStreamingClient[BudgetAnalysisRequest, BudgetAnalysisResponse]
+
+func (c *recommendationServiceClient) RefineRecommendations(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[RefineRequest, RecommendationResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RecommendationService_ServiceDesc.Streams[2], RecommendationService_RefineRecommendations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[RefineRequest, RecommendationResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecommendationService_RefineRecommendationsClient = grpc.BidiStreamingClient[RefineRequest, RecommendationResponse]
+
+func (c *recommendationServiceClient) GenerateItinerary(ctx context.Context, in *ItineraryRequest, opts ...grpc.CallOption) (*ItineraryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ItineraryResponse)
+	err := c.cc.Invoke(ctx, RecommendationService_GenerateItinerary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RecommendationServiceServer is the server API for RecommendationService service.
 // All implementations must embed UnimplementedRecommendationServiceServer
 // for forward compatibility.
 type RecommendationServiceServer interface {
 	GetRecommendations(context.Context, *RecommendationRequest) (*RecommendationResponse, error)
 	AnalyzeBudget(context.Context, *BudgetAnalysisRequest) (*BudgetAnalysisResponse, error)
+	// Öneriler puanlandıkça tek tek gönderilir (sırasız; önce topluluk gezileri, sonra katalog)
+	StreamRecommendations(*RecommendationRequest, grpc.ServerStreamingServer[Recommendation]) error
+	// Büyük harcama listeleri için: ilk mesaj gezi ve bütçe bilgisini taşır, sonraki mesajlardan sadece expenses okunur
+	AnalyzeExpensesStream(grpc.ClientStreamingServer[BudgetAnalysisRequest, BudgetAnalysisResponse]) error
+	// Her RefineRequest için güncel sıralama döner; geçmiş profil oturum başında bir kez oluşturulur
+	RefineRecommendations(grpc.BidiStreamingServer[RefineRequest, RecommendationResponse]) error
+	// Public gezilerde görülen aktivitelerden gün gün plan; istenirse yeni gezi olarak kaydedilir
+	GenerateItinerary(context.Context, *ItineraryRequest) (*ItineraryResponse, error)
 	mustEmbedUnimplementedRecommendationServiceServer()
 }
 
@@ -81,6 +156,18 @@ func (UnimplementedRecommendationServiceServer) GetRecommendations(context.Conte
 func (UnimplementedRecommendationServiceServer) AnalyzeBudget(context.Context, *BudgetAnalysisRequest) (*BudgetAnalysisResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AnalyzeBudget not implemented")
 }
+func (UnimplementedRecommendationServiceServer) StreamRecommendations(*RecommendationRequest, grpc.ServerStreamingServer[Recommendation]) error {
+	return status.Error(codes.Unimplemented, "method StreamRecommendations not implemented")
+}
+func (UnimplementedRecommendationServiceServer) AnalyzeExpensesStream(grpc.ClientStreamingServer[BudgetAnalysisRequest, BudgetAnalysisResponse]) error {
+	return status.Error(codes.Unimplemented, "method AnalyzeExpensesStream not implemented")
+}
+func (UnimplementedRecommendationServiceServer) RefineRecommendations(grpc.BidiStreamingServer[RefineRequest, RecommendationResponse]) error {
+	return status.Error(codes.Unimplemented, "method RefineRecommendations not implemented")
+}
+func (UnimplementedRecommendationServiceServer) GenerateItinerary(context.Context, *ItineraryRequest) (*ItineraryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateItinerary not implemented")
+}
 func (UnimplementedRecommendationServiceServer) mustEmbedUnimplementedRecommendationServiceServer() {}
 func (UnimplementedRecommendationServiceServer) testEmbeddedByValue()                               {}
 
@@ -138,6 +225,49 @@ func _RecommendationService_AnalyzeBudget_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RecommendationService_StreamRecommendations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RecommendationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RecommendationServiceServer).StreamRecommendations(m, &grpc.GenericServerStream[RecommendationRequest, Recommendation]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecommendationService_StreamRecommendationsServer = grpc.ServerStreamingServer[Recommendation]
+
+func _RecommendationService_AnalyzeExpensesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RecommendationServiceServer).AnalyzeExpensesStream(&grpc.GenericServerStream[BudgetAnalysisRequest, BudgetAnalysisResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecommendationService_AnalyzeExpensesStreamServer = grpc.ClientStreamingServer[BudgetAnalysisRequest, BudgetAnalysisResponse]
+
+func _RecommendationService_RefineRecommendations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RecommendationServiceServer).RefineRecommendations(&grpc.GenericServerStream[RefineRequest, RecommendationResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecommendationService_RefineRecommendationsServer = grpc.BidiStreamingServer[RefineRequest, RecommendationResponse]
+
+func _RecommendationService_GenerateItinerary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItineraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecommendationServiceServer).GenerateItinerary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecommendationService_GenerateItinerary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecommendationServiceServer).GenerateItinerary(ctx, req.(*ItineraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RecommendationService_ServiceDesc is the grpc.ServiceDesc for RecommendationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,7 +283,28 @@ var RecommendationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnalyzeBudget",
 			Handler:    _RecommendationService_AnalyzeBudget_Handler,
 		},
+		{
+			MethodName: "GenerateItinerary",
+			Handler:    _RecommendationService_GenerateItinerary_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamRecommendations",
+			Handler:       _RecommendationService_StreamRecommendations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AnalyzeExpensesStream",
+			Handler:       _RecommendationService_AnalyzeExpensesStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "RefineRecommendations",
+			Handler:       _RecommendationService_RefineRecommendations_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/recomendation.proto",
 }
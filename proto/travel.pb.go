@@ -0,0 +1,1941 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: proto/travel.proto
+
+package recommendation
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Trip struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Destination   string                 `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	DestinationId uint32                 `protobuf:"varint,5,opt,name=destination_id,json=destinationId,proto3" json:"destination_id,omitempty"` // Katalogdaki destinasyon (0 = eşleşmedi)
+	StartDate     string                 `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`              // YYYY-MM-DD
+	EndDate       string                 `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Budget        float64                `protobuf:"fixed64,9,opt,name=budget,proto3" json:"budget,omitempty"`
+	Currency      string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // upcoming, in_progress, completed, cancelled
+	IsPublic      bool                   `protobuf:"varint,12,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,13,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64               `protobuf:"fixed64,14,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	Activities    []*TripActivity        `protobuf:"bytes,15,rep,name=activities,proto3" json:"activities,omitempty"`
+	Expenses      []*TripExpense         `protobuf:"bytes,16,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC 3339
+	UpdatedAt     string                 `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Trip) Reset() {
+	*x = Trip{}
+	mi := &file_proto_travel_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trip) ProtoMessage() {}
+
+func (x *Trip) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trip.ProtoReflect.Descriptor instead.
+func (*Trip) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Trip) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Trip) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Trip) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Trip) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Trip) GetDestinationId() uint32 {
+	if x != nil {
+		return x.DestinationId
+	}
+	return 0
+}
+
+func (x *Trip) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Trip) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Trip) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Trip) GetBudget() float64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *Trip) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Trip) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Trip) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *Trip) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *Trip) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *Trip) GetActivities() []*TripActivity {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+func (x *Trip) GetExpenses() []*TripExpense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *Trip) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Trip) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type TripActivity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TripId        uint32                 `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,6,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64               `protobuf:"fixed64,7,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	Date          string                 `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripActivity) Reset() {
+	*x = TripActivity{}
+	mi := &file_proto_travel_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripActivity) ProtoMessage() {}
+
+func (x *TripActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripActivity.ProtoReflect.Descriptor instead.
+func (*TripActivity) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TripActivity) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TripActivity) GetTripId() uint32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *TripActivity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TripActivity) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TripActivity) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *TripActivity) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *TripActivity) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *TripActivity) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type TripExpense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TripId        uint32                 `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExpenseDate   string                 `protobuf:"bytes,6,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"` // YYYY-MM-DD
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	ExchangeRate  float64                `protobuf:"fixed64,8,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"` // 1 currency = exchange_rate gezinin para birimi
+	HomeAmount    float64                `protobuf:"fixed64,9,opt,name=home_amount,json=homeAmount,proto3" json:"home_amount,omitempty"`       // Gezinin para birimindeki tutar
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripExpense) Reset() {
+	*x = TripExpense{}
+	mi := &file_proto_travel_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripExpense) ProtoMessage() {}
+
+func (x *TripExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripExpense.ProtoReflect.Descriptor instead.
+func (*TripExpense) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TripExpense) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TripExpense) GetTripId() uint32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *TripExpense) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TripExpense) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TripExpense) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TripExpense) GetExpenseDate() string {
+	if x != nil {
+		return x.ExpenseDate
+	}
+	return ""
+}
+
+func (x *TripExpense) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TripExpense) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *TripExpense) GetHomeAmount() float64 {
+	if x != nil {
+		return x.HomeAmount
+	}
+	return 0
+}
+
+// TripInput - Oluşturma ve güncellemede ortak gezi alanları
+type TripInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Destination   string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD; güncellemede boşsa değişmez
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Budget        float64                `protobuf:"fixed64,6,opt,name=budget,proto3" json:"budget,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"` // Boşsa EUR (güncellemede değişmez)
+	IsPublic      bool                   `protobuf:"varint,8,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,9,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"` // Verilmezse destinasyondan geocode edilir
+	Longitude     *float64               `protobuf:"fixed64,10,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripInput) Reset() {
+	*x = TripInput{}
+	mi := &file_proto_travel_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripInput) ProtoMessage() {}
+
+func (x *TripInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripInput.ProtoReflect.Descriptor instead.
+func (*TripInput) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TripInput) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TripInput) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *TripInput) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *TripInput) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *TripInput) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TripInput) GetBudget() float64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *TripInput) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TripInput) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *TripInput) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *TripInput) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+type ActivityInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,4,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64               `protobuf:"fixed64,5,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	Date          string                 `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD; güncellemede boşsa değişmez
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityInput) Reset() {
+	*x = ActivityInput{}
+	mi := &file_proto_travel_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityInput) ProtoMessage() {}
+
+func (x *ActivityInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityInput.ProtoReflect.Descriptor instead.
+func (*ActivityInput) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActivityInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ActivityInput) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ActivityInput) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ActivityInput) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *ActivityInput) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *ActivityInput) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type ExpenseInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // Kategori anahtarı veya adı
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`                          // Boşsa gezinin para birimi (güncellemede değişmez)
+	ExpenseDate   string                 `protobuf:"bytes,4,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"` // YYYY-MM-DD; güncellemede boşsa değişmez
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseInput) Reset() {
+	*x = ExpenseInput{}
+	mi := &file_proto_travel_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseInput) ProtoMessage() {}
+
+func (x *ExpenseInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseInput.ProtoReflect.Descriptor instead.
+func (*ExpenseInput) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExpenseInput) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ExpenseInput) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExpenseInput) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ExpenseInput) GetExpenseDate() string {
+	if x != nil {
+		return x.ExpenseDate
+	}
+	return ""
+}
+
+func (x *ExpenseInput) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateTripRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trip          *TripInput             `protobuf:"bytes,1,opt,name=trip,proto3" json:"trip,omitempty"`
+	Activities    []*ActivityInput       `protobuf:"bytes,2,rep,name=activities,proto3" json:"activities,omitempty"`
+	Expenses      []*ExpenseInput        `protobuf:"bytes,3,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTripRequest) Reset() {
+	*x = CreateTripRequest{}
+	mi := &file_proto_travel_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTripRequest) ProtoMessage() {}
+
+func (x *CreateTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTripRequest.ProtoReflect.Descriptor instead.
+func (*CreateTripRequest) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateTripRequest) GetTrip() *TripInput {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+func (x *CreateTripRequest) GetActivities() []*ActivityInput {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+func (x *CreateTripRequest) GetExpenses() []*ExpenseInput {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+type UpdateTripRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        uint32                 `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Trip          *TripInput             `protobuf:"bytes,2,opt,name=trip,proto3" json:"trip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTripRequest) Reset() {
+	*x = UpdateTripRequest{}
+	mi := &file_proto_travel_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTripRequest) ProtoMessage() {}
+
+func (x *UpdateTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTripRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTripRequest) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateTripRequest) GetTripId() uint32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *UpdateTripRequest) GetTrip() *TripInput {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+type TripRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        uint32                 `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripRequest) Reset() {
+	*x = TripRequest{}
+	mi := &file_proto_travel_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripRequest) ProtoMessage() {}
+
+func (x *TripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripRequest.ProtoReflect.Descriptor instead.
+func (*TripRequest) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TripRequest) GetTripId() uint32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+type ListTripsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []string               `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"` // Boşsa tüm geziler
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTripsRequest) Reset() {
+	*x = ListTripsRequest{}
+	mi := &file_proto_travel_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTripsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTripsRequest) ProtoMessage() {}
+
+func (x *ListTripsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTripsRequest.ProtoReflect.Descriptor instead.
+func (*ListTripsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListTripsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ListPublicTripsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Destination   string                 `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"` // Verilirse destinasyona göre arama
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPublicTripsRequest) Reset() {
+	*x = ListPublicTripsRequest{}
+	mi := &file_proto_travel_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPublicTripsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPublicTripsRequest) ProtoMessage() {}
+
+func (x *ListPublicTripsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPublicTripsRequest.ProtoReflect.Descriptor instead.
+func (*ListPublicTripsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListPublicTripsRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+type TripList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trips         []*Trip                `protobuf:"bytes,1,rep,name=trips,proto3" json:"trips,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripList) Reset() {
+	*x = TripList{}
+	mi := &file_proto_travel_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripList) ProtoMessage() {}
+
+func (x *TripList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripList.ProtoReflect.Descriptor instead.
+func (*TripList) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TripList) GetTrips() []*Trip {
+	if x != nil {
+		return x.Trips
+	}
+	return nil
+}
+
+type ActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        uint32                 `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	ActivityId    uint32                 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"` // Sadece güncellemede
+	Activity      *ActivityInput         `protobuf:"bytes,3,opt,name=activity,proto3" json:"activity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityRequest) Reset() {
+	*x = ActivityRequest{}
+	mi := &file_proto_travel_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityRequest) ProtoMessage() {}
+
+func (x *ActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityRequest.ProtoReflect.Descriptor instead.
+func (*ActivityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ActivityRequest) GetTripId() uint32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *ActivityRequest) GetActivityId() uint32 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *ActivityRequest) GetActivity() *ActivityInput {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+type ExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        uint32                 `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	ExpenseId     uint32                 `protobuf:"varint,2,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"` // Sadece güncellemede
+	Expense       *ExpenseInput          `protobuf:"bytes,3,opt,name=expense,proto3" json:"expense,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseRequest) Reset() {
+	*x = ExpenseRequest{}
+	mi := &file_proto_travel_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseRequest) ProtoMessage() {}
+
+func (x *ExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExpenseRequest) GetTripId() uint32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *ExpenseRequest) GetExpenseId() uint32 {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return 0
+}
+
+func (x *ExpenseRequest) GetExpense() *ExpenseInput {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+type TripItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        uint32                 `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	ItemId        uint32                 `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // Aktivite veya harcama
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripItemRequest) Reset() {
+	*x = TripItemRequest{}
+	mi := &file_proto_travel_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripItemRequest) ProtoMessage() {}
+
+func (x *TripItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripItemRequest.ProtoReflect.Descriptor instead.
+func (*TripItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TripItemRequest) GetTripId() uint32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *TripItemRequest) GetItemId() uint32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+type MessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageResponse) Reset() {
+	*x = MessageResponse{}
+	mi := &file_proto_travel_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageResponse) ProtoMessage() {}
+
+func (x *MessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageResponse.ProtoReflect.Descriptor instead.
+func (*MessageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName     string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_proto_travel_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *User) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *User) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+type RegisterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	FirstName     string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	mi := &file_proto_travel_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RegisterRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_proto_travel_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LoginRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                          // "authorization: Bearer <token>" olarak gönderilir
+	ExpiresAt     string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // RFC 3339
+	User          *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	mi := &file_proto_travel_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LoginResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_proto_travel_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{20}
+}
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_proto_travel_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{21}
+}
+
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FirstName     string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_proto_travel_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateProfileRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_proto_travel_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{23}
+}
+
+type UserList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserList) Reset() {
+	*x = UserList{}
+	mi := &file_proto_travel_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserList) ProtoMessage() {}
+
+func (x *UserList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_travel_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserList.ProtoReflect.Descriptor instead.
+func (*UserList) Descriptor() ([]byte, []int) {
+	return file_proto_travel_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UserList) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+var File_proto_travel_proto protoreflect.FileDescriptor
+
+const file_proto_travel_proto_rawDesc = "" +
+	"\n" +
+	"\x12proto/travel.proto\x12\x06travel\"\xd7\x04\n" +
+	"\x04Trip\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdestination\x18\x04 \x01(\tR\vdestination\x12%\n" +
+	"\x0edestination_id\x18\x05 \x01(\rR\rdestinationId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\a \x01(\tR\aendDate\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12\x16\n" +
+	"\x06budget\x18\t \x01(\x01R\x06budget\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1b\n" +
+	"\tis_public\x18\f \x01(\bR\bisPublic\x12\x1f\n" +
+	"\blatitude\x18\r \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x0e \x01(\x01H\x01R\tlongitude\x88\x01\x01\x124\n" +
+	"\n" +
+	"activities\x18\x0f \x03(\v2\x14.travel.TripActivityR\n" +
+	"activities\x12/\n" +
+	"\bexpenses\x18\x10 \x03(\v2\x13.travel.TripExpenseR\bexpenses\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x12 \x01(\tR\tupdatedAtB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\xfc\x01\n" +
+	"\fTripActivity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\rR\x06tripId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12\x1f\n" +
+	"\blatitude\x18\x06 \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\a \x01(\x01H\x01R\tlongitude\x88\x01\x01\x12\x12\n" +
+	"\x04date\x18\b \x01(\tR\x04dateB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\x91\x02\n" +
+	"\vTripExpense\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\rR\x06tripId\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12!\n" +
+	"\fexpense_date\x18\x06 \x01(\tR\vexpenseDate\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12#\n" +
+	"\rexchange_rate\x18\b \x01(\x01R\fexchangeRate\x12\x1f\n" +
+	"\vhome_amount\x18\t \x01(\x01R\n" +
+	"homeAmount\"\xcf\x02\n" +
+	"\tTripInput\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06budget\x18\x06 \x01(\x01R\x06budget\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x1b\n" +
+	"\tis_public\x18\b \x01(\bR\bisPublic\x12\x1f\n" +
+	"\blatitude\x18\t \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\n" +
+	" \x01(\x01H\x01R\tlongitude\x88\x01\x01B\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\xd4\x01\n" +
+	"\rActivityInput\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12\x1f\n" +
+	"\blatitude\x18\x04 \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x05 \x01(\x01H\x01R\tlongitude\x88\x01\x01\x12\x12\n" +
+	"\x04date\x18\x06 \x01(\tR\x04dateB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\xa3\x01\n" +
+	"\fExpenseInput\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12!\n" +
+	"\fexpense_date\x18\x04 \x01(\tR\vexpenseDate\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\xa3\x01\n" +
+	"\x11CreateTripRequest\x12%\n" +
+	"\x04trip\x18\x01 \x01(\v2\x11.travel.TripInputR\x04trip\x125\n" +
+	"\n" +
+	"activities\x18\x02 \x03(\v2\x15.travel.ActivityInputR\n" +
+	"activities\x120\n" +
+	"\bexpenses\x18\x03 \x03(\v2\x14.travel.ExpenseInputR\bexpenses\"S\n" +
+	"\x11UpdateTripRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\rR\x06tripId\x12%\n" +
+	"\x04trip\x18\x02 \x01(\v2\x11.travel.TripInputR\x04trip\"&\n" +
+	"\vTripRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\rR\x06tripId\".\n" +
+	"\x10ListTripsRequest\x12\x1a\n" +
+	"\bstatuses\x18\x01 \x03(\tR\bstatuses\":\n" +
+	"\x16ListPublicTripsRequest\x12 \n" +
+	"\vdestination\x18\x01 \x01(\tR\vdestination\".\n" +
+	"\bTripList\x12\"\n" +
+	"\x05trips\x18\x01 \x03(\v2\f.travel.TripR\x05trips\"~\n" +
+	"\x0fActivityRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\rR\x06tripId\x12\x1f\n" +
+	"\vactivity_id\x18\x02 \x01(\rR\n" +
+	"activityId\x121\n" +
+	"\bactivity\x18\x03 \x01(\v2\x15.travel.ActivityInputR\bactivity\"x\n" +
+	"\x0eExpenseRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\rR\x06tripId\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x02 \x01(\rR\texpenseId\x12.\n" +
+	"\aexpense\x18\x03 \x01(\v2\x14.travel.ExpenseInputR\aexpense\"C\n" +
+	"\x0fTripItemRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\rR\x06tripId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\rR\x06itemId\"+\n" +
+	"\x0fMessageResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"h\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x03 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x04 \x01(\tR\blastName\"\x7f\n" +
+	"\x0fRegisterRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x03 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x04 \x01(\tR\blastName\"@\n" +
+	"\fLoginRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"f\n" +
+	"\rLoginResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tR\texpiresAt\x12 \n" +
+	"\x04user\x18\x03 \x01(\v2\f.travel.UserR\x04user\"\x0f\n" +
+	"\rLogoutRequest\"\x13\n" +
+	"\x11GetProfileRequest\"R\n" +
+	"\x14UpdateProfileRequest\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x01 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x02 \x01(\tR\blastName\"\x12\n" +
+	"\x10ListUsersRequest\".\n" +
+	"\bUserList\x12\"\n" +
+	"\x05users\x18\x01 \x03(\v2\f.travel.UserR\x05users2\xfc\x05\n" +
+	"\vTripService\x127\n" +
+	"\n" +
+	"CreateTrip\x12\x19.travel.CreateTripRequest\x1a\f.travel.Trip\"\x00\x12.\n" +
+	"\aGetTrip\x12\x13.travel.TripRequest\x1a\f.travel.Trip\"\x00\x12;\n" +
+	"\vListMyTrips\x12\x18.travel.ListTripsRequest\x1a\x10.travel.TripList\"\x00\x12E\n" +
+	"\x0fListPublicTrips\x12\x1e.travel.ListPublicTripsRequest\x1a\x10.travel.TripList\"\x00\x127\n" +
+	"\n" +
+	"UpdateTrip\x12\x19.travel.UpdateTripRequest\x1a\f.travel.Trip\"\x00\x12<\n" +
+	"\n" +
+	"DeleteTrip\x12\x13.travel.TripRequest\x1a\x17.travel.MessageResponse\"\x00\x12>\n" +
+	"\vAddActivity\x12\x17.travel.ActivityRequest\x1a\x14.travel.TripActivity\"\x00\x12A\n" +
+	"\x0eUpdateActivity\x12\x17.travel.ActivityRequest\x1a\x14.travel.TripActivity\"\x00\x12D\n" +
+	"\x0eDeleteActivity\x12\x17.travel.TripItemRequest\x1a\x17.travel.MessageResponse\"\x00\x12;\n" +
+	"\n" +
+	"AddExpense\x12\x16.travel.ExpenseRequest\x1a\x13.travel.TripExpense\"\x00\x12>\n" +
+	"\rUpdateExpense\x12\x16.travel.ExpenseRequest\x1a\x13.travel.TripExpense\"\x00\x12C\n" +
+	"\rDeleteExpense\x12\x17.travel.TripItemRequest\x1a\x17.travel.MessageResponse\"\x002\xe9\x02\n" +
+	"\vUserService\x123\n" +
+	"\bRegister\x12\x17.travel.RegisterRequest\x1a\f.travel.User\"\x00\x126\n" +
+	"\x05Login\x12\x14.travel.LoginRequest\x1a\x15.travel.LoginResponse\"\x00\x12:\n" +
+	"\x06Logout\x12\x15.travel.LogoutRequest\x1a\x17.travel.MessageResponse\"\x00\x127\n" +
+	"\n" +
+	"GetProfile\x12\x19.travel.GetProfileRequest\x1a\f.travel.User\"\x00\x12=\n" +
+	"\rUpdateProfile\x12\x1c.travel.UpdateProfileRequest\x1a\f.travel.User\"\x00\x129\n" +
+	"\tListUsers\x12\x18.travel.ListUsersRequest\x1a\x10.travel.UserList\"\x00B&Z$travel-platform/proto/recommendationb\x06proto3"
+
+var (
+	file_proto_travel_proto_rawDescOnce sync.Once
+	file_proto_travel_proto_rawDescData []byte
+)
+
+func file_proto_travel_proto_rawDescGZIP() []byte {
+	file_proto_travel_proto_rawDescOnce.Do(func() {
+		file_proto_travel_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_travel_proto_rawDesc), len(file_proto_travel_proto_rawDesc)))
+	})
+	return file_proto_travel_proto_rawDescData
+}
+
+var file_proto_travel_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_proto_travel_proto_goTypes = []any{
+	(*Trip)(nil),                   // 0: travel.Trip
+	(*TripActivity)(nil),           // 1: travel.TripActivity
+	(*TripExpense)(nil),            // 2: travel.TripExpense
+	(*TripInput)(nil),              // 3: travel.TripInput
+	(*ActivityInput)(nil),          // 4: travel.ActivityInput
+	(*ExpenseInput)(nil),           // 5: travel.ExpenseInput
+	(*CreateTripRequest)(nil),      // 6: travel.CreateTripRequest
+	(*UpdateTripRequest)(nil),      // 7: travel.UpdateTripRequest
+	(*TripRequest)(nil),            // 8: travel.TripRequest
+	(*ListTripsRequest)(nil),       // 9: travel.ListTripsRequest
+	(*ListPublicTripsRequest)(nil), // 10: travel.ListPublicTripsRequest
+	(*TripList)(nil),               // 11: travel.TripList
+	(*ActivityRequest)(nil),        // 12: travel.ActivityRequest
+	(*ExpenseRequest)(nil),         // 13: travel.ExpenseRequest
+	(*TripItemRequest)(nil),        // 14: travel.TripItemRequest
+	(*MessageResponse)(nil),        // 15: travel.MessageResponse
+	(*User)(nil),                   // 16: travel.User
+	(*RegisterRequest)(nil),        // 17: travel.RegisterRequest
+	(*LoginRequest)(nil),           // 18: travel.LoginRequest
+	(*LoginResponse)(nil),          // 19: travel.LoginResponse
+	(*LogoutRequest)(nil),          // 20: travel.LogoutRequest
+	(*GetProfileRequest)(nil),      // 21: travel.GetProfileRequest
+	(*UpdateProfileRequest)(nil),   // 22: travel.UpdateProfileRequest
+	(*ListUsersRequest)(nil),       // 23: travel.ListUsersRequest
+	(*UserList)(nil),               // 24: travel.UserList
+}
+var file_proto_travel_proto_depIdxs = []int32{
+	1,  // 0: travel.Trip.activities:type_name -> travel.TripActivity
+	2,  // 1: travel.Trip.expenses:type_name -> travel.TripExpense
+	3,  // 2: travel.CreateTripRequest.trip:type_name -> travel.TripInput
+	4,  // 3: travel.CreateTripRequest.activities:type_name -> travel.ActivityInput
+	5,  // 4: travel.CreateTripRequest.expenses:type_name -> travel.ExpenseInput
+	3,  // 5: travel.UpdateTripRequest.trip:type_name -> travel.TripInput
+	0,  // 6: travel.TripList.trips:type_name -> travel.Trip
+	4,  // 7: travel.ActivityRequest.activity:type_name -> travel.ActivityInput
+	5,  // 8: travel.ExpenseRequest.expense:type_name -> travel.ExpenseInput
+	16, // 9: travel.LoginResponse.user:type_name -> travel.User
+	16, // 10: travel.UserList.users:type_name -> travel.User
+	6,  // 11: travel.TripService.CreateTrip:input_type -> travel.CreateTripRequest
+	8,  // 12: travel.TripService.GetTrip:input_type -> travel.TripRequest
+	9,  // 13: travel.TripService.ListMyTrips:input_type -> travel.ListTripsRequest
+	10, // 14: travel.TripService.ListPublicTrips:input_type -> travel.ListPublicTripsRequest
+	7,  // 15: travel.TripService.UpdateTrip:input_type -> travel.UpdateTripRequest
+	8,  // 16: travel.TripService.DeleteTrip:input_type -> travel.TripRequest
+	12, // 17: travel.TripService.AddActivity:input_type -> travel.ActivityRequest
+	12, // 18: travel.TripService.UpdateActivity:input_type -> travel.ActivityRequest
+	14, // 19: travel.TripService.DeleteActivity:input_type -> travel.TripItemRequest
+	13, // 20: travel.TripService.AddExpense:input_type -> travel.ExpenseRequest
+	13, // 21: travel.TripService.UpdateExpense:input_type -> travel.ExpenseRequest
+	14, // 22: travel.TripService.DeleteExpense:input_type -> travel.TripItemRequest
+	17, // 23: travel.UserService.Register:input_type -> travel.RegisterRequest
+	18, // 24: travel.UserService.Login:input_type -> travel.LoginRequest
+	20, // 25: travel.UserService.Logout:input_type -> travel.LogoutRequest
+	21, // 26: travel.UserService.GetProfile:input_type -> travel.GetProfileRequest
+	22, // 27: travel.UserService.UpdateProfile:input_type -> travel.UpdateProfileRequest
+	23, // 28: travel.UserService.ListUsers:input_type -> travel.ListUsersRequest
+	0,  // 29: travel.TripService.CreateTrip:output_type -> travel.Trip
+	0,  // 30: travel.TripService.GetTrip:output_type -> travel.Trip
+	11, // 31: travel.TripService.ListMyTrips:output_type -> travel.TripList
+	11, // 32: travel.TripService.ListPublicTrips:output_type -> travel.TripList
+	0,  // 33: travel.TripService.UpdateTrip:output_type -> travel.Trip
+	15, // 34: travel.TripService.DeleteTrip:output_type -> travel.MessageResponse
+	1,  // 35: travel.TripService.AddActivity:output_type -> travel.TripActivity
+	1,  // 36: travel.TripService.UpdateActivity:output_type -> travel.TripActivity
+	15, // 37: travel.TripService.DeleteActivity:output_type -> travel.MessageResponse
+	2,  // 38: travel.TripService.AddExpense:output_type -> travel.TripExpense
+	2,  // 39: travel.TripService.UpdateExpense:output_type -> travel.TripExpense
+	15, // 40: travel.TripService.DeleteExpense:output_type -> travel.MessageResponse
+	16, // 41: travel.UserService.Register:output_type -> travel.User
+	19, // 42: travel.UserService.Login:output_type -> travel.LoginResponse
+	15, // 43: travel.UserService.Logout:output_type -> travel.MessageResponse
+	16, // 44: travel.UserService.GetProfile:output_type -> travel.User
+	16, // 45: travel.UserService.UpdateProfile:output_type -> travel.User
+	24, // 46: travel.UserService.ListUsers:output_type -> travel.UserList
+	29, // [29:47] is the sub-list for method output_type
+	11, // [11:29] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_proto_travel_proto_init() }
+func file_proto_travel_proto_init() {
+	if File_proto_travel_proto != nil {
+		return
+	}
+	file_proto_travel_proto_msgTypes[0].OneofWrappers = []any{}
+	file_proto_travel_proto_msgTypes[1].OneofWrappers = []any{}
+	file_proto_travel_proto_msgTypes[3].OneofWrappers = []any{}
+	file_proto_travel_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_travel_proto_rawDesc), len(file_proto_travel_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_proto_travel_proto_goTypes,
+		DependencyIndexes: file_proto_travel_proto_depIdxs,
+		MessageInfos:      file_proto_travel_proto_msgTypes,
+	}.Build()
+	File_proto_travel_proto = out.File
+	file_proto_travel_proto_goTypes = nil
+	file_proto_travel_proto_depIdxs = nil
+}
@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"bytes"
+	"testing"
+	"time"
+	"travel-platform/internal/backup"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchive_WriteRead(t *testing.T) {
+	archive := backup.NewArchive()
+	archive.Trips = append(archive.Trips, backup.NewTripRecord(models.Trip{
+		ID:          3,
+		Title:       "Lisbon",
+		Destination: "Lisbon",
+		Activities:  []models.Activity{{ID: 10, Name: "Tram 28"}},
+	}, nil))
+	archive.AddAttachment("expense", 4, "receipt.pdf", "application/pdf", []byte("%PDF"))
+
+	var buf bytes.Buffer
+	assert.NoError(t, backup.Write(&buf, archive))
+
+	restored, err := backup.Read(buf.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, backup.Version, restored.Manifest.Version)
+	assert.Equal(t, 1, restored.Manifest.TripCount)
+	assert.Equal(t, "Tram 28", restored.Trips[0].Activities[0].Name)
+	assert.Equal(t, []byte("%PDF"), restored.Files["attachments/expense-4/receipt.pdf"])
+
+	t.Run("Not a zip", func(t *testing.T) {
+		_, err := backup.Read([]byte("nope"))
+		assert.Error(t, err)
+	})
+}
+
+func TestBackupService_RestoreRemapsIDs(t *testing.T) {
+	db := setupTestDB(t)
+	assert.NoError(t, db.AutoMigrate(&models.Trip{}, &models.Activity{}, &models.Expense{}, &models.ChatRoom{}, &models.ChatMessage{}, &models.TripAuditEntry{}, &models.Receipt{}))
+
+	userRepo := repository.NewUserRepository(db)
+	chatRepo := repository.NewChatRepository(db)
+	tripService := services.NewTripService(repository.NewTripRepository(db), repository.NewAuditRepository(db), currency.DefaultTable())
+	service := services.NewBackupService(tripService, setupReceiptService(t, db), chatRepo, userRepo)
+
+	user := &models.User{Email: "backup@test.com", FirstName: "Ada", LastName: "Lovelace", Password: "x"}
+	assert.NoError(t, userRepo.CreateUser(user))
+
+	day := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	archive := backup.NewArchive()
+	archive.Trips = []backup.TripRecord{{
+		ID:          99,
+		Title:       "Rome",
+		Destination: "Rome",
+		StartDate:   day,
+		EndDate:     day.AddDate(0, 0, 3),
+		Activities:  []backup.ActivityRecord{{ID: 500, Name: "Colosseum", Date: day}},
+		Expenses:    []backup.ExpenseRecord{{ID: 700, Category: "food", Amount: 12, Currency: "EUR", ExpenseDate: day}},
+		Chat:        []backup.ChatRecord{{AuthorID: 42, AuthorName: "Grace Hopper", Message: "Ciao!", CreatedAt: day}},
+	}}
+
+	result, err := service.Restore(user.ID, archive)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Trips)
+	assert.Equal(t, 1, result.Messages)
+	assert.Empty(t, result.Errors)
+
+	newTripID := result.IDMap.Trips[99]
+	assert.NotZero(t, newTripID)
+	assert.NotZero(t, result.IDMap.Activities[500])
+	assert.NotZero(t, result.IDMap.Expenses[700])
+
+	room, err := chatRepo.GetRoomByTripID(newTripID)
+	assert.NoError(t, err)
+	messages, _ := chatRepo.GetMessagesByRoomID(room.ID)
+	assert.Equal(t, "[Grace Hopper] Ciao!", messages[0].Message)
+	assert.Equal(t, user.ID, messages[0].UserID)
+}
@@ -0,0 +1,181 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/grpc"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/services"
+	pb "travel-platform/proto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// forecastSpends - 10 günlük gezinin 4. günündeki harcamalar:
+// gezi öncesi konaklama ve ileri tarihli harcama hıza girmez
+func forecastSpends() []services.Spend {
+	return []services.Spend{
+		{Category: "lodging", Date: day("2025-05-20"), Amount: 400},
+		{Category: "food", Date: day("2025-06-01"), Amount: 40},
+		{Category: "food", Date: day("2025-06-02"), Amount: 40},
+		{Category: "transport", Date: day("2025-06-03"), Amount: 80},
+		{Category: "food", Date: day("2025-06-08"), Amount: 20},
+	}
+}
+
+func TestForecastBudget(t *testing.T) {
+	budgets := map[string]float64{"food": 150, "transport": 60, "museums": 50}
+	forecast := services.ForecastBudget(day("2025-06-01"), day("2025-06-10"), day("2025-06-04"), 1000, budgets, forecastSpends())
+
+	assert.Equal(t, 10, forecast.TripDays)
+	assert.Equal(t, 4, forecast.DaysElapsed)
+	assert.Equal(t, 6, forecast.DaysRemaining)
+	assert.Equal(t, 100.0, forecast.DailyBudget)
+	assert.Equal(t, 580.0, forecast.Spent)
+	assert.Equal(t, 40.0, forecast.DailyBurn)
+	assert.Equal(t, 820.0, forecast.Projected)
+	assert.Equal(t, 10.5, forecast.DaysOfBudgetLeft)
+	assert.Equal(t, []services.DailySpend{
+		{Date: "2025-05-20", Amount: 400}, {Date: "2025-06-01", Amount: 40}, {Date: "2025-06-02", Amount: 40},
+		{Date: "2025-06-03", Amount: 80}, {Date: "2025-06-08", Amount: 20},
+	}, forecast.Daily)
+
+	assert.Equal(t, []services.CategoryForecast{
+		{Category: "food", Budget: 150, Spent: 100, Remaining: 50, DailyBurn: 20, Projected: 220, DaysOfBudgetLeft: 2.5, Status: services.BudgetAtRisk},
+		{Category: "lodging", Spent: 400, Projected: 400, DaysOfBudgetLeft: -1},
+		{Category: "museums", Budget: 50, Remaining: 50, DaysOfBudgetLeft: -1, Status: services.BudgetOnTrack},
+		{Category: "transport", Budget: 60, Spent: 80, Remaining: -20, DailyBurn: 20, Projected: 200, DaysOfBudgetLeft: 0, Status: services.BudgetExceeded},
+	}, forecast.Categories)
+
+	t.Run("Before the trip nothing counts toward the burn rate", func(t *testing.T) {
+		forecast := services.ForecastBudget(day("2025-06-01"), day("2025-06-10"), day("2025-05-25"), 1000, nil, forecastSpends())
+		assert.Equal(t, 0, forecast.DaysElapsed)
+		assert.Equal(t, 10, forecast.DaysRemaining)
+		assert.Zero(t, forecast.DailyBurn)
+		assert.Equal(t, 580.0, forecast.Projected)
+		assert.Equal(t, -1.0, forecast.DaysOfBudgetLeft)
+	})
+
+	t.Run("After the trip the projection is the total spent", func(t *testing.T) {
+		forecast := services.ForecastBudget(day("2025-06-01"), day("2025-06-10"), day("2025-07-01"), 1000, nil, forecastSpends())
+		assert.Equal(t, 10, forecast.DaysElapsed)
+		assert.Zero(t, forecast.DaysRemaining)
+		assert.Equal(t, 18.0, forecast.DailyBurn)
+		assert.Equal(t, 580.0, forecast.Projected)
+	})
+}
+
+func TestBudgetService_CategoryBudgets(t *testing.T) {
+	db, tripService := setupTrashService(t)
+	trip := createTrashTrip(t, tripService, 1)
+	trip.Budget = 200
+	service := services.NewBudgetService(repository.NewCategoryBudgetRepository(db))
+
+	saved, err := service.SetCategoryBudgets(trip, []models.CategoryBudget{{Category: " Food ", Amount: 120}, {Category: "transport", Amount: 50}})
+	assert.NoError(t, err)
+	assert.Len(t, saved, 2)
+	assert.Equal(t, "food", saved[0].Category)
+
+	t.Run("Invalid budgets are rejected and the saved ones kept", func(t *testing.T) {
+		for name, budgets := range map[string][]models.CategoryBudget{
+			"empty category":   {{Category: " ", Amount: 10}},
+			"zero amount":      {{Category: "food", Amount: 0}},
+			"duplicate":        {{Category: "food", Amount: 10}, {Category: "FOOD", Amount: 10}},
+			"over trip budget": {{Category: "food", Amount: 150}, {Category: "transport", Amount: 60}},
+		} {
+			_, err := service.SetCategoryBudgets(trip, budgets)
+			assert.Error(t, err, name)
+		}
+		budgets, _ := service.GetCategoryBudgets(trip.ID)
+		assert.Len(t, budgets, 2)
+	})
+
+	t.Run("Forecast uses the saved budgets", func(t *testing.T) {
+		forecast, err := service.Forecast(trip, day("2025-06-02"))
+		assert.NoError(t, err)
+		assert.Equal(t, "EUR", forecast.Currency)
+		assert.Equal(t, 20.0, forecast.Spent)
+		assert.Equal(t, 10.0, forecast.DailyBurn)
+		assert.Equal(t, 40.0, forecast.Projected)
+		assert.Equal(t, 18.0, forecast.DaysOfBudgetLeft)
+		assert.Equal(t, "food", forecast.Categories[0].Category)
+		assert.Equal(t, services.BudgetOnTrack, forecast.Categories[0].Status)
+	})
+
+	t.Run("Empty list removes all budgets", func(t *testing.T) {
+		saved, err := service.SetCategoryBudgets(trip, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, saved)
+	})
+}
+
+func TestAnalyzeBudget_Forecast(t *testing.T) {
+	server := grpc.NewRecommendationServer(new(MockTripService), nil, setupCategoryService(t), setupDestinationService(t), currency.DefaultTable())
+
+	var expenses []*pb.Expense
+	for _, spend := range forecastSpends() {
+		expenses = append(expenses, &pb.Expense{Category: spend.Category, Amount: spend.Amount, Currency: "EUR", ExpenseDate: spend.Date.Format("2006-01-02")})
+	}
+	req := &pb.BudgetAnalysisRequest{
+		TripId:          1,
+		TotalBudget:     700,
+		Expenses:        expenses,
+		CategoryBudgets: []*pb.CategoryBudget{{Category: "food", Amount: 150}, {Category: "transport", Amount: 60}},
+		StartDate:       "2025-06-01",
+		EndDate:         "2025-06-10",
+		Today:           "2025-06-04",
+	}
+
+	resp, err := server.AnalyzeBudget(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, 580.0, resp.TotalSpent)
+
+	assert.NotNil(t, resp.Forecast)
+	assert.Equal(t, int32(6), resp.Forecast.DaysRemaining)
+	assert.Equal(t, 40.0, resp.Forecast.DailyBurn)
+	assert.Equal(t, 820.0, resp.Forecast.ProjectedTotal)
+	assert.Equal(t, 3.0, resp.Forecast.DaysOfBudgetLeft)
+	assert.Len(t, resp.Forecast.Daily, 5)
+
+	categories := make(map[string]*pb.CategoryAnalysis)
+	for _, c := range resp.CategoryBreakdown {
+		categories[c.Category] = c
+	}
+	assert.Equal(t, "warning", categories["food"].Status)
+	assert.Equal(t, 220.0, categories["food"].Projected)
+	assert.Equal(t, 2.5, categories["food"].DaysOfBudgetLeft)
+	assert.Equal(t, "exceeded", categories["transport"].Status)
+	assert.Equal(t, "good", categories["lodging"].Status) // bütçesiz kategori ideal oranlarla değerlendirilir
+	assert.Zero(t, categories["lodging"].Budget)
+
+	// Mevcut uyarılar korunur, tahmin uyarıları eklenir
+	assert.Equal(t, []string{
+		"⚠️ You've spent 75% of your budget.",
+		"📈 At 40.00 per day you're on track to spend 820.00, over your budget of 700.00.",
+		"⏳ Your budget will last about 3.0 more days, but 6 days of the trip remain.",
+		"⚠️ food is on track to reach 220.00, over its budget of 150.00.",
+		"🚨 transport spending (80.00) is over its budget of 60.00.",
+	}, resp.Warnings)
+
+	t.Run("Without trip dates no forecast is returned", func(t *testing.T) {
+		req := &pb.BudgetAnalysisRequest{TripId: 1, TotalBudget: 700, Expenses: expenses, CategoryBudgets: req.CategoryBudgets}
+		resp, err := server.AnalyzeBudget(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Nil(t, resp.Forecast)
+		for _, c := range resp.CategoryBreakdown {
+			assert.Equal(t, c.TotalSpent, c.Projected)
+			assert.Equal(t, -1.0, c.DaysOfBudgetLeft)
+		}
+	})
+
+	t.Run("Invalid dates", func(t *testing.T) {
+		for _, dates := range [][2]string{{"2025-06-01", ""}, {"2025-06-10", "2025-06-01"}, {"June 1", "2025-06-10"}} {
+			_, err := server.AnalyzeBudget(context.Background(), &pb.BudgetAnalysisRequest{
+				TripId: 1, TotalBudget: 700, Expenses: expenses, StartDate: dates[0], EndDate: dates[1],
+			})
+			assert.Error(t, err, dates)
+		}
+	})
+}
@@ -0,0 +1,202 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/grpc"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/services"
+	pb "travel-platform/proto"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setupCategoryService(t *testing.T) services.CategoryService {
+	db := setupTestDB(t)
+	assert.NoError(t, db.AutoMigrate(&models.Trip{}, &models.Expense{}, &models.CategoryBudget{}, &models.ExpenseCategory{}))
+	return services.NewCategoryService(repository.NewExpenseCategoryRepository(db))
+}
+
+func TestCategoryService_CreateAndValidate(t *testing.T) {
+	service := setupCategoryService(t)
+
+	categories, err := service.GetCategories(1)
+	assert.NoError(t, err)
+	assert.Len(t, categories, len(services.DefaultCategories))
+
+	ski := &models.ExpenseCategory{Name: " Ski Passes ", Color: "#1E90FF", Icon: "fa-skiing"}
+	assert.NoError(t, service.CreateCategory(1, ski))
+	assert.Equal(t, "ski-passes", ski.Key)
+	assert.Equal(t, "Ski Passes", ski.Name)
+	assert.Equal(t, "#1e90ff", ski.Color)
+	assert.Equal(t, "skiing", ski.Icon)
+
+	t.Run("Defaults for color and icon", func(t *testing.T) {
+		c := &models.ExpenseCategory{Name: "Gifts"}
+		assert.NoError(t, service.CreateCategory(1, c))
+		assert.Equal(t, "#6b7280", c.Color)
+		assert.Equal(t, "tag", c.Icon)
+	})
+
+	t.Run("Invalid categories are rejected", func(t *testing.T) {
+		for name, c := range map[string]*models.ExpenseCategory{
+			"empty name":         {Name: " !! "},
+			"bad color":          {Name: "Tolls", Color: "blue"},
+			"bad icon":           {Name: "Tolls", Icon: "<script>"},
+			"inverted range":     {Name: "Tolls", IdealMin: 30, IdealMax: 10},
+			"range over 100":     {Name: "Tolls", IdealMax: 120},
+			"suggestion missing": {Name: "Tolls", SuggestAbove: 20},
+			"system collision":   {Name: "FOOD"},
+			"custom collision":   {Name: "ski passes"},
+		} {
+			assert.Error(t, service.CreateCategory(1, c), name)
+		}
+	})
+
+	t.Run("Custom categories are per user", func(t *testing.T) {
+		assert.NoError(t, service.CreateCategory(2, &models.ExpenseCategory{Name: "Ski Passes"}))
+		categories, _ := service.GetCategories(2)
+		assert.Len(t, categories, len(services.DefaultCategories)+1)
+		_, err := service.ResolveCategory(2, "gifts")
+		assert.Error(t, err)
+	})
+
+	t.Run("Resolve by key or name, case-insensitive", func(t *testing.T) {
+		for _, value := range []string{"ski-passes", "Ski Passes", " SKI PASSES "} {
+			key, err := service.ResolveCategory(1, value)
+			assert.NoError(t, err)
+			assert.Equal(t, "ski-passes", key)
+		}
+		key, _ := service.ResolveCategory(1, "Food")
+		assert.Equal(t, "food", key)
+		_, err := service.ResolveCategory(1, "entertainment")
+		assert.Error(t, err)
+	})
+}
+
+func TestCategoryService_RenameMergeDelete(t *testing.T) {
+	db, tripService := setupTrashService(t)
+	service := services.NewCategoryService(repository.NewExpenseCategoryRepository(db))
+	trip := createTrashTrip(t, tripService, 1)
+	other := createTrashTrip(t, tripService, 2)
+
+	ski := &models.ExpenseCategory{Name: "Ski"}
+	assert.NoError(t, service.CreateCategory(1, ski))
+	assert.NoError(t, db.Create(&[]models.Expense{
+		{TripID: trip.ID, Category: "ski", Amount: 50, Currency: "EUR", ExpenseDate: day("2025-06-02")},
+		{TripID: trip.ID, Category: "entertainment", Amount: 30, Currency: "EUR", ExpenseDate: day("2025-06-02")},
+		{TripID: other.ID, Category: "entertainment", Amount: 10, Currency: "EUR", ExpenseDate: day("2025-06-02")},
+	}).Error)
+	assert.NoError(t, db.Create(&[]models.CategoryBudget{
+		{TripID: trip.ID, Category: "ski", Amount: 80},
+		{TripID: trip.ID, Category: "entertainment", Amount: 40},
+		{TripID: trip.ID, Category: "activities", Amount: 60},
+	}).Error)
+
+	countCategory := func(tripID uint, category string) int64 {
+		var count int64
+		db.Model(&models.Expense{}).Where("trip_id = ? AND category = ?", tripID, category).Count(&count)
+		return count
+	}
+
+	t.Run("Renaming moves expenses and budgets to the new key", func(t *testing.T) {
+		updated, err := service.UpdateCategory(1, ski.ID, &models.ExpenseCategory{Name: "Ski Passes", Color: "#112233"})
+		assert.NoError(t, err)
+		assert.Equal(t, "ski-passes", updated.Key)
+		assert.Equal(t, int64(1), countCategory(trip.ID, "ski-passes"))
+		assert.Zero(t, countCategory(trip.ID, "ski"))
+
+		var budget models.CategoryBudget
+		assert.NoError(t, db.Where("trip_id = ? AND category = ?", trip.ID, "ski-passes").First(&budget).Error)
+		assert.Equal(t, 80.0, budget.Amount)
+	})
+
+	t.Run("Only the owner can edit", func(t *testing.T) {
+		_, err := service.UpdateCategory(2, ski.ID, &models.ExpenseCategory{Name: "Mine"})
+		assert.Error(t, err)
+		assert.Error(t, service.DeleteCategory(2, ski.ID))
+	})
+
+	t.Run("Used categories cannot be deleted", func(t *testing.T) {
+		assert.Error(t, service.DeleteCategory(1, ski.ID))
+	})
+
+	t.Run("Invalid merges", func(t *testing.T) {
+		for _, pair := range [][2]string{{"food", "other"}, {"ski-passes", "Ski Passes"}, {"entertainment", "nightlife"}, {"", "food"}} {
+			_, err := service.MergeCategories(1, pair[0], pair[1])
+			assert.Error(t, err, pair)
+		}
+	})
+
+	t.Run("Legacy category merge sums budgets and leaves other users alone", func(t *testing.T) {
+		moved, err := service.MergeCategories(1, "entertainment", "Activities")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), moved)
+		assert.Equal(t, int64(1), countCategory(trip.ID, "activities"))
+		assert.Equal(t, int64(1), countCategory(other.ID, "entertainment"))
+
+		var budgets []models.CategoryBudget
+		db.Where("trip_id = ? AND category IN ?", trip.ID, []string{"entertainment", "activities"}).Find(&budgets)
+		assert.Len(t, budgets, 1)
+		assert.Equal(t, 100.0, budgets[0].Amount)
+	})
+
+	t.Run("Merging a custom category deletes it", func(t *testing.T) {
+		moved, err := service.MergeCategories(1, "Ski Passes", "activities")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), moved)
+		categories, _ := service.GetCategories(1)
+		assert.Len(t, categories, len(services.DefaultCategories))
+	})
+
+	t.Run("Unused categories can be deleted", func(t *testing.T) {
+		gifts := &models.ExpenseCategory{Name: "Gifts"}
+		assert.NoError(t, service.CreateCategory(1, gifts))
+		assert.NoError(t, service.DeleteCategory(1, gifts.ID))
+	})
+}
+
+func TestAnalyzeBudget_CustomCategories(t *testing.T) {
+	categoryService := setupCategoryService(t)
+	assert.NoError(t, categoryService.CreateCategory(7, &models.ExpenseCategory{
+		Name: "Ski Passes", IdealMin: 10, IdealMax: 20, SuggestAbove: 25, Suggestion: "💡 Buy a multi-day pass.",
+	}))
+	server := grpc.NewRecommendationServer(new(MockTripService), nil, categoryService, setupDestinationService(t), currency.DefaultTable())
+
+	expenses := []*pb.Expense{
+		{Category: "ski-passes", Amount: 300},
+		{Category: "food", Amount: 400},
+		{Category: "accommodation", Amount: 300},
+	}
+
+	// Özel kategoriler token'ın kullanıcısından gelir
+	resp, err := server.AnalyzeBudget(authedContext(t, 7), &pb.BudgetAnalysisRequest{TripId: 1, TotalBudget: 2000, Expenses: expenses})
+	assert.NoError(t, err)
+	statuses := make(map[string]string)
+	for _, c := range resp.CategoryBreakdown {
+		statuses[c.Category] = c.Status
+	}
+	assert.Equal(t, "warning", statuses["ski-passes"])
+	assert.Equal(t, "warning", statuses["food"])
+	assert.Equal(t, "optimal", statuses["accommodation"])
+	assert.Equal(t, []string{"💡 Food expenses are high. Try local cuisine.", "💡 Buy a multi-day pass."}, resp.Suggestions)
+
+	t.Run("Another user's categories are not applied", func(t *testing.T) {
+		resp, err := server.AnalyzeBudget(authedContext(t, 8), &pb.BudgetAnalysisRequest{TripId: 1, TotalBudget: 2000, Expenses: expenses})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"💡 Food expenses are high. Try local cuisine."}, resp.Suggestions)
+
+		_, err = server.AnalyzeBudget(authedContext(t, 8), &pb.BudgetAnalysisRequest{TripId: 1, UserId: 7, TotalBudget: 2000, Expenses: expenses})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Without a token only system categories are used", func(t *testing.T) {
+		resp, err := server.AnalyzeBudget(context.Background(), &pb.BudgetAnalysisRequest{TripId: 1, UserId: 7, TotalBudget: 2000, Expenses: expenses})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"💡 Food expenses are high. Try local cuisine."}, resp.Suggestions)
+	})
+}
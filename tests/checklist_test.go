@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"testing"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func setupChecklistService(t *testing.T) (*gorm.DB, services.TripService, services.MemberService, services.ChecklistService) {
+	db, tripService := setupTrashService(t)
+	assert.NoError(t, db.AutoMigrate(&models.ChecklistTemplate{}, &models.ChecklistTemplateItem{}))
+
+	memberService := services.NewMemberService(repository.NewMemberRepository(db), repository.NewUserRepository(db))
+	checklistService := services.NewChecklistService(repository.NewChecklistRepository(db), memberService)
+	return db, tripService, memberService, checklistService
+}
+
+func createChecklistUser(t *testing.T, db *gorm.DB, email string) *models.User {
+	user := &models.User{FirstName: "Test", LastName: "User", Email: email, Password: "secret"}
+	assert.NoError(t, repository.NewUserRepository(db).CreateUser(user))
+	return user
+}
+
+func TestMembers_AddAndRemove(t *testing.T) {
+	db, tripService, memberService, _ := setupChecklistService(t)
+	owner := createChecklistUser(t, db, "owner@test.com")
+	friend := createChecklistUser(t, db, "friend@test.com")
+	stranger := createChecklistUser(t, db, "stranger@test.com")
+	trip := createTrashTrip(t, tripService, owner.ID)
+
+	member, err := memberService.AddMember(trip, "friend@test.com")
+	assert.NoError(t, err)
+	assert.Equal(t, friend.ID, member.UserID)
+
+	// Sahip, tekrar eklenen üye ve kayıtlı olmayan e-posta reddedilir
+	_, err = memberService.AddMember(trip, "owner@test.com")
+	assert.Error(t, err)
+	_, err = memberService.AddMember(trip, "friend@test.com")
+	assert.Error(t, err)
+	_, err = memberService.AddMember(trip, "nobody@test.com")
+	assert.Error(t, err)
+
+	assert.True(t, memberService.IsParticipant(trip, owner.ID))
+	assert.True(t, memberService.IsParticipant(trip, friend.ID))
+	assert.False(t, memberService.IsParticipant(trip, stranger.ID))
+
+	members, err := memberService.GetMembers(trip.ID)
+	assert.NoError(t, err)
+	assert.Len(t, members, 1)
+	assert.Equal(t, "friend@test.com", members[0].User.Email)
+
+	assert.NoError(t, memberService.RemoveMember(trip.ID, friend.ID))
+	assert.False(t, memberService.IsParticipant(trip, friend.ID))
+	assert.Error(t, memberService.RemoveMember(trip.ID, friend.ID))
+}
+
+func TestChecklists_AssignmentAndCompletion(t *testing.T) {
+	db, tripService, memberService, service := setupChecklistService(t)
+	owner := createChecklistUser(t, db, "owner@test.com")
+	friend := createChecklistUser(t, db, "friend@test.com")
+	stranger := createChecklistUser(t, db, "stranger@test.com")
+	trip := createTrashTrip(t, tripService, owner.ID)
+	_, err := memberService.AddMember(trip, "friend@test.com")
+	assert.NoError(t, err)
+
+	checklist := &models.Checklist{TripID: trip.ID, Title: "Packing", Items: []models.ChecklistItem{{Text: "Passport"}, {Text: "Charger"}}}
+	assert.NoError(t, service.CreateChecklist(checklist))
+	assert.Equal(t, models.ChecklistOther, checklist.Kind)
+
+	assert.Error(t, service.CreateChecklist(&models.Checklist{TripID: trip.ID, Title: "Bad", Kind: "groceries"}))
+	assert.Error(t, service.CreateChecklist(&models.Checklist{TripID: trip.ID}))
+
+	// Sadece gezi katılımcılarına atama yapılabilir
+	item := &models.ChecklistItem{ChecklistID: checklist.ID, Text: "Sunscreen", AssigneeID: &friend.ID}
+	assert.NoError(t, service.AddItem(trip, item))
+	assert.Equal(t, 2, item.Position)
+	assert.Error(t, service.AddItem(trip, &models.ChecklistItem{ChecklistID: checklist.ID, Text: "Hat", AssigneeID: &stranger.ID}))
+	assert.Error(t, service.AddItem(trip, &models.ChecklistItem{ChecklistID: checklist.ID}))
+
+	item.Done = true
+	assert.NoError(t, service.UpdateItem(trip, item, friend.ID))
+
+	lists, err := service.GetChecklists(trip.ID)
+	assert.NoError(t, err)
+	assert.Len(t, lists, 1)
+	assert.Equal(t, []string{"Passport", "Charger", "Sunscreen"}, []string{lists[0].Items[0].Text, lists[0].Items[1].Text, lists[0].Items[2].Text})
+
+	saved := lists[0].Items[2]
+	assert.True(t, saved.Done)
+	assert.NotNil(t, saved.DoneAt)
+	assert.Equal(t, friend.ID, *saved.DoneByID)
+	assert.Equal(t, "friend@test.com", saved.Assignee.Email)
+
+	progress := services.Progress(lists)
+	assert.Equal(t, 3, progress.Total)
+	assert.Equal(t, 1, progress.Done)
+	assert.InDelta(t, 33.33, progress.Percent, 0.01)
+
+	// İşaret kaldırılınca tamamlanma bilgisi de temizlenir
+	saved.Done = false
+	assert.NoError(t, service.UpdateItem(trip, &saved, owner.ID))
+	lists, _ = service.GetChecklists(trip.ID)
+	assert.Nil(t, lists[0].Items[2].DoneAt)
+	assert.Nil(t, lists[0].Items[2].DoneByID)
+	assert.Equal(t, 0, services.Progress(lists).Done)
+}
+
+func TestChecklists_Templates(t *testing.T) {
+	db, tripService, _, service := setupChecklistService(t)
+	owner := createChecklistUser(t, db, "owner@test.com")
+	trip := createTrashTrip(t, tripService, owner.ID)
+	other := createTrashTrip(t, tripService, owner.ID)
+
+	checklist := &models.Checklist{TripID: trip.ID, Title: "Documents", Kind: models.ChecklistDocuments,
+		Items: []models.ChecklistItem{{Text: "Passport", Done: true}, {Text: "Visa"}}}
+	assert.NoError(t, service.CreateChecklist(checklist))
+
+	template, err := service.SaveAsTemplate(checklist, owner.ID, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "Documents", template.Title)
+
+	templates, err := service.GetTemplates(owner.ID)
+	assert.NoError(t, err)
+	assert.Len(t, templates, 1)
+	assert.Len(t, templates[0].Items, 2)
+
+	created, err := service.CreateFromTemplate(&templates[0], other.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ChecklistDocuments, created.Kind)
+
+	lists, _ := service.GetChecklists(other.ID)
+	assert.Len(t, lists, 1)
+	assert.Len(t, lists[0].Items, 2)
+	assert.False(t, lists[0].Items[0].Done)
+
+	assert.NoError(t, service.DeleteTemplate(template.ID))
+	templates, _ = service.GetTemplates(owner.ID)
+	assert.Empty(t, templates)
+}
+
+func TestChecklists_RemovedWithPurgedTrip(t *testing.T) {
+	db, tripService, memberService, service := setupChecklistService(t)
+	owner := createChecklistUser(t, db, "owner@test.com")
+	createChecklistUser(t, db, "friend@test.com")
+	trip := createTrashTrip(t, tripService, owner.ID)
+	_, err := memberService.AddMember(trip, "friend@test.com")
+	assert.NoError(t, err)
+	assert.NoError(t, service.CreateChecklist(&models.Checklist{TripID: trip.ID, Title: "Packing", Items: []models.ChecklistItem{{Text: "Socks"}}}))
+
+	assert.NoError(t, tripService.DeleteTrip(trip.ID, owner.ID))
+	assert.NoError(t, tripService.PurgeTrip(trip.ID))
+
+	var lists, items, members int64
+	db.Model(&models.Checklist{}).Count(&lists)
+	db.Model(&models.ChecklistItem{}).Count(&items)
+	db.Model(&models.TripMember{}).Count(&members)
+	assert.Zero(t, lists)
+	assert.Zero(t, items)
+	assert.Zero(t, members)
+}
@@ -0,0 +1,224 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/grpc"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/services"
+	pb "travel-platform/proto"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// stubRates - Sabit kur döndüren, çağrı sayısını tutan sağlayıcı
+type stubRates struct {
+	rate  float64
+	err   error
+	calls int
+}
+
+func (s *stubRates) Rate(from, to string, date time.Time) (float64, error) {
+	s.calls++
+	return s.rate, s.err
+}
+
+func day(value string) time.Time {
+	t, _ := time.Parse("2006-01-02", value)
+	return t
+}
+
+func TestRateTable_Lookup(t *testing.T) {
+	table := currency.DefaultTable()
+
+	rate, err := table.Rate("EUR", "USD", day("2025-01-02"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0321, rate)
+
+	// Tarihte kur yoksa önceki en yakın kur kullanılır
+	rate, _ = table.Rate("eur", "usd", day("2025-03-15"))
+	assert.Equal(t, 1.0321, rate)
+
+	// Tablo başlangıcından önceki tarihler ilk kuru kullanır
+	rate, _ = table.Rate("EUR", "USD", day("2020-01-01"))
+	assert.Equal(t, 1.0956, rate)
+
+	// Çapraz kur EUR üzerinden
+	rate, _ = table.Rate("USD", "GBP", day("2025-01-02"))
+	assert.InDelta(t, 0.8292/1.0321, rate, 1e-9)
+
+	_, err = table.Rate("EUR", "XYZ", day("2025-01-02"))
+	assert.True(t, errors.Is(err, currency.ErrRateNotFound))
+
+	assert.Contains(t, table.Currencies(), "EUR")
+	assert.Contains(t, table.Currencies(), "TRY")
+}
+
+func TestRateTable_LoadErrors(t *testing.T) {
+	_, err := currency.LoadTable(strings.NewReader("date,currency,rate\n2025-01-02,USD,abc\n"))
+	assert.Error(t, err)
+
+	_, err = currency.LoadTable(strings.NewReader("date,currency,rate\n2025-01-02,US,1.1\n"))
+	assert.Error(t, err)
+
+	table, err := currency.LoadTable(strings.NewReader("date,currency,rate\n2025-01-02,SEK,11.5\n"))
+	assert.NoError(t, err)
+	rate, _ := table.Rate("SEK", "EUR", day("2025-02-01"))
+	assert.InDelta(t, 1/11.5, rate, 1e-9)
+}
+
+func TestRateChain_FallsBack(t *testing.T) {
+	failing := &stubRates{err: errors.New("service down")}
+	chain := currency.Chain{failing, currency.DefaultTable()}
+
+	rate, err := chain.Rate("EUR", "GBP", day("2025-07-01"))
+	assert.NoError(t, err)
+	assert.Equal(t, 0.8581, rate)
+	assert.Equal(t, 1, failing.calls)
+	assert.Contains(t, chain.Currencies(), "GBP")
+}
+
+func TestHTTPProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("to") == "XYZ" {
+			http.NotFound(w, r)
+			return
+		}
+		assert.Equal(t, "/2025-01-02", r.URL.Path)
+		assert.Equal(t, "USD", r.URL.Query().Get("from"))
+		w.Write([]byte(`{"amount":1.0,"base":"USD","date":"2025-01-02","rates":{"EUR":0.9689}}`))
+	}))
+	defer server.Close()
+
+	provider := currency.NewHTTPProvider(server.URL + "/")
+	rate, err := provider.Rate("usd", "EUR", day("2025-01-02"))
+	assert.NoError(t, err)
+	assert.Equal(t, 0.9689, rate)
+
+	_, err = provider.Rate("USD", "XYZ", day("2025-01-02"))
+	assert.True(t, errors.Is(err, currency.ErrRateNotFound))
+}
+
+func setupCurrencyService(t *testing.T, provider currency.RateProvider) services.CurrencyService {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(&models.ExchangeRate{}))
+	return services.NewCurrencyService(repository.NewExchangeRateRepository(db), provider)
+}
+
+func TestCurrencyService_CachesRates(t *testing.T) {
+	stub := &stubRates{rate: 0.85}
+	service := setupCurrencyService(t, stub)
+
+	converted, rate, err := service.Convert(100, "USD", "GBP", day("2025-03-10"))
+	assert.NoError(t, err)
+	assert.Equal(t, 0.85, rate)
+	assert.Equal(t, 85.0, converted)
+
+	// Aynı gün ve birkaç gün sonrası veritabanından cevaplanır
+	service.Rate("USD", "GBP", day("2025-03-10"))
+	service.Rate("USD", "GBP", day("2025-03-12"))
+	assert.Equal(t, 1, stub.calls)
+
+	// Sağlayıcı çökerse eski kayıtlı kur kullanılır
+	stub.err = errors.New("service down")
+	rate, err = service.Rate("USD", "GBP", day("2025-06-01"))
+	assert.NoError(t, err)
+	assert.Equal(t, 0.85, rate)
+
+	_, err = service.Rate("USD", "JPY", day("2025-06-01"))
+	assert.Error(t, err)
+
+	_, err = service.Rate("US", "GBP", day("2025-06-01"))
+	assert.Error(t, err)
+}
+
+func TestTripService_CapturesExpenseRates(t *testing.T) {
+	_, tripService := setupTrashService(t)
+
+	trip := &models.Trip{
+		UserID:      1,
+		Title:       "London",
+		Destination: "London",
+		StartDate:   day("2025-01-02"),
+		EndDate:     day("2025-01-05"),
+		Budget:      500,
+		Currency:    "gbp",
+		Expenses: []models.Expense{
+			{Category: "food", Amount: 100, Currency: "USD", ExpenseDate: day("2025-01-02")},
+		},
+	}
+	assert.NoError(t, tripService.CreateTrip(trip))
+	assert.Equal(t, "GBP", trip.Currency)
+
+	expense := trip.Expenses[0]
+	assert.InDelta(t, 0.8292/1.0321, expense.ExchangeRate, 1e-9)
+	assert.Equal(t, 80.34, expense.HomeAmount)
+
+	// Para birimi verilmeyen harcama gezinin para biriminde sayılır
+	local := &models.Expense{TripID: trip.ID, Category: "transport", Amount: 20, ExpenseDate: day("2025-01-03")}
+	assert.NoError(t, tripService.AddExpense(local, 1))
+	assert.Equal(t, "GBP", local.Currency)
+	assert.Equal(t, 1.0, local.ExchangeRate)
+
+	// Toplamlar harcama tarihindeki kurla çevrilmiş tutarlardan hesaplanır
+	saved, _ := tripService.GetTripByID(trip.ID)
+	spending := services.SummarizeSpending(saved)
+	assert.Equal(t, "GBP", spending.Currency)
+	assert.Equal(t, 100.34, spending.Total)
+	assert.Equal(t, 399.66, spending.Remaining)
+	assert.Equal(t, 80.34, spending.ByCategory["food"])
+
+	// Gezinin para birimi değişince harcamalar yeniden çevrilir
+	saved.Currency = "EUR"
+	assert.NoError(t, tripService.UpdateTrip(saved, 1))
+	saved, _ = tripService.GetTripByID(trip.ID)
+	for _, e := range saved.Expenses {
+		if e.Currency == "USD" {
+			assert.Equal(t, 96.89, e.HomeAmount)
+		} else {
+			assert.Equal(t, 24.12, e.HomeAmount)
+		}
+	}
+
+	bad := &models.Expense{TripID: trip.ID, Category: "food", Amount: 5, Currency: "XYZ", ExpenseDate: day("2025-01-03")}
+	assert.Error(t, tripService.AddExpense(bad, 1))
+
+	trip.Currency = "euro"
+	assert.Error(t, services.ValidateTrip(trip))
+}
+
+func TestAnalyzeBudget_MixedCurrencies(t *testing.T) {
+	server := grpc.NewRecommendationServer(new(MockTripService), nil, setupCategoryService(t), setupDestinationService(t), currency.DefaultTable())
+
+	resp, err := server.AnalyzeBudget(context.Background(), &pb.BudgetAnalysisRequest{
+		TripId:      1,
+		TotalBudget: 1000,
+		Currency:    "EUR",
+		Expenses: []*pb.Expense{
+			{Category: "food", Amount: 100, Currency: "EUR"},
+			{Category: "food", Amount: 103.21, Currency: "USD", ExpenseDate: "2025-01-02"},
+			{Category: "transport", Amount: 50, Currency: "GBP", ExchangeRate: 1.2},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "EUR", resp.Currency)
+	assert.Equal(t, 260.0, resp.TotalSpent)
+	assert.Equal(t, 740.0, resp.Remaining)
+
+	_, err = server.AnalyzeBudget(context.Background(), &pb.BudgetAnalysisRequest{
+		TripId:      1,
+		TotalBudget: 1000,
+		Expenses:    []*pb.Expense{{Category: "food", Amount: 10, Currency: "XYZ"}},
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,195 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/geo"
+	"travel-platform/internal/grpc"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/services"
+	pb "travel-platform/proto"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// seedDestinations - Verilen veritabanında gazetteer'dan katalog oluşturur
+func seedDestinations(t *testing.T, db *gorm.DB) services.DestinationService {
+	assert.NoError(t, db.AutoMigrate(&models.Trip{}, &models.Destination{}))
+	service := services.NewDestinationService(repository.NewDestinationRepository(db))
+	_, err := service.Seed(geo.DefaultGazetteer().Locations())
+	assert.NoError(t, err)
+	return service
+}
+
+func setupDestinationService(t *testing.T) services.DestinationService {
+	return seedDestinations(t, setupTestDB(t))
+}
+
+func destinationNames(destinations []models.Destination) []string {
+	var names []string
+	for _, d := range destinations {
+		names = append(names, d.Name)
+	}
+	return names
+}
+
+func TestDestinationService_Seed(t *testing.T) {
+	db := setupTestDB(t)
+	service := seedDestinations(t, db)
+
+	all, err := service.GetDestinations()
+	assert.NoError(t, err)
+	assert.Len(t, all, len(geo.DefaultGazetteer().Locations()))
+
+	added, err := service.Seed(geo.DefaultGazetteer().Locations())
+	assert.NoError(t, err)
+	assert.Zero(t, added)
+
+	paris, ok := service.Match("Paris")
+	assert.True(t, ok)
+	assert.Equal(t, "Paris, France", paris.DisplayName())
+	assert.True(t, paris.Featured)
+	assert.Equal(t, 1500.0, paris.EstimatedBudget)
+	assert.Equal(t, []string{"Eiffel Tower", "Louvre Museum", "Seine River Cruise"}, paris.HighlightList())
+
+	featured, err := service.GetFeatured()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Barcelona", "Istanbul", "Paris"}, destinationNames(featured))
+
+	rome, _ := service.Match("Rome")
+	assert.Equal(t, []string{"roma"}, rome.AliasList())
+	assert.NotEmpty(t, rome.Description)
+}
+
+func TestDestinationService_Match(t *testing.T) {
+	service := setupDestinationService(t)
+
+	for query, expected := range map[string]string{
+		"Paris":               "Paris",
+		"paris":               "Paris",
+		"Paris, France":       "Paris",
+		"PARIS  France":       "Paris",
+		"Pariss":              "Paris",
+		"Eiffel Tower, Paris": "Paris",
+		"München":             "Munich",
+		"Munchen":             "Munich",
+		"Barcelonna":          "Barcelona",
+		"Roma":                "Rome",
+		"Kapadokya":           "Cappadocia",
+		"new-york":            "New York",
+	} {
+		destination, ok := service.Match(query)
+		if assert.True(t, ok, query) {
+			assert.Equal(t, expected, destination.Name, query)
+		}
+	}
+
+	for _, query := range []string{"", "Atlantis", "Pxrxs"} {
+		_, ok := service.Match(query)
+		assert.False(t, ok, query)
+	}
+
+	loc, err := service.Geocode("lisboa")
+	assert.NoError(t, err)
+	assert.Equal(t, "Lisbon", loc.Name)
+	_, err = service.Geocode("Atlantis")
+	assert.ErrorIs(t, err, geo.ErrNotFound)
+}
+
+func TestDestinationService_Autocomplete(t *testing.T) {
+	db := setupTestDB(t)
+	service := seedDestinations(t, db)
+
+	results, err := service.Autocomplete("par", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Paris"}, destinationNames(results))
+
+	results, _ = service.Autocomplete("york", 0)
+	assert.Equal(t, []string{"New York"}, destinationNames(results))
+
+	results, _ = service.Autocomplete("ital", 0)
+	assert.Equal(t, []string{"Florence", "Milan", "Rome", "Venice"}, destinationNames(results))
+
+	results, _ = service.Autocomplete("barsel", 0)
+	assert.Equal(t, []string{"Barcelona"}, destinationNames(results))
+
+	results, _ = service.Autocomplete("zzz", 0)
+	assert.Empty(t, results)
+
+	// Aynı sıradakiler arasında public gezisi çok olan önce gelir
+	sydney, _ := service.Match("Sydney")
+	assert.NoError(t, db.Create(&models.Trip{UserID: 1, Title: "Down Under", Destination: "Sydney", DestinationID: &sydney.ID, IsPublic: true}).Error)
+	results, _ = service.Autocomplete("s", 3)
+	assert.Equal(t, []string{"Sydney", "San Francisco", "Santorini"}, destinationNames(results))
+
+	results, _ = service.Autocomplete("", 100)
+	assert.Len(t, results, services.MaxAutocompleteLimit)
+}
+
+func TestDestinationService_LinkTrips(t *testing.T) {
+	db, tripService := setupTrashService(t)
+	catalog := seedDestinations(t, db)
+	geoService := services.NewGeoService(geo.DefaultGazetteer(), catalog)
+
+	var trips []*models.Trip
+	for _, destination := range []string{"paris", "Paris, France", "Pariss", "Atlantis"} {
+		trip := &models.Trip{UserID: 1, Title: destination, Destination: destination, StartDate: day("2025-05-01"), EndDate: day("2025-05-03")}
+		assert.NoError(t, tripService.CreateTrip(trip))
+		trips = append(trips, trip)
+	}
+
+	linked, err := catalog.LinkTrips()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, linked)
+
+	paris, _ := catalog.Match("Paris")
+	for _, trip := range trips[:3] {
+		saved, err := tripService.GetTripByID(trip.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, paris.ID, *saved.DestinationID)
+	}
+	saved, _ := tripService.GetTripByID(trips[3].ID)
+	assert.Nil(t, saved.DestinationID)
+
+	t.Run("New trips are linked and get the catalog coordinates", func(t *testing.T) {
+		trip := &models.Trip{Destination: "Vienna, Austria"}
+		assert.Empty(t, geoService.GeocodeTrip(trip))
+		vienna, _ := catalog.Match("Vienna")
+		assert.Equal(t, vienna.ID, *trip.DestinationID)
+		assert.Equal(t, vienna.Latitude, *trip.Latitude)
+
+		// Zaten bağlı gezi değişmez
+		assert.False(t, geoService.LinkDestination(trip))
+	})
+}
+
+func TestGetRecommendations_UsesDestinationCatalog(t *testing.T) {
+	mockService := new(MockTripService)
+	server := grpc.NewRecommendationServer(mockService, nil, setupCategoryService(t), setupDestinationService(t), currency.DefaultTable())
+	mockService.On("GetPublicTrips").Return([]models.Trip{
+		{Destination: "paris", Budget: 1000, IsPublic: true},
+		{Destination: "Paris, France", Budget: 1200, IsPublic: true},
+	}, nil)
+
+	resp, err := server.GetRecommendations(context.Background(), &pb.RecommendationRequest{UserId: 1})
+	assert.NoError(t, err)
+
+	// Farklı yazılmış Paris gezileri tek öneri, öne çıkan Paris de aynı yer olarak tekrar önerilmez
+	var paris []*pb.Recommendation
+	for _, rec := range resp.Recommendations {
+		if rec.Destination == "paris" || rec.Destination == "Paris, France" {
+			paris = append(paris, rec)
+		}
+	}
+	assert.Len(t, paris, 1)
+	assert.Equal(t, 1100.0, paris[0].EstimatedBudget)
+	assert.Equal(t, "Popular destination with 2 trips planned by our community", paris[0].Description)
+
+	istanbul := recommendationFor(resp.Recommendations, "Istanbul, Turkey")
+	assert.NotNil(t, istanbul)
+	assert.Equal(t, "Historic city where East meets West", istanbul.Description)
+	assert.Equal(t, []string{"Hagia Sophia", "Bosphorus Cruise", "Grand Bazaar"}, istanbul.SuggestedActivities)
+}
@@ -0,0 +1,237 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+	"travel-platform/internal/handlers"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/services"
+	"travel-platform/internal/vault"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func testCipher(t *testing.T) *vault.Cipher {
+	cipher, err := vault.NewCipher(bytes.Repeat([]byte{7}, vault.KeySize))
+	assert.NoError(t, err)
+	return cipher
+}
+
+func setupDocumentService(t *testing.T) (*gorm.DB, services.TripService, services.DocumentService) {
+	db, tripService := setupTrashService(t)
+	assert.NoError(t, db.AutoMigrate(&models.TravelDocument{}, &models.User{}, &models.TripMember{}))
+	memberService := services.NewMemberService(repository.NewMemberRepository(db), repository.NewUserRepository(db))
+	return db, tripService, services.NewDocumentService(repository.NewDocumentRepository(db), tripService, memberService, testCipher(t))
+}
+
+func TestVault_EncryptDecrypt(t *testing.T) {
+	cipher := testCipher(t)
+
+	encrypted, err := cipher.Encrypt([]byte("P1234567"), []byte("user:1"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(encrypted), "P1234567")
+
+	plaintext, err := cipher.Decrypt(encrypted, []byte("user:1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "P1234567", string(plaintext))
+
+	// Başka kullanıcıya bağlanmış veya bozulmuş veri çözülemez
+	_, err = cipher.Decrypt(encrypted, []byte("user:2"))
+	assert.ErrorIs(t, err, vault.ErrDecrypt)
+	encrypted[len(encrypted)-1] ^= 1
+	_, err = cipher.Decrypt(encrypted, []byte("user:1"))
+	assert.ErrorIs(t, err, vault.ErrDecrypt)
+
+	_, err = vault.NewCipher([]byte("short"))
+	assert.Error(t, err)
+}
+
+func TestVault_LoadKey(t *testing.T) {
+	t.Setenv("VAULT_KEY", "")
+	keyFile := filepath.Join(t.TempDir(), "vault.key")
+
+	key, err := vault.LoadKey(keyFile)
+	assert.NoError(t, err)
+	assert.Len(t, key, vault.KeySize)
+
+	info, err := os.Stat(keyFile)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	again, err := vault.LoadKey(keyFile)
+	assert.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	t.Setenv("VAULT_KEY", "bm90LWEtMzItYnl0ZS1rZXk=")
+	_, err = vault.LoadKey(keyFile)
+	assert.Error(t, err)
+}
+
+func TestDocuments_EncryptedAtRest(t *testing.T) {
+	db, _, service := setupDocumentService(t)
+
+	document := &models.TravelDocument{UserID: 1, Type: models.DocumentPassport, Number: "P1234567",
+		FileName: "passport.pdf", ContentType: "application/pdf"}
+	assert.NoError(t, service.UploadDocument(document, []byte("%PDF passport scan")))
+	assert.Equal(t, "passport.pdf", document.Title)
+	assert.Equal(t, int64(18), document.Size)
+
+	var raw models.TravelDocument
+	assert.NoError(t, db.First(&raw, document.ID).Error)
+	assert.NotContains(t, string(raw.EncryptedData), "passport scan")
+	assert.NotContains(t, string(raw.EncryptedNumber), "P1234567")
+
+	loaded, err := service.GetDocumentByID(document.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "P1234567", loaded.Number)
+	data, err := service.OpenDocument(loaded)
+	assert.NoError(t, err)
+	assert.Equal(t, "%PDF passport scan", string(data))
+
+	// Liste dosya içeriğini yüklemez
+	documents, err := service.GetDocuments(1)
+	assert.NoError(t, err)
+	assert.Len(t, documents, 1)
+	assert.Empty(t, documents[0].EncryptedData)
+	assert.Equal(t, "P1234567", documents[0].Number)
+
+	// Bilgi güncellemesi dosyayı değiştirmez
+	loaded.Number = "P7654321"
+	loaded.Title = "Renewed passport"
+	assert.NoError(t, service.UpdateDocument(loaded))
+	loaded, _ = service.GetDocumentByID(document.ID)
+	assert.Equal(t, "P7654321", loaded.Number)
+	data, _ = service.OpenDocument(loaded)
+	assert.Equal(t, "%PDF passport scan", string(data))
+
+	assert.Error(t, service.UploadDocument(&models.TravelDocument{UserID: 1, Type: "diploma", Title: "x"}, nil))
+}
+
+func TestDocumentReminders(t *testing.T) {
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	date := func(y int, m time.Month, d int) *time.Time {
+		t := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	tripID := uint(2)
+	trips := []models.Trip{
+		{ID: 1, Title: "Rome", StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Title: "Tokyo", StartDate: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 9, 20, 0, 0, 0, 0, time.UTC)},
+	}
+	documents := []models.TravelDocument{
+		{ID: 1, Title: "Passport", Type: models.DocumentPassport, ExpiresAt: date(2025, 11, 1)}, // Rome +6 ay ve Tokyo'yu karşılamaz
+		{ID: 2, Title: "Japan visa", Type: models.DocumentVisa, TripID: &tripID, ExpiresAt: date(2025, 9, 15)},
+		{ID: 3, Title: "Insurance", Type: models.DocumentInsurance, ExpiresAt: date(2026, 1, 1)},
+		{ID: 4, Title: "Old ID", Type: models.DocumentIDCard, ExpiresAt: date(2025, 2, 1)},
+		{ID: 5, Title: "Gym card", Type: models.DocumentOther, ExpiresAt: date(2025, 3, 20)},
+		{ID: 6, Title: "No expiry", Type: models.DocumentOther},
+	}
+
+	reminders := services.DocumentReminders(documents, trips, now)
+
+	type key struct{ document, trip uint }
+	got := map[key]string{}
+	for _, r := range reminders {
+		got[key{r.DocumentID, r.TripID}] = r.Message
+	}
+	assert.Len(t, got, 5)
+	assert.Contains(t, got[key{1, 1}], "valid for 6 months after Rome")
+	assert.Contains(t, got[key{1, 2}], "Tokyo")
+	assert.Contains(t, got[key{2, 2}], "before Tokyo ends")
+	assert.Contains(t, got[key{4, 0}], "expired")
+	assert.Equal(t, "Gym card expires in 19 days", got[key{5, 0}])
+}
+
+func TestDocumentService_RemindersForMemberTrips(t *testing.T) {
+	db, tripService, service := setupDocumentService(t)
+	today := time.Now().Truncate(24 * time.Hour)
+	trip := createStatusTrip(t, tripService, today.AddDate(0, 1, 0), 5)
+	past := createStatusTrip(t, tripService, today.AddDate(0, -1, 0), 5)
+	members := repository.NewMemberRepository(db)
+	assert.NoError(t, members.AddMember(&models.TripMember{TripID: trip.ID, UserID: 2}))
+	assert.NoError(t, members.AddMember(&models.TripMember{TripID: past.ID, UserID: 2}))
+
+	// Üyenin vizesi katıldığı gezi bitmeden doluyor
+	expires := today.AddDate(0, 1, 2)
+	assert.NoError(t, service.UploadDocument(&models.TravelDocument{
+		UserID: 2, Type: models.DocumentVisa, Title: "Visa", TripID: &trip.ID, ExpiresAt: &expires,
+	}, []byte("visa scan")))
+
+	reminders, err := service.GetReminders(2, today)
+	assert.NoError(t, err)
+	if assert.Len(t, reminders, 1) {
+		assert.Equal(t, trip.ID, reminders[0].TripID)
+	}
+}
+
+func TestDocuments_OwnerOnlyAccess(t *testing.T) {
+	db, tripService, service := setupDocumentService(t)
+	memberService := services.NewMemberService(repository.NewMemberRepository(db), repository.NewUserRepository(db))
+	handler := handlers.NewDocumentHandler(service, tripService, memberService)
+	trip := createTrashTrip(t, tripService, 1)
+
+	request := func(userID uint, method, target string, body *bytes.Buffer, contentType string, vars map[string]string) *httptest.ResponseRecorder {
+		if body == nil {
+			body = &bytes.Buffer{}
+		}
+		r := httptest.NewRequest(method, target, body)
+		if contentType != "" {
+			r.Header.Set("Content-Type", contentType)
+		}
+		r = mux.SetURLVars(r, vars)
+		r = r.WithContext(context.WithValue(r.Context(), "user_id", userID))
+		w := httptest.NewRecorder()
+		switch {
+		case method == http.MethodPost:
+			handler.UploadDocument(w, r)
+		case vars["id"] != "":
+			handler.DownloadDocument(w, r)
+		default:
+			handler.GetDocuments(w, r)
+		}
+		return w
+	}
+
+	upload := func(userID uint, tripID string) *httptest.ResponseRecorder {
+		var body bytes.Buffer
+		form := multipart.NewWriter(&body)
+		form.WriteField("type", "visa")
+		form.WriteField("trip_id", tripID)
+		part, _ := form.CreateFormFile("file", "visa.pdf")
+		part.Write([]byte("visa scan"))
+		form.Close()
+		return request(userID, http.MethodPost, "/api/documents", &body, form.FormDataContentType(), nil)
+	}
+
+	assert.Equal(t, http.StatusCreated, upload(1, "1").Code)
+	// Başkasının gezisine belge bağlanamaz
+	assert.Equal(t, http.StatusBadRequest, upload(2, "1").Code)
+
+	w := request(1, http.MethodGet, "/api/documents/1/download", nil, "", map[string]string{"id": "1"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "visa scan", w.Body.String())
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+
+	w = request(2, http.MethodGet, "/api/documents/1/download", nil, "", map[string]string{"id": "1"})
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = request(1, http.MethodGet, "/api/documents?trip_id=1", nil, "", nil)
+	assert.Contains(t, w.Body.String(), `"trip_id":1`)
+
+	// Gezi kalıcı silinince belge kalır, bağlantısı kalkar
+	assert.NoError(t, tripService.DeleteTrip(trip.ID, 1))
+	assert.NoError(t, tripService.PurgeTrip(trip.ID))
+	document, err := service.GetDocumentByID(1)
+	assert.NoError(t, err)
+	assert.Nil(t, document.TripID)
+}
@@ -0,0 +1,217 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/geo"
+	"travel-platform/internal/grpc"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+	pb "travel-platform/proto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Rome gezilerinde günlük ortalama 100 EUR, aktivite başına 20 EUR
+func romeTrips() []models.Trip {
+	return []models.Trip{
+		{
+			Destination: "Rome, Italy", IsPublic: true, Currency: "EUR",
+			StartDate: day("2025-04-01"), EndDate: day("2025-04-04"),
+			Activities: []models.Activity{
+				{Name: "Colosseum", Location: "Piazza del Colosseo"},
+				{Name: "Vatican Museums"},
+				{Name: "Trastevere Food Tour"},
+			},
+			Expenses: []models.Expense{
+				{Category: "activities", Amount: 60, Currency: "EUR", ExpenseDate: day("2025-04-02")},
+				{Category: "food", Amount: 140, Currency: "EUR", ExpenseDate: day("2025-04-02")},
+			},
+		},
+		{
+			Destination: "Rome", IsPublic: true, Currency: "EUR", Budget: 300,
+			StartDate: day("2025-05-10"), EndDate: day("2025-05-11"),
+			Activities: []models.Activity{{Name: "colosseum "}, {Name: "Pantheon"}, {Name: "Pantheon"}},
+		},
+		{
+			Destination: "Paris", IsPublic: true, Currency: "EUR", Budget: 2000,
+			StartDate: day("2025-05-10"), EndDate: day("2025-05-11"),
+			Activities: []models.Activity{{Name: "Louvre"}},
+		},
+	}
+}
+
+func setupItineraryServer(t *testing.T) *grpc.RecommendationServer {
+	service := new(MockTripService)
+	service.On("GetPublicTrips").Return(romeTrips(), nil)
+	return grpc.NewRecommendationServer(service, nil, setupCategoryService(t), setupDestinationService(t), currency.DefaultTable())
+}
+
+func itemNames(day *pb.ItineraryDayPlan) []string {
+	var names []string
+	for _, item := range day.Items {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+func TestGenerateItinerary_SpreadsActivitiesAndBudget(t *testing.T) {
+	server := setupItineraryServer(t)
+
+	resp, err := server.GenerateItinerary(context.Background(), &pb.ItineraryRequest{
+		Destination:      "rome",
+		StartDate:        "2025-06-01",
+		EndDate:          "2025-06-03",
+		Budget:           600,
+		ActivitiesPerDay: 2,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Rome, Italy", resp.Destination)
+	assert.Equal(t, "EUR", resp.Currency)
+	require.Len(t, resp.Days, 3)
+	assert.Equal(t, "2025-06-03", resp.Days[2].Date)
+
+	// En popüler aktiviteler farklı günlere dağıtılır, katalogdaki öne çıkanlar sona eklenir
+	assert.Equal(t, []string{"Colosseum", "Vatican Museums"}, itemNames(resp.Days[0]))
+	assert.Equal(t, []string{"Pantheon", "Trevi Fountain"}, itemNames(resp.Days[1]))
+	assert.Equal(t, []string{"Trastevere Food Tour"}, itemNames(resp.Days[2]))
+	assert.Equal(t, int32(2), resp.Days[0].Items[0].Popularity)
+	assert.Equal(t, "Piazza del Colosseo", resp.Days[0].Items[0].Location)
+	assert.Equal(t, int32(0), resp.Days[1].Items[1].Popularity)
+
+	// 5 aktivite x 20 EUR, kalan 500 EUR günlere eşit bölünür
+	assert.Equal(t, 20.0, resp.Days[0].Items[0].EstimatedCost)
+	assert.InDelta(t, 206.67, resp.Days[0].EstimatedCost, 0.01)
+	assert.InDelta(t, 186.67, resp.Days[2].EstimatedCost, 0.01)
+	assert.InDelta(t, 600, resp.EstimatedTotal, 0.05)
+	assert.Empty(t, resp.Warnings)
+}
+
+func TestGenerateItinerary_EstimatesBudgetAndRespectsDays(t *testing.T) {
+	server := setupItineraryServer(t)
+
+	// Bütçe verilmezse topluluğun günlük ortalaması kullanılır
+	resp, err := server.GenerateItinerary(context.Background(), &pb.ItineraryRequest{
+		Destination: "Rome", StartDate: "2025-06-01", EndDate: "2025-06-03",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 300.0, resp.TotalBudget)
+	assert.InDelta(t, 300, resp.EstimatedTotal, 0.05)
+
+	// Tek günde aktivite sayısı sınırı aşılmaz
+	resp, err = server.GenerateItinerary(context.Background(), &pb.ItineraryRequest{
+		Destination: "Rome", StartDate: "2025-06-01", EndDate: "2025-06-01", Budget: 50, ActivitiesPerDay: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Days, 1)
+	assert.Equal(t, []string{"Colosseum", "Pantheon"}, itemNames(resp.Days[0]))
+	assert.Contains(t, resp.Warnings[0], "usually spend about 100.00 EUR per day")
+
+	// Bilinmeyen destinasyonda tahmin yapılamaz
+	resp, err = server.GenerateItinerary(context.Background(), &pb.ItineraryRequest{
+		Destination: "Atlantis", StartDate: "2025-06-01", EndDate: "2025-06-02",
+	})
+	require.NoError(t, err)
+	assert.Len(t, resp.Days, 2)
+	assert.Empty(t, resp.Days[0].Items)
+	assert.Equal(t, 0.0, resp.EstimatedTotal)
+	assert.Contains(t, resp.Warnings[0], "No public trips to Atlantis")
+}
+
+func TestGenerateItinerary_ConvertsCurrency(t *testing.T) {
+	server := setupItineraryServer(t)
+
+	resp, err := server.GenerateItinerary(context.Background(), &pb.ItineraryRequest{
+		Destination: "Rome", StartDate: "2025-06-01", EndDate: "2025-06-02", Currency: "usd",
+	})
+	require.NoError(t, err)
+
+	expected, _, err := currency.Convert(currency.DefaultTable(), 20, "EUR", "USD", day("2025-06-01"))
+	require.NoError(t, err)
+	assert.Equal(t, "USD", resp.Currency)
+	assert.Equal(t, currency.Round(expected), resp.Days[0].Items[0].EstimatedCost)
+}
+
+func TestGenerateItinerary_Validation(t *testing.T) {
+	server := setupItineraryServer(t)
+
+	requests := []*pb.ItineraryRequest{
+		{StartDate: "2025-06-01", EndDate: "2025-06-03"},
+		{Destination: "Rome", StartDate: "June 1", EndDate: "2025-06-03"},
+		{Destination: "Rome", StartDate: "2025-06-03", EndDate: "2025-06-01"},
+		{Destination: "Rome", StartDate: "2025-01-01", EndDate: "2025-12-31"},
+		{Destination: "Rome", StartDate: "2025-06-01", EndDate: "2025-06-03", Budget: -1},
+		{Destination: "Rome", StartDate: "2025-06-01", EndDate: "2025-06-03", Currency: "EURO"},
+		{Destination: "Rome", StartDate: "2025-06-01", EndDate: "2025-06-03", ActivitiesPerDay: 7},
+	}
+	for _, req := range requests {
+		_, err := server.GenerateItinerary(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "%+v", req)
+	}
+}
+
+func TestGenerateItinerary_SaveRequiresToken(t *testing.T) {
+	server := setupItineraryServer(t)
+
+	// user_id tek başına yetki vermez
+	_, err := server.GenerateItinerary(context.Background(), &pb.ItineraryRequest{
+		Destination: "Rome", StartDate: "2025-06-01", EndDate: "2025-06-03", Save: true, UserId: 1,
+	})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Başka kullanıcı adına kaydedilemez
+	_, err = server.GenerateItinerary(authedContext(t, 2), &pb.ItineraryRequest{
+		Destination: "Rome", StartDate: "2025-06-01", EndDate: "2025-06-03", Save: true, UserId: 1,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGenerateItinerary_SavesTrip(t *testing.T) {
+	db, tripService := setupTrashService(t)
+	require.NoError(t, db.AutoMigrate(&models.User{}))
+	for _, trip := range romeTrips() {
+		trip.UserID = 2
+		trip.Title = trip.Destination
+		require.NoError(t, tripService.CreateTrip(&trip))
+	}
+	destinations := setupDestinationService(t)
+	// Plan harcama içermez; bütçe uyarısı kontrol edilmediği için bildirim servisi gerekmez
+	writeService := services.NewTripWriteService(tripService, services.NewGeoService(geo.DefaultGazetteer(), destinations), setupCategoryService(t), nil)
+	server := grpc.NewRecommendationServer(tripService, writeService, setupCategoryService(t), destinations, currency.DefaultTable())
+
+	// Gezi token'ın kullanıcısına kaydedilir, user_id gerekmez
+	resp, err := server.GenerateItinerary(authedContext(t, 1), &pb.ItineraryRequest{
+		Destination: "Rome", StartDate: "2025-06-01", EndDate: "2025-06-02", Budget: 400, Save: true,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, resp.TripId)
+
+	trip, err := tripService.GetTripByID(uint(resp.TripId))
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), trip.UserID)
+	assert.Equal(t, "Trip to Rome, Italy", trip.Title)
+	assert.Equal(t, "Rome, Italy", trip.Destination)
+	assert.Equal(t, 400.0, trip.Budget)
+	assert.Equal(t, day("2025-06-02"), trip.EndDate.UTC())
+	require.NotNil(t, trip.DestinationID)
+	rome, _ := destinations.Match("Rome")
+	assert.Equal(t, rome.ID, *trip.DestinationID)
+
+	// Her plan maddesi kendi gününde bir aktivite olur
+	require.Len(t, trip.Activities, 5)
+	byName := make(map[string]models.Activity)
+	for _, a := range trip.Activities {
+		byName[a.Name] = a
+	}
+	assert.Equal(t, day("2025-06-01"), byName["Colosseum"].Date.UTC())
+	assert.Equal(t, day("2025-06-02"), byName["Pantheon"].Date.UTC())
+	assert.Equal(t, "Estimated cost: 20.00 EUR", byName["Colosseum"].Description)
+	// Konumu olmayan aktiviteler destinasyonda geocode edilir
+	assert.Equal(t, "Rome, Italy", byName["Pantheon"].Location)
+	assert.NotNil(t, byName["Pantheon"].Latitude)
+}
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+	"travel-platform/internal/geo"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGazetteer_Geocode(t *testing.T) {
+	g := geo.DefaultGazetteer()
+
+	t.Run("Exact and alias", func(t *testing.T) {
+		loc, err := g.Geocode("  paris ")
+		assert.NoError(t, err)
+		assert.Equal(t, "France", loc.Country)
+
+		loc, err = g.Geocode("Roma")
+		assert.NoError(t, err)
+		assert.Equal(t, "Rome", loc.Name)
+	})
+
+	t.Run("Place inside a city", func(t *testing.T) {
+		loc, err := g.Geocode("Eiffel Tower, Paris, France")
+		assert.NoError(t, err)
+		assert.Equal(t, "Paris", loc.Name)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := g.Geocode("Atlantis")
+		assert.ErrorIs(t, err, geo.ErrNotFound)
+	})
+}
+
+func TestGeoService_GeocodeTrip(t *testing.T) {
+	service := services.NewGeoService(geo.DefaultGazetteer(), nil)
+
+	userLat, userLon := 1.0, 2.0
+	trip := &models.Trip{
+		Destination: "Rome, Italy",
+		Activities: []models.Activity{
+			{Name: "Colosseum", Location: "Colosseum"},
+			{Name: "Pinned", Location: "Somewhere", Latitude: &userLat, Longitude: &userLon},
+			{Name: "Lost", Location: ""},
+		},
+	}
+
+	unresolved := service.GeocodeTrip(trip)
+
+	assert.Empty(t, unresolved)
+	assert.InDelta(t, 41.9, *trip.Latitude, 0.1)
+	assert.NotNil(t, trip.Activities[0].Latitude) // destinasyon üzerinden bulundu
+	assert.Equal(t, 1.0, *trip.Activities[1].Latitude)
+	assert.Nil(t, trip.Activities[2].Latitude)
+}
+
+func TestMapExports(t *testing.T) {
+	lat1, lon1, lat2, lon2 := 41.89, 12.49, 41.90, 12.45
+	day := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	trip := &models.Trip{
+		Title:       "Rome",
+		Destination: "Rome",
+		Activities: []models.Activity{
+			{Name: "Vatican", Latitude: &lat2, Longitude: &lon2, Date: day.AddDate(0, 0, 1)},
+			{Name: "Colosseum", Latitude: &lat1, Longitude: &lon1, Date: day},
+			{Name: "No coordinates", Date: day},
+		},
+	}
+
+	t.Run("GeoJSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, geo.WriteGeoJSON(&buf, trip))
+
+		var fc struct {
+			Type     string `json:"type"`
+			Features []struct {
+				Geometry struct {
+					Type        string          `json:"type"`
+					Coordinates json.RawMessage `json:"coordinates"`
+				} `json:"geometry"`
+			} `json:"features"`
+		}
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &fc))
+		assert.Equal(t, "FeatureCollection", fc.Type)
+		assert.Len(t, fc.Features, 3) // 2 nokta + rota
+		assert.Equal(t, "LineString", fc.Features[2].Geometry.Type)
+		// Rota tarih sırasında ve [lon, lat]
+		assert.JSONEq(t, `[[12.49, 41.89], [12.45, 41.9]]`, string(fc.Features[2].Geometry.Coordinates))
+	})
+
+	t.Run("GPX", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, geo.WriteGPX(&buf, trip))
+		assert.NoError(t, xml.Unmarshal(buf.Bytes(), new(interface{})))
+		assert.Equal(t, 2, strings.Count(buf.String(), "<wpt "))
+		assert.Equal(t, 2, strings.Count(buf.String(), "<rtept "))
+	})
+
+	t.Run("KML", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, geo.WriteKML(&buf, trip))
+		assert.Contains(t, buf.String(), "<coordinates>12.49,41.89,0 12.45,41.9,0</coordinates>")
+	})
+}
@@ -0,0 +1,220 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+	"travel-platform/internal/geo"
+	"travel-platform/internal/grpc"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/services"
+	pb "travel-platform/proto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// startTravelServer - Trip ve User servislerini auth interceptor ile bellek içi bağlantıda çalıştırır
+func startTravelServer(t *testing.T) (pb.TripServiceClient, pb.UserServiceClient) {
+	db, tripService := setupTrashService(t)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Notification{}, &models.NotificationSettings{}, &models.ChatRoom{}, &models.ChatMessage{}))
+
+	userRepo := repository.NewUserRepository(db)
+	categoryService := services.NewCategoryService(repository.NewExpenseCategoryRepository(db))
+	memberService := services.NewMemberService(repository.NewMemberRepository(db), userRepo)
+	budgetService := services.NewBudgetService(repository.NewCategoryBudgetRepository(db))
+	notificationService := services.NewNotificationService(repository.NewNotificationRepository(db), tripService, budgetService, memberService,
+		userRepo, repository.NewChatRepository(db), &fakeBroadcaster{}, &fakeMailer{})
+	geoService := services.NewGeoService(geo.DefaultGazetteer(), nil)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpclib.NewServer(grpclib.UnaryInterceptor(grpc.AuthInterceptor), grpclib.StreamInterceptor(grpc.StreamAuthInterceptor))
+	pb.RegisterTripServiceServer(server, grpc.NewTripServer(tripService, services.NewTripWriteService(tripService, geoService, categoryService, notificationService)))
+	pb.RegisterUserServiceServer(server, grpc.NewUserServer(services.NewUserService(userRepo)))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpclib.NewClient("passthrough:///bufnet",
+		grpclib.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpclib.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewTripServiceClient(conn), pb.NewUserServiceClient(conn)
+}
+
+// loginAs - Kullanıcıyı kaydedip token'lı context döner
+func loginAs(t *testing.T, users pb.UserServiceClient, email string) context.Context {
+	_, err := users.Register(context.Background(), &pb.RegisterRequest{Email: email, Password: "secret123", FirstName: "Test", LastName: "User"})
+	require.NoError(t, err)
+	login, err := users.Login(context.Background(), &pb.LoginRequest{Email: email, Password: "secret123"})
+	require.NoError(t, err)
+	require.NotEmpty(t, login.Token)
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+login.Token)
+}
+
+func TestUserServiceGRPC_AuthMetadata(t *testing.T) {
+	_, users := startTravelServer(t)
+
+	_, err := users.GetProfile(context.Background(), &pb.GetProfileRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	bad := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer nope")
+	_, err = users.GetProfile(bad, &pb.GetProfileRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = users.Login(context.Background(), &pb.LoginRequest{Email: "nobody@test.com", Password: "x"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := loginAs(t, users, "ada@test.com")
+	profile, err := users.GetProfile(ctx, &pb.GetProfileRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "ada@test.com", profile.Email)
+
+	updated, err := users.UpdateProfile(ctx, &pb.UpdateProfileRequest{FirstName: "Ada", LastName: "Lovelace"})
+	require.NoError(t, err)
+	assert.Equal(t, "Lovelace", updated.LastName)
+
+	list, err := users.ListUsers(ctx, &pb.ListUsersRequest{})
+	require.NoError(t, err)
+	assert.Len(t, list.Users, 1)
+
+	// Aynı e-posta ile ikinci kayıt reddedilir
+	_, err = users.Register(context.Background(), &pb.RegisterRequest{Email: "ada@test.com", Password: "x"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Çıkıştan sonra token geçersizdir
+	_, err = users.Logout(ctx, &pb.LogoutRequest{})
+	require.NoError(t, err)
+	_, err = users.GetProfile(ctx, &pb.GetProfileRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestTripServiceGRPC_CRUD(t *testing.T) {
+	trips, users := startTravelServer(t)
+	ctx := loginAs(t, users, "owner@test.com")
+
+	_, err := trips.CreateTrip(context.Background(), &pb.CreateTripRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	trip, err := trips.CreateTrip(ctx, &pb.CreateTripRequest{
+		Trip: &pb.TripInput{Title: "Rome", Destination: "Rome", StartDate: "2025-06-01", EndDate: "2025-06-04", Budget: 500, IsPublic: true},
+		Activities: []*pb.ActivityInput{
+			{Name: "Colosseum", Location: "Rome", Date: "2025-06-02"},
+		},
+		Expenses: []*pb.ExpenseInput{
+			{Category: "Food", Amount: 40, ExpenseDate: "2025-06-02"},
+		},
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, trip.Id)
+	assert.Equal(t, "EUR", trip.Currency)
+	assert.NotNil(t, trip.Latitude) // Gazetteer'dan
+	require.Len(t, trip.Activities, 1)
+	require.Len(t, trip.Expenses, 1)
+	assert.Equal(t, "food", trip.Expenses[0].Category)
+
+	// Geçersiz tarih sessizce atlanmaz
+	_, err = trips.CreateTrip(ctx, &pb.CreateTripRequest{
+		Trip:       &pb.TripInput{Title: "X", Destination: "Paris", StartDate: "2025-06-01", EndDate: "2025-06-02"},
+		Activities: []*pb.ActivityInput{{Name: "Louvre", Date: "June 2"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "activities[0]")
+
+	// Güncelleme: boş tarih ve para birimi korunur
+	updated, err := trips.UpdateTrip(ctx, &pb.UpdateTripRequest{
+		TripId: trip.Id,
+		Trip:   &pb.TripInput{Title: "Rome in June", Destination: "Rome", Budget: 800, IsPublic: true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Rome in June", updated.Title)
+	assert.Equal(t, 800.0, updated.Budget)
+	assert.Equal(t, "2025-06-04", updated.EndDate)
+
+	activity, err := trips.AddActivity(ctx, &pb.ActivityRequest{TripId: trip.Id, Activity: &pb.ActivityInput{Name: "Vatican", Date: "2025-06-03"}})
+	require.NoError(t, err)
+	activity, err = trips.UpdateActivity(ctx, &pb.ActivityRequest{TripId: trip.Id, ActivityId: activity.Id, Activity: &pb.ActivityInput{Name: "Vatican Museums"}})
+	require.NoError(t, err)
+	assert.Equal(t, "2025-06-03", activity.Date)
+
+	expense, err := trips.AddExpense(ctx, &pb.ExpenseRequest{TripId: trip.Id, Expense: &pb.ExpenseInput{Category: "transport", Amount: 20, Currency: "USD", ExpenseDate: "2025-06-03"}})
+	require.NoError(t, err)
+	assert.NotZero(t, expense.ExchangeRate)
+	expense, err = trips.UpdateExpense(ctx, &pb.ExpenseRequest{TripId: trip.Id, ExpenseId: expense.Id, Expense: &pb.ExpenseInput{Category: "transport", Amount: 25}})
+	require.NoError(t, err)
+	assert.Equal(t, 25.0, expense.Amount)
+	assert.Equal(t, "USD", expense.Currency)
+
+	_, err = trips.AddExpense(ctx, &pb.ExpenseRequest{TripId: trip.Id, Expense: &pb.ExpenseInput{Category: "unknown", Amount: 5, ExpenseDate: "2025-06-03"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = trips.DeleteActivity(ctx, &pb.TripItemRequest{TripId: trip.Id, ItemId: activity.Id})
+	require.NoError(t, err)
+	_, err = trips.DeleteExpense(ctx, &pb.TripItemRequest{TripId: trip.Id, ItemId: expense.Id})
+	require.NoError(t, err)
+	_, err = trips.DeleteExpense(ctx, &pb.TripItemRequest{TripId: trip.Id, ItemId: expense.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	loaded, err := trips.GetTrip(ctx, &pb.TripRequest{TripId: trip.Id})
+	require.NoError(t, err)
+	assert.Len(t, loaded.Activities, 1)
+	assert.Len(t, loaded.Expenses, 1)
+
+	mine, err := trips.ListMyTrips(ctx, &pb.ListTripsRequest{Statuses: []string{"completed"}})
+	require.NoError(t, err)
+	assert.Len(t, mine.Trips, 1)
+
+	public, err := trips.ListPublicTrips(context.Background(), &pb.ListPublicTripsRequest{Destination: "rome"})
+	require.NoError(t, err)
+	assert.Len(t, public.Trips, 1)
+
+	_, err = trips.DeleteTrip(ctx, &pb.TripRequest{TripId: trip.Id})
+	require.NoError(t, err)
+	_, err = trips.GetTrip(ctx, &pb.TripRequest{TripId: trip.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestTripServiceGRPC_Ownership(t *testing.T) {
+	trips, users := startTravelServer(t)
+	owner := loginAs(t, users, "owner@test.com")
+	other := loginAs(t, users, "other@test.com")
+
+	private, err := trips.CreateTrip(owner, &pb.CreateTripRequest{
+		Trip: &pb.TripInput{Title: "Secret", Destination: "Paris", StartDate: "2025-06-01", EndDate: "2025-06-02"},
+	})
+	require.NoError(t, err)
+	public, err := trips.CreateTrip(owner, &pb.CreateTripRequest{
+		Trip: &pb.TripInput{Title: "Open", Destination: "Paris", StartDate: "2025-06-01", EndDate: "2025-06-02", IsPublic: true},
+	})
+	require.NoError(t, err)
+
+	// Özel gezi sadece sahibine görünür
+	_, err = trips.GetTrip(other, &pb.TripRequest{TripId: private.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = trips.GetTrip(context.Background(), &pb.TripRequest{TripId: private.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = trips.GetTrip(owner, &pb.TripRequest{TripId: private.Id})
+	assert.NoError(t, err)
+	_, err = trips.GetTrip(context.Background(), &pb.TripRequest{TripId: public.Id})
+	assert.NoError(t, err)
+
+	// Başkasının gezisi değiştirilemez
+	_, err = trips.UpdateTrip(other, &pb.UpdateTripRequest{TripId: public.Id, Trip: &pb.TripInput{Title: "Mine", Destination: "Paris"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = trips.DeleteTrip(other, &pb.TripRequest{TripId: public.Id})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = trips.AddExpense(other, &pb.ExpenseRequest{TripId: public.Id, Expense: &pb.ExpenseInput{Category: "food", Amount: 1, ExpenseDate: "2025-06-01"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	mine, err := trips.ListMyTrips(other, &pb.ListTripsRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, mine.Trips)
+}
@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"testing"
+	"time"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTripHistory_RecordsMutations(t *testing.T) {
+	_, service := setupTrashService(t)
+	trip := createTrashTrip(t, service, 1)
+
+	// Bir işbirlikçi (kullanıcı 2) bütçeyi değiştirir
+	trip.Budget = 900
+	assert.NoError(t, service.UpdateTrip(trip, 2))
+
+	activity := &models.Activity{TripID: trip.ID, Name: "Pantheon", Date: trip.StartDate}
+	assert.NoError(t, service.AddActivity(activity, 1))
+	assert.NoError(t, service.DeleteActivity(trip.Activities[0].ID, 2))
+
+	expense := trip.Expenses[0]
+	expense.Amount = 35
+	assert.NoError(t, service.UpdateExpense(&expense, 1))
+
+	t.Run("Unchanged update is not recorded", func(t *testing.T) {
+		current, _ := service.GetTripByID(trip.ID)
+		assert.NoError(t, service.UpdateTrip(current, 1))
+	})
+
+	history, err := service.GetTripHistory(trip.ID)
+	assert.NoError(t, err)
+	assert.Len(t, history, 5)
+
+	// En yeni kayıt başta
+	assert.Equal(t, models.AuditEntityExpense, history[0].EntityType)
+	assert.Equal(t, []models.FieldChange{{Field: "amount", Old: 20.0, New: 35.0}}, history[0].Changes)
+
+	assert.Equal(t, models.AuditDelete, history[1].Action)
+	assert.Equal(t, models.AuditEntityActivity, history[1].EntityType)
+	assert.Equal(t, uint(2), history[1].ActorID)
+
+	assert.Equal(t, models.AuditCreate, history[2].Action)
+	assert.Equal(t, activity.ID, history[2].EntityID)
+
+	assert.Equal(t, uint(2), history[3].ActorID)
+	assert.Equal(t, []models.FieldChange{{Field: "budget", Old: 0.0, New: 900.0}}, history[3].Changes)
+
+	assert.Equal(t, models.AuditCreate, history[4].Action)
+	assert.Equal(t, models.AuditEntityTrip, history[4].EntityType)
+}
+
+func TestTripHistory_Revert(t *testing.T) {
+	_, service := setupTrashService(t)
+	trip := createTrashTrip(t, service, 1)
+	removedID := trip.Activities[0].ID
+
+	trip.Title = "Rome again"
+	assert.NoError(t, service.UpdateTrip(trip, 1))
+	assert.NoError(t, service.DeleteActivity(removedID, 1))
+	assert.NoError(t, service.AddExpense(&models.Expense{TripID: trip.ID, Category: "transport", Amount: 12, ExpenseDate: trip.StartDate}, 1))
+
+	history, _ := service.GetTripHistory(trip.ID)
+	created := history[len(history)-1]
+
+	reverted, err := service.RevertTrip(trip.ID, created.ID, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "Rome", reverted.Title)
+	assert.Len(t, reverted.Activities, 2)
+	assert.Len(t, reverted.Expenses, 1)
+	assert.Equal(t, "food", reverted.Expenses[0].Category)
+
+	// Silinen aktivite aynı ID ile geri geldi
+	var ids []uint
+	for _, a := range reverted.Activities {
+		ids = append(ids, a.ID)
+	}
+	assert.Contains(t, ids, removedID)
+
+	// Geri alma da geçmişe eklenir
+	history, _ = service.GetTripHistory(trip.ID)
+	assert.Equal(t, models.AuditRevert, history[0].Action)
+	assert.Equal(t, uint(2), history[0].ActorID)
+	assert.Contains(t, history[0].Changes, models.FieldChange{Field: "title", Old: "Rome again", New: "Rome"})
+
+	t.Run("Entry of another trip", func(t *testing.T) {
+		other := createTrashTrip(t, service, 1)
+		_, err := service.RevertTrip(other.ID, created.ID, 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestTripHistory_PurgeRemovesHistory(t *testing.T) {
+	db, service := setupTrashService(t)
+	trip := createTrashTrip(t, service, 1)
+
+	assert.NoError(t, service.DeleteTrip(trip.ID, 1))
+	assert.NoError(t, service.PurgeTrip(trip.ID))
+
+	entries, err := repository.NewAuditRepository(db).GetEntriesByTripID(trip.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestTripHistory_SnapshotRoundTrip(t *testing.T) {
+	lat := 41.9
+	day := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	trip := &models.Trip{
+		ID:          7,
+		Title:       "Rome",
+		Destination: "Rome",
+		Latitude:    &lat,
+		StartDate:   day,
+		EndDate:     day,
+		Activities:  []models.Activity{{ID: 3, TripID: 7, Name: "Colosseum", Date: day}},
+		Expenses:    []models.Expense{{ID: 4, TripID: 7, Category: "food", Amount: 10, Currency: "EUR", ExpenseDate: day}},
+	}
+
+	restored := &models.Trip{ID: 7}
+	models.NewTripSnapshot(trip).ApplyTo(restored)
+
+	assert.Equal(t, trip.Title, restored.Title)
+	assert.Equal(t, lat, *restored.Latitude)
+	assert.Equal(t, trip.Activities, restored.Activities)
+	assert.Equal(t, trip.Expenses, restored.Expenses)
+}
+
+func TestTripHistory_RevertKeepsCatalogLink(t *testing.T) {
+	db, service := setupTrashService(t)
+	catalog := seedDestinations(t, db)
+	rome, _ := catalog.Match("Rome")
+	paris, _ := catalog.Match("Paris")
+
+	trip := createTrashTrip(t, service, 1)
+	trip.Title, trip.DestinationID = "Rome again", &rome.ID
+	assert.NoError(t, service.UpdateTrip(trip, 1))
+	history, _ := service.GetTripHistory(trip.ID)
+	linked := history[0]
+
+	trip.Title = "Rome once more"
+	assert.NoError(t, service.UpdateTrip(trip, 1))
+	reverted, err := service.RevertTrip(trip.ID, linked.ID, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, rome.ID, *reverted.DestinationID)
+
+	// Başka bir destinasyondan geri dönünce bağlantı da geri gelir
+	reverted.Destination, reverted.DestinationID = "Paris", &paris.ID
+	assert.NoError(t, service.UpdateTrip(reverted, 1))
+	reverted, err = service.RevertTrip(trip.ID, linked.ID, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "Rome", reverted.Destination)
+	assert.Equal(t, rome.ID, *reverted.DestinationID)
+
+	// Bağlantı alanı olmayan eski kayıtlar: destinasyon aynıysa bağlantı korunur, değiştiyse temizlenir
+	legacy := models.TripSnapshot{Destination: "Rome"}
+	kept := &models.Trip{Destination: "Rome", DestinationID: &rome.ID}
+	legacy.ApplyTo(kept)
+	assert.Equal(t, rome.ID, *kept.DestinationID)
+	moved := &models.Trip{Destination: "Paris", DestinationID: &paris.ID}
+	legacy.ApplyTo(moved)
+	assert.Nil(t, moved.DestinationID)
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"travel-platform/internal/importer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const sampleICS = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:flight-1\r\n" +
+	"SUMMARY:Flight TK1821 IST\\, FCO\r\n" +
+	"LOCATION:Rome\r\n" +
+	"DTSTART:20250601T080000Z\r\n" +
+	"DTEND:20250601T103000Z\r\n" +
+	"DESCRIPTION:Seat 12A\\nGate B4\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:hotel-1\r\n" +
+	"SUMMARY:Hotel Artemide\r\n" +
+	"LOCATION:Rome\r\n" +
+	"DTSTART;VALUE=DATE:20250601\r\n" +
+	"DTEND;VALUE=DATE:20250605\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:broken-1\r\n" +
+	"SUMMARY:Broken\r\n" +
+	"DTSTART:not-a-date\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseICS(t *testing.T) {
+	events, itemErrors, err := importer.ParseICS(strings.NewReader(sampleICS))
+
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Len(t, itemErrors, 1)
+	assert.Contains(t, itemErrors[0].Item, "broken-1")
+
+	assert.Equal(t, "Flight TK1821 IST, FCO", events[0].Summary)
+	assert.Equal(t, "Seat 12A\nGate B4", events[0].Description)
+	assert.True(t, events[1].AllDay)
+	// DTEND all-day event'lerde exclusive: 5 Haziran -> 4 Haziran
+	assert.Equal(t, 4, events[1].End.Day())
+
+	t.Run("Not a calendar", func(t *testing.T) {
+		_, _, err := importer.ParseICS(strings.NewReader("hello"))
+		assert.Error(t, err)
+	})
+}
+
+func TestTripFromEvents(t *testing.T) {
+	events, _, _ := importer.ParseICS(strings.NewReader(sampleICS))
+
+	trip, err := importer.TripFromEvents(1, events, importer.TripOptions{Title: "Rome"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Rome", trip.Title)
+	assert.Equal(t, "Rome", trip.Destination)
+	assert.Equal(t, 1, trip.StartDate.Day())
+	assert.Equal(t, 4, trip.EndDate.Day())
+	assert.Len(t, trip.Activities, 2)
+}
+
+func TestParseBundle(t *testing.T) {
+	data := []byte(`{
+		"format": "travelmate-itinerary",
+		"version": 1,
+		"trips": [
+			{"title": "Paris", "destination": "Paris", "start_date": "2025-05-01", "end_date": "2025-05-03",
+			 "activities": [{"name": "Louvre", "date": "2025-05-02"}, {"name": "", "date": "2025-05-02"}],
+			 "expenses": [{"category": "food", "amount": 20, "expense_date": "2025-05-02"}]},
+			{"title": "Bad", "destination": "X", "start_date": "05/01/2025", "end_date": "2025-05-03"}
+		]
+	}`)
+
+	bundle, err := importer.ParseBundle(data)
+	assert.NoError(t, err)
+
+	trips, itemErrors := bundle.ToTrips(7)
+	assert.Len(t, trips, 1)
+	assert.Len(t, itemErrors, 2)
+	assert.Equal(t, uint(7), trips[0].UserID)
+	assert.Len(t, trips[0].Activities, 1)
+	assert.Equal(t, "EUR", trips[0].Expenses[0].Currency)
+
+	t.Run("Wrong format", func(t *testing.T) {
+		_, err := importer.ParseBundle([]byte(`{"format": "other", "version": 1}`))
+		assert.Error(t, err)
+	})
+}
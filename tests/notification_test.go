@@ -0,0 +1,235 @@
+package tests
+
+import (
+	"sync"
+	"testing"
+	"time"
+	"travel-platform/internal/backup"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeMailer - Gönderilen e-postaları kaydeder; block verilirse kapanana kadar bekler (yavaş SMTP)
+type fakeMailer struct {
+	mu    sync.Mutex
+	sent  []string // "alıcı|konu"
+	block chan struct{}
+}
+
+func (m *fakeMailer) Send(to, subject, body string) error {
+	if m.block != nil {
+		<-m.block
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, to+"|"+subject)
+	return nil
+}
+
+func (m *fakeMailer) Sent() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.sent...)
+}
+
+// fakeBroadcaster - Sohbet odasına iletilen mesajları kaydeder
+type fakeBroadcaster struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (b *fakeBroadcaster) Broadcast(roomID uint, message, sender string, senderID uint) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.messages = append(b.messages, sender+": "+message)
+}
+
+func (b *fakeBroadcaster) Messages() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.messages...)
+}
+
+func TestNotificationService_BudgetAlerts(t *testing.T) {
+	db, tripService := setupTrashService(t)
+	assert.NoError(t, db.AutoMigrate(&models.Notification{}, &models.NotificationSettings{}, &models.ChatRoom{}, &models.ChatMessage{}))
+	userRepo := repository.NewUserRepository(db)
+	owner := &models.User{Email: "owner@test.com", FirstName: "Olive", LastName: "Owner", Password: "x"}
+	friend := &models.User{Email: "friend@test.com", FirstName: "Fred", LastName: "Friend", Password: "x"}
+	assert.NoError(t, userRepo.CreateUser(owner))
+	assert.NoError(t, userRepo.CreateUser(friend))
+
+	memberService := services.NewMemberService(repository.NewMemberRepository(db), userRepo)
+	budgetService := services.NewBudgetService(repository.NewCategoryBudgetRepository(db))
+	chatRepo := repository.NewChatRepository(db)
+	mailer := &fakeMailer{}
+	broadcaster := &fakeBroadcaster{}
+	service := services.NewNotificationService(repository.NewNotificationRepository(db), tripService, budgetService, memberService,
+		userRepo, chatRepo, broadcaster, mailer)
+
+	trip := createTrashTrip(t, tripService, owner.ID) // food 20 EUR
+	trip.Budget = 100
+	assert.NoError(t, tripService.UpdateTrip(trip, owner.ID))
+	_, err := memberService.AddMember(trip, friend.Email)
+	assert.NoError(t, err)
+	_, err = budgetService.SetCategoryBudgets(trip, []models.CategoryBudget{{Category: "food", Amount: 50}})
+	assert.NoError(t, err)
+
+	_, err = service.UpdateSettings(friend.ID, &models.NotificationSettings{BudgetEmail: true, BudgetChat: true})
+	assert.NoError(t, err)
+
+	today := day("2025-06-04")
+	addExpense := func(category string, amount float64) *models.Expense {
+		expense := &models.Expense{TripID: trip.ID, Category: category, Amount: amount, Currency: "EUR", ExpenseDate: day("2025-06-02")}
+		assert.NoError(t, tripService.AddExpense(expense, owner.ID))
+		return expense
+	}
+
+	events, err := service.CheckBudgetAlerts(trip.ID, today)
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	t.Run("Crossing a category threshold notifies every participant", func(t *testing.T) {
+		addExpense("food", 20)
+		events, err := service.CheckBudgetAlerts(trip.ID, today)
+		assert.NoError(t, err)
+		assert.Equal(t, []services.BudgetAlertEvent{{Category: "food", Threshold: 75, Budget: 50, Spent: 40, Currency: "EUR"}}, events)
+		assert.Equal(t, "⚠️ Rome: 75% of the food budget used (40.00 of 50.00 EUR).", events[0].Message("Rome"))
+		service.Wait()
+
+		ownerNotes, _ := service.GetNotifications(owner.ID, true)
+		assert.Len(t, ownerNotes, 1)
+		assert.Equal(t, "Budget alert: Rome", ownerNotes[0].Title)
+		friendNotes, _ := service.GetNotifications(friend.ID, true)
+		assert.Len(t, friendNotes, 0) // Fred sadece e-posta istedi
+		assert.Equal(t, []string{"friend@test.com|Budget alert: Rome"}, mailer.Sent())
+		assert.Empty(t, broadcaster.Messages()) // Sohbet ayarı sadece gezi sahibininki geçerli
+	})
+
+	t.Run("Only the highest new threshold is reported, once", func(t *testing.T) {
+		addExpense("food", 15)
+		events, err := service.CheckBudgetAlerts(trip.ID, today)
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, 100, events[0].Threshold)
+		assert.Contains(t, events[0].Message("Rome"), "🚨")
+
+		events, err = service.CheckBudgetAlerts(trip.ID, today)
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("Total budget alerts go to the trip chat when the owner opts in", func(t *testing.T) {
+		_, err := service.UpdateSettings(owner.ID, &models.NotificationSettings{BudgetInApp: true, BudgetChat: true})
+		assert.NoError(t, err)
+
+		transport := addExpense("transport", 30)
+		events, err := service.CheckBudgetAlerts(trip.ID, today)
+		assert.NoError(t, err)
+		assert.Equal(t, []services.BudgetAlertEvent{{Threshold: 75, Budget: 100, Spent: 85, Currency: "EUR"}}, events)
+		service.Wait()
+		assert.Equal(t, []string{"System: ⚠️ Rome: 75% of the trip budget used (85.00 of 100.00 EUR)."}, broadcaster.Messages())
+
+		room, err := chatRepo.GetRoomByTripID(trip.ID)
+		assert.NoError(t, err)
+		messages, _ := chatRepo.GetMessagesByRoomID(room.ID)
+		assert.Len(t, messages, 1)
+		assert.Equal(t, "System", messages[0].SenderName())
+		assert.Equal(t, "System", backup.NewTripRecord(*trip, messages).Chat[0].AuthorName)
+
+		t.Run("Dropping below a threshold resets it", func(t *testing.T) {
+			assert.NoError(t, tripService.DeleteExpense(transport.ID, owner.ID))
+			events, err := service.CheckBudgetAlerts(trip.ID, today)
+			assert.NoError(t, err)
+			assert.Empty(t, events)
+
+			addExpense("transport", 25)
+			events, err = service.CheckBudgetAlerts(trip.ID, today)
+			assert.NoError(t, err)
+			assert.Len(t, events, 1)
+			assert.Equal(t, 75, events[0].Threshold)
+			service.Wait()
+		})
+
+		t.Run("Removing a budget resets its thresholds", func(t *testing.T) {
+			trip.Budget = 0
+			assert.NoError(t, tripService.UpdateTrip(trip, owner.ID))
+			events, err := service.CheckBudgetAlerts(trip.ID, today)
+			assert.NoError(t, err)
+			assert.Empty(t, events)
+
+			trip.Budget = 100
+			assert.NoError(t, tripService.UpdateTrip(trip, owner.ID))
+			events, err = service.CheckBudgetAlerts(trip.ID, today)
+			assert.NoError(t, err)
+			assert.Equal(t, []services.BudgetAlertEvent{{Threshold: 75, Budget: 100, Spent: 80, Currency: "EUR"}}, events)
+			service.Wait()
+		})
+	})
+
+	t.Run("Reading notifications", func(t *testing.T) {
+		notes, _ := service.GetNotifications(owner.ID, false)
+		assert.Len(t, notes, 5)
+		assert.Error(t, service.MarkRead(friend.ID, notes[0].ID))
+		assert.NoError(t, service.MarkRead(owner.ID, notes[0].ID))
+
+		unread, _ := service.CountUnread(owner.ID)
+		assert.Equal(t, int64(4), unread)
+		marked, err := service.MarkAllRead(owner.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), marked)
+		unread, _ = service.CountUnread(owner.ID)
+		assert.Zero(t, unread)
+	})
+
+	t.Run("Default settings", func(t *testing.T) {
+		settings, err := service.GetSettings(999)
+		assert.NoError(t, err)
+		assert.True(t, settings.BudgetInApp)
+		assert.False(t, settings.BudgetEmail)
+		assert.False(t, settings.BudgetChat)
+	})
+}
+
+func TestNotificationService_SlowMailerDoesNotBlock(t *testing.T) {
+	db, tripService := setupTrashService(t)
+	assert.NoError(t, db.AutoMigrate(&models.Notification{}, &models.NotificationSettings{}, &models.ChatRoom{}, &models.ChatMessage{}))
+	userRepo := repository.NewUserRepository(db)
+	owner := &models.User{Email: "owner@test.com", FirstName: "Olive", LastName: "Owner", Password: "x"}
+	assert.NoError(t, userRepo.CreateUser(owner))
+
+	mailer := &fakeMailer{block: make(chan struct{})}
+	service := services.NewNotificationService(repository.NewNotificationRepository(db), tripService,
+		services.NewBudgetService(repository.NewCategoryBudgetRepository(db)),
+		services.NewMemberService(repository.NewMemberRepository(db), userRepo), userRepo, repository.NewChatRepository(db), nil, mailer)
+	_, err := service.UpdateSettings(owner.ID, &models.NotificationSettings{BudgetInApp: true, BudgetEmail: true})
+	assert.NoError(t, err)
+
+	trip := createTrashTrip(t, tripService, owner.ID) // food 20 EUR
+	trip.Budget = 20
+	assert.NoError(t, tripService.UpdateTrip(trip, owner.ID))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		events, err := service.CheckBudgetAlerts(trip.ID, day("2025-06-04"))
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("CheckBudgetAlerts waited for the mailer")
+	}
+
+	// Uygulama içi bildirim istek içinde yazılır, e-posta SMTP cevap verince gider
+	notes, _ := service.GetNotifications(owner.ID, true)
+	assert.Len(t, notes, 1)
+	assert.Empty(t, mailer.Sent())
+	close(mailer.block)
+	service.Wait()
+	assert.Equal(t, []string{"owner@test.com|Budget alert: Rome"}, mailer.Sent())
+}
@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"travel-platform/internal/backup"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/services"
+	"travel-platform/internal/storage"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// Dosya türü içerikten tespit edildiği için gerçek imzalar kullanılır
+var (
+	pngReceipt = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	pdfReceipt = []byte("%PDF-1.4\n%receipt")
+)
+
+func setupReceiptService(t *testing.T, db *gorm.DB) services.ReceiptService {
+	store, err := storage.NewLocalStore(t.TempDir())
+	assert.NoError(t, err)
+	return services.NewReceiptService(repository.NewReceiptRepository(db), store)
+}
+
+func TestLocalStore(t *testing.T) {
+	store, err := storage.NewLocalStore(t.TempDir())
+	assert.NoError(t, err)
+
+	assert.NoError(t, store.Put("receipts/1/a.png", []byte("one")))
+	assert.NoError(t, store.Put("other/b.txt", []byte("two")))
+
+	data, err := store.Get("receipts/1/a.png")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("one"), data)
+
+	keys, err := store.List("receipts/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"receipts/1/a.png"}, keys)
+
+	assert.NoError(t, store.Delete("receipts/1/a.png"))
+	assert.NoError(t, store.Delete("receipts/1/a.png"))
+	_, err = store.Get("receipts/1/a.png")
+	assert.True(t, errors.Is(err, storage.ErrNotFound))
+
+	// Kök dizinin dışına çıkan anahtarlar reddedilir
+	for _, key := range []string{"", "../escape", "/etc/passwd", "a/../../b", "a//b"} {
+		assert.Error(t, store.Put(key, []byte("x")), key)
+	}
+}
+
+func TestReceipts_UploadAndDelete(t *testing.T) {
+	db, tripService := setupTrashService(t)
+	service := setupReceiptService(t, db)
+	trip := createTrashTrip(t, tripService, 1)
+	expenseID := trip.Expenses[0].ID
+
+	receipt := &models.Receipt{ExpenseID: expenseID, FileName: `C:\scans\dinner bill.png`, UploadedByID: 1}
+	assert.NoError(t, service.UploadReceipt(receipt, pngReceipt))
+	assert.Equal(t, "dinner bill.png", receipt.FileName)
+	assert.Equal(t, "image/png", receipt.ContentType)
+	assert.Equal(t, int64(len(pngReceipt)), receipt.Size)
+	assert.True(t, receipt.IsImage())
+	assert.NotContains(t, receipt.StorageKey, " ")
+
+	pdf := &models.Receipt{ExpenseID: expenseID, FileName: "hotel.pdf", UploadedByID: 1}
+	assert.NoError(t, service.UploadReceipt(pdf, pdfReceipt))
+	assert.Equal(t, "application/pdf", pdf.ContentType)
+
+	// Gezi yüklenirken fişler de gelir
+	saved, _ := tripService.GetTripByID(trip.ID)
+	assert.Len(t, saved.Expenses[0].Receipts, 2)
+
+	data, err := service.OpenReceipt(receipt)
+	assert.NoError(t, err)
+	assert.Equal(t, pngReceipt, data)
+
+	assert.NoError(t, service.DeleteReceipt(receipt))
+	_, err = service.OpenReceipt(receipt)
+	assert.Error(t, err)
+	receipts, _ := service.GetReceipts(expenseID)
+	assert.Len(t, receipts, 1)
+
+	t.Run("Invalid uploads", func(t *testing.T) {
+		assert.Error(t, service.UploadReceipt(&models.Receipt{ExpenseID: expenseID, FileName: "a.txt"}, []byte("just text")))
+		assert.Error(t, service.UploadReceipt(&models.Receipt{ExpenseID: expenseID, FileName: "a.png"}, nil))
+		assert.Error(t, service.UploadReceipt(&models.Receipt{FileName: "a.png"}, pngReceipt))
+		big := append(append([]byte{}, pdfReceipt...), make([]byte, services.MaxReceiptSize)...)
+		assert.Error(t, service.UploadReceipt(&models.Receipt{ExpenseID: expenseID, FileName: "big.pdf"}, big))
+	})
+}
+
+func TestReceipts_PurgedTripLeavesOrphansForCleanup(t *testing.T) {
+	db, tripService := setupTrashService(t)
+	service := setupReceiptService(t, db)
+	trip := createTrashTrip(t, tripService, 1)
+	kept := createTrashTrip(t, tripService, 1)
+
+	assert.NoError(t, service.UploadReceipt(&models.Receipt{ExpenseID: trip.Expenses[0].ID, FileName: "a.png", UploadedByID: 1}, pngReceipt))
+	keptReceipt := &models.Receipt{ExpenseID: kept.Expenses[0].ID, FileName: "b.png", UploadedByID: 1}
+	assert.NoError(t, service.UploadReceipt(keptReceipt, pngReceipt))
+
+	assert.NoError(t, tripService.DeleteTrip(trip.ID, 1))
+	assert.NoError(t, tripService.PurgeTrip(trip.ID))
+
+	// Yeni dosyalar yükleme sürüyor olabilir, grace süresi içinde silinmez
+	purged, err := service.PurgeOrphans(services.ReceiptOrphanGrace)
+	assert.NoError(t, err)
+	assert.Zero(t, purged)
+
+	purged, err = service.PurgeOrphans(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, purged)
+
+	_, err = service.OpenReceipt(keptReceipt)
+	assert.NoError(t, err)
+}
+
+func TestBackup_ReceiptsRoundTrip(t *testing.T) {
+	db, tripService := setupTrashService(t)
+	assert.NoError(t, db.AutoMigrate(&models.ChatRoom{}, &models.ChatMessage{}))
+	userRepo := repository.NewUserRepository(db)
+	user := &models.User{Email: "receipts@test.com", FirstName: "Ada", LastName: "Lovelace", Password: "x"}
+	assert.NoError(t, userRepo.CreateUser(user))
+
+	receiptService := setupReceiptService(t, db)
+	service := services.NewBackupService(tripService, receiptService, repository.NewChatRepository(db), userRepo)
+
+	trip := createTrashTrip(t, tripService, user.ID)
+	expenseID := trip.Expenses[0].ID
+	assert.NoError(t, receiptService.UploadReceipt(&models.Receipt{ExpenseID: expenseID, FileName: "bill.png", UploadedByID: user.ID}, pngReceipt))
+	assert.NoError(t, receiptService.UploadReceipt(&models.Receipt{ExpenseID: expenseID, FileName: "bill.png", UploadedByID: user.ID}, pdfReceipt))
+
+	saved, _ := tripService.GetTripByID(trip.ID)
+	archive, err := service.Export([]models.Trip{*saved})
+	assert.NoError(t, err)
+	assert.Len(t, archive.Manifest.Attachments, 2)
+	assert.Equal(t, pdfReceipt, archive.Files[fmt.Sprintf("attachments/expense-%d/bill-2.png", expenseID)])
+
+	var buf bytes.Buffer
+	assert.NoError(t, backup.Write(&buf, archive))
+	read, err := backup.Read(buf.Bytes())
+	assert.NoError(t, err)
+
+	result, err := service.Restore(user.ID, read)
+	assert.NoError(t, err)
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, 2, result.Receipts)
+
+	receipts, _ := receiptService.GetReceipts(result.IDMap.Expenses[expenseID])
+	assert.Len(t, receipts, 2)
+	assert.Equal(t, "image/png", receipts[0].ContentType)
+	assert.Equal(t, "application/pdf", receipts[1].ContentType)
+}
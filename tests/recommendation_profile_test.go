@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/grpc"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	pb "travel-platform/proto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+// authedContext - AuthInterceptor'dan geçmiş, kullanıcıyı taşıyan sunucu context'i
+func authedContext(t *testing.T, userID uint) context.Context {
+	token := middleware.CreateSession(userID, "user@test.com")
+	incoming := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	var authed context.Context
+	_, err := grpc.AuthInterceptor(incoming, nil, nil, func(ctx context.Context, _ any) (any, error) {
+		authed = ctx
+		return nil, nil
+	})
+	require.NoError(t, err)
+	return authed
+}
+
+func recommendationFor(recs []*pb.Recommendation, destination string) *pb.Recommendation {
+	for _, rec := range recs {
+		if rec.Destination == destination {
+			return rec
+		}
+	}
+	return nil
+}
+
+func TestGetRecommendations_PersonalizedFromHistory(t *testing.T) {
+	mockService := new(MockTripService)
+	server := grpc.NewRecommendationServer(mockService, nil, setupCategoryService(t), setupDestinationService(t), currency.DefaultTable())
+	mockService.On("GetPublicTrips").Return([]models.Trip{
+		{Destination: "Rome, Italy", Budget: 900, IsPublic: true},
+		{Destination: "Florence", Budget: 1000, IsPublic: true, Activities: []models.Activity{{Name: "Uffizi Museum"}}},
+		{Destination: "Tokyo", Budget: 3000, IsPublic: true, Activities: []models.Activity{{Name: "Sushi Class"}}},
+	}, nil)
+
+	resp, err := server.GetRecommendations(context.Background(), &pb.RecommendationRequest{
+		UserId: 1,
+		History: &pb.UserTripHistory{UserId: 1, PastTrips: []*pb.TripInfo{
+			{Destination: "Rome", Budget: 1000, StartDate: "2024-04-10", EndDate: "2024-04-14", Activities: []string{"Colosseum Tour", "Vatican Museums"}},
+			{Destination: "Lisbon", Budget: 1100, Currency: "EUR", StartDate: "2024-05-05", EndDate: "2024-05-09", Activities: []string{"Food Tour"}},
+		}},
+	})
+	assert.NoError(t, err)
+
+	t.Run("Visited places are excluded", func(t *testing.T) {
+		assert.Nil(t, recommendationFor(resp.Recommendations, "Rome, Italy"))
+	})
+
+	t.Run("Similar places are boosted with reasons", func(t *testing.T) {
+		florence := recommendationFor(resp.Recommendations, "Florence")
+		assert.NotNil(t, florence)
+		assert.Equal(t, "Florence", resp.Recommendations[0].Destination)
+		assert.Equal(t, 78.0, florence.MatchScore)
+		assert.Equal(t, []string{
+			"In Italy, like your trip to Rome",
+			"Close to your usual budget of 1050 EUR",
+			"Activities you enjoyed before: museum",
+			"Best in Spring, when you usually travel",
+		}, florence.Reasons)
+
+		tokyo := recommendationFor(resp.Recommendations, "Tokyo")
+		assert.Equal(t, 55.0, tokyo.MatchScore)
+		assert.Equal(t, []string{"Best in Spring, when you usually travel"}, tokyo.Reasons)
+	})
+
+	t.Run("Static suggestions use the favorite season", func(t *testing.T) {
+		istanbul := recommendationFor(resp.Recommendations, "Istanbul, Turkey")
+		assert.NotNil(t, istanbul)
+		assert.Contains(t, istanbul.Reasons, "Best in Spring, when you usually travel")
+		assert.Equal(t, 65.0, istanbul.MatchScore)
+	})
+}
+
+func TestGetRecommendations_HistoryFromUserTrips(t *testing.T) {
+	_, tripService := setupTrashService(t)
+	server := grpc.NewRecommendationServer(tripService, nil, setupCategoryService(t), setupDestinationService(t), currency.DefaultTable())
+
+	createTrashTrip(t, tripService, 1) // Rome, Haziran
+	for _, destination := range []string{"Rome", "Milan", "Kyoto"} {
+		trip := &models.Trip{UserID: 2, Title: destination, Destination: destination, IsPublic: true,
+			StartDate: day("2025-07-01"), EndDate: day("2025-07-05")}
+		assert.NoError(t, tripService.CreateTrip(trip))
+	}
+
+	resp, err := server.GetRecommendations(authedContext(t, 1), &pb.RecommendationRequest{UserId: 1, MaxBudget: 1500})
+	assert.NoError(t, err)
+	assert.Nil(t, recommendationFor(resp.Recommendations, "Rome"))
+	assert.Equal(t, "Milan", resp.Recommendations[0].Destination)
+	assert.Equal(t, []string{"Fits your budget of 1500 EUR", "In Italy, like your trip to Rome"}, resp.Recommendations[0].Reasons)
+
+	// Barcelona yaz mevsiminde öneriliyor, kullanıcı da Haziran'da gezmiş
+	barcelona := recommendationFor(resp.Recommendations, "Barcelona, Spain")
+	assert.NotNil(t, barcelona)
+	assert.Contains(t, barcelona.Reasons, "Best in Summer, when you usually travel")
+
+	t.Run("Users without trips get the same recommendations as before", func(t *testing.T) {
+		resp, err := server.GetRecommendations(context.Background(), &pb.RecommendationRequest{UserId: 3, MaxBudget: 1500})
+		assert.NoError(t, err)
+		assert.NotNil(t, recommendationFor(resp.Recommendations, "Rome"))
+		for _, rec := range resp.Recommendations {
+			assert.Equal(t, []string{"Fits your budget of 1500 EUR"}, rec.Reasons)
+		}
+	})
+
+	t.Run("Private trips only personalize for their owner", func(t *testing.T) {
+		for _, ctx := range []context.Context{context.Background(), authedContext(t, 2)} {
+			resp, err := server.GetRecommendations(ctx, &pb.RecommendationRequest{UserId: 1, MaxBudget: 1500})
+			assert.NoError(t, err)
+			assert.NotNil(t, recommendationFor(resp.Recommendations, "Rome"))
+			for _, rec := range resp.Recommendations {
+				assert.Equal(t, []string{"Fits your budget of 1500 EUR"}, rec.Reasons)
+			}
+		}
+	})
+
+	t.Run("Public trips personalize for anyone", func(t *testing.T) {
+		trip := &models.Trip{UserID: 4, Title: "Rome", Destination: "Rome", IsPublic: true,
+			StartDate: day("2025-06-01"), EndDate: day("2025-06-04")}
+		assert.NoError(t, tripService.CreateTrip(trip))
+
+		resp, err := server.GetRecommendations(context.Background(), &pb.RecommendationRequest{UserId: 4, MaxBudget: 1500})
+		assert.NoError(t, err)
+		assert.Nil(t, recommendationFor(resp.Recommendations, "Rome"))
+		assert.Contains(t, recommendationFor(resp.Recommendations, "Milan").Reasons, "In Italy, like your trip to Rome")
+	})
+}
@@ -3,6 +3,8 @@ package tests
 import (
 	"context"
 	"testing"
+	"time"
+	"travel-platform/internal/currency"
 	"travel-platform/internal/grpc"
 	"travel-platform/internal/models"
 	pb "travel-platform/proto"
@@ -19,17 +21,41 @@ type MockTripService struct {
 func (m *MockTripService) CreateTrip(trip *models.Trip) error                 { return nil }
 func (m *MockTripService) GetTripByID(id uint) (*models.Trip, error)          { return nil, nil }
 func (m *MockTripService) GetTripByUserID(userID uint) ([]models.Trip, error) { return nil, nil }
-func (m *MockTripService) UpdateTrip(trip *models.Trip) error                 { return nil }
-func (m *MockTripService) DeleteTrip(id uint) error                           { return nil }
+func (m *MockTripService) UpdateTrip(trip *models.Trip, actorID uint) error   { return nil }
+func (m *MockTripService) DeleteTrip(id uint, actorID uint) error             { return nil }
 func (m *MockTripService) GetPublicTrips() ([]models.Trip, error) {
 	args := m.Called()
 	return args.Get(0).([]models.Trip), args.Error(1)
 }
-func (m *MockTripService) SearchByDestination(dest string) ([]models.Trip, error) { return nil, nil }
+func (m *MockTripService) SearchByDestination(dest string) ([]models.Trip, error)       { return nil, nil }
+func (m *MockTripService) AddActivity(activity *models.Activity, actorID uint) error    { return nil }
+func (m *MockTripService) UpdateActivity(activity *models.Activity, actorID uint) error { return nil }
+func (m *MockTripService) DeleteActivity(id uint, actorID uint) error                   { return nil }
+func (m *MockTripService) AddExpense(expense *models.Expense, actorID uint) error       { return nil }
+func (m *MockTripService) UpdateExpense(expense *models.Expense, actorID uint) error    { return nil }
+func (m *MockTripService) DeleteExpense(id uint, actorID uint) error                    { return nil }
+func (m *MockTripService) GetTrash(userID uint) ([]models.Trip, error)                  { return nil, nil }
+func (m *MockTripService) GetDeletedTripByID(id uint) (*models.Trip, error)             { return nil, nil }
+func (m *MockTripService) RestoreTrip(id uint, actorID uint) error                      { return nil }
+func (m *MockTripService) PurgeTrip(id uint) error                                      { return nil }
+func (m *MockTripService) PurgeExpiredTrash(retention time.Duration) (int, error)       { return 0, nil }
+func (m *MockTripService) GetTripsByStatus(userID uint, statuses []string) ([]models.Trip, error) {
+	return nil, nil
+}
+func (m *MockTripService) ChangeTripStatus(tripID uint, status string, actorID uint) (*models.Trip, error) {
+	return nil, nil
+}
+func (m *MockTripService) AdvanceTripStatuses(now time.Time) (int, error) { return 0, nil }
+func (m *MockTripService) GetTripHistory(tripID uint) ([]models.TripAuditEntry, error) {
+	return nil, nil
+}
+func (m *MockTripService) RevertTrip(tripID, entryID, actorID uint) (*models.Trip, error) {
+	return nil, nil
+}
 
 func TestAnalyzeBudget(t *testing.T) {
 	service := new(MockTripService)
-	server := grpc.NewRecommendationServer(service)
+	server := grpc.NewRecommendationServer(service, nil, setupCategoryService(t), setupDestinationService(t), currency.DefaultTable())
 
 	req := &pb.BudgetAnalysisRequest{
 		TripId:      1,
@@ -50,7 +76,7 @@ func TestAnalyzeBudget(t *testing.T) {
 
 func TestGetRecommendations_WithTrips(t *testing.T) {
 	mockService := new(MockTripService)
-	server := grpc.NewRecommendationServer(mockService)
+	server := grpc.NewRecommendationServer(mockService, nil, setupCategoryService(t), setupDestinationService(t), currency.DefaultTable())
 
 	mockTrips := []models.Trip{
 		{
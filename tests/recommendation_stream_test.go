@@ -0,0 +1,291 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/grpc"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+	pb "travel-platform/proto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func streamTrips() []models.Trip {
+	return []models.Trip{
+		{Destination: "Paris", Budget: 1200, IsPublic: true, StartDate: day("2025-05-10")},
+		{Destination: "Rome, Italy", Budget: 900, IsPublic: true, StartDate: day("2025-04-02")},
+		{Destination: "Tokyo", Budget: 2500, IsPublic: true, StartDate: day("2025-10-01")},
+		{Destination: "Lisbon", Budget: 700, IsPublic: true, StartDate: day("2025-06-15")},
+	}
+}
+
+// startStreamServer - Sunucuyu bellek içi bağlantı üzerinde çalıştırıp istemci döner
+func startStreamServer(t *testing.T, trips []models.Trip) (*grpc.RecommendationServer, pb.RecommendationServiceClient) {
+	service := new(MockTripService)
+	service.On("GetPublicTrips").Return(trips, nil)
+	return serveRecommendations(t, service)
+}
+
+func serveRecommendations(t *testing.T, tripService services.TripService) (*grpc.RecommendationServer, pb.RecommendationServiceClient) {
+	server := grpc.NewRecommendationServer(tripService, nil, setupCategoryService(t), setupDestinationService(t), currency.DefaultTable())
+
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpclib.NewServer(grpclib.UnaryInterceptor(grpc.AuthInterceptor), grpclib.StreamInterceptor(grpc.StreamAuthInterceptor))
+	pb.RegisterRecommendationServiceServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpclib.NewClient("passthrough:///bufnet",
+		grpclib.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpclib.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return server, pb.NewRecommendationServiceClient(conn)
+}
+
+// bearer - Kullanıcı için oturum açıp token'lı context döner
+func bearer(userID uint) context.Context {
+	token := middleware.CreateSession(userID, "user@test.com")
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func TestStreamRecommendations_SendsEveryRecommendation(t *testing.T) {
+	server, client := startStreamServer(t, streamTrips())
+	req := &pb.RecommendationRequest{UserId: 1, MaxBudget: 2000}
+
+	stream, err := client.StreamRecommendations(context.Background(), req)
+	require.NoError(t, err)
+
+	var streamed []string
+	for {
+		rec, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		streamed = append(streamed, rec.Destination)
+	}
+
+	// Aynı öneriler, sadece sıralama istemciye kalır
+	resp, err := server.GetRecommendations(context.Background(), req)
+	require.NoError(t, err)
+	var expected []string
+	for _, rec := range resp.Recommendations {
+		expected = append(expected, rec.Destination)
+	}
+	assert.ElementsMatch(t, expected, streamed)
+	assert.NotContains(t, streamed, "Tokyo") // Bütçe üstü
+}
+
+func TestStreamRecommendations_RejectsInvalidRequest(t *testing.T) {
+	_, client := startStreamServer(t, streamTrips())
+
+	stream, err := client.StreamRecommendations(context.Background(), &pb.RecommendationRequest{UserId: 1, TravelMonth: 13})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Stream'lerde de token doğrulanır
+	bad := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer nope")
+	stream, err = client.StreamRecommendations(bad, &pb.RecommendationRequest{UserId: 1})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	stream, err = client.StreamRecommendations(bearer(1), &pb.RecommendationRequest{UserId: 1})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+}
+
+func TestAnalyzeExpensesStream_MatchesUnaryAnalysis(t *testing.T) {
+	server, client := startStreamServer(t, nil)
+
+	header := &pb.BudgetAnalysisRequest{
+		TripId:          1,
+		TotalBudget:     1000,
+		Currency:        "EUR",
+		CategoryBudgets: []*pb.CategoryBudget{{Category: "food", Amount: 300}},
+		StartDate:       "2025-06-01",
+		EndDate:         "2025-06-10",
+		Today:           "2025-06-05",
+	}
+	var expenses []*pb.Expense
+	for i := 0; i < 50; i++ {
+		expenses = append(expenses, &pb.Expense{Category: "food", Amount: 5, Currency: "EUR", ExpenseDate: "2025-06-02"})
+		expenses = append(expenses, &pb.Expense{Category: "accommodation", Amount: 10, Currency: "USD", ExchangeRate: 0.9, ExpenseDate: "2025-06-03"})
+	}
+
+	stream, err := client.AnalyzeExpensesStream(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(header))
+	for i := 0; i < len(expenses); i += 30 {
+		end := min(i+30, len(expenses))
+		require.NoError(t, stream.Send(&pb.BudgetAnalysisRequest{Expenses: expenses[i:end]}))
+	}
+	streamed, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+
+	unaryReq := &pb.BudgetAnalysisRequest{
+		TripId:          header.TripId,
+		TotalBudget:     header.TotalBudget,
+		Currency:        header.Currency,
+		CategoryBudgets: header.CategoryBudgets,
+		StartDate:       header.StartDate,
+		EndDate:         header.EndDate,
+		Today:           header.Today,
+		Expenses:        expenses,
+	}
+	unary, err := server.AnalyzeBudget(context.Background(), unaryReq)
+	require.NoError(t, err)
+
+	assert.Equal(t, 700.0, streamed.TotalSpent)
+	assert.Equal(t, unary.TotalSpent, streamed.TotalSpent)
+	assert.Equal(t, unary.Warnings, streamed.Warnings)
+	assert.Equal(t, unary.Forecast.ProjectedTotal, streamed.Forecast.ProjectedTotal)
+	assert.ElementsMatch(t, unary.CategoryBreakdown, streamed.CategoryBreakdown)
+}
+
+func TestAnalyzeExpensesStream_Errors(t *testing.T) {
+	_, client := startStreamServer(t, nil)
+
+	// Hiç mesaj yok
+	stream, err := client.AnalyzeExpensesStream(context.Background())
+	require.NoError(t, err)
+	_, err = stream.CloseAndRecv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Hatalı harcamanın index'i tüm parçalar boyunca sayılır
+	stream, err = client.AnalyzeExpensesStream(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&pb.BudgetAnalysisRequest{TripId: 1, TotalBudget: 500, Expenses: []*pb.Expense{{Category: "food", Amount: 10}}}))
+	stream.Send(&pb.BudgetAnalysisRequest{Expenses: []*pb.Expense{{Category: "food", Amount: 10, ExpenseDate: "June 5"}}})
+	_, err = stream.CloseAndRecv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "expenses[1]")
+}
+
+func TestRefineRecommendations_UpdatesRankings(t *testing.T) {
+	_, client := startStreamServer(t, streamTrips())
+
+	session, err := client.RefineRecommendations(context.Background())
+	require.NoError(t, err)
+
+	refine := func(msg *pb.RefineRequest) []string {
+		require.NoError(t, session.Send(msg))
+		resp, err := session.Recv()
+		require.NoError(t, err)
+		var destinations []string
+		for _, rec := range resp.Recommendations {
+			destinations = append(destinations, rec.Destination)
+		}
+		return destinations
+	}
+	budget := func(v float64) *float64 { return &v }
+	text := func(v string) *string { return &v }
+	month := func(v int32) *int32 { return &v }
+
+	all := refine(&pb.RefineRequest{UserId: 1})
+	assert.Contains(t, all, "Tokyo")
+
+	cheap := refine(&pb.RefineRequest{MaxBudget: budget(1000)})
+	assert.NotContains(t, cheap, "Tokyo")
+	assert.NotContains(t, cheap, "Paris")
+	assert.Contains(t, cheap, "Lisbon")
+
+	// Sadece destinasyon değişir, bütçe tercihi korunur
+	rome := refine(&pb.RefineRequest{PreferredDestination: text("Rome")})
+	assert.Equal(t, "Rome, Italy", rome[0])
+	assert.NotContains(t, rome, "Tokyo")
+
+	// Bütçe kaldırılınca Tokyo geri gelir
+	unlimited := refine(&pb.RefineRequest{MaxBudget: budget(0), PreferredDestination: text("Tokyo")})
+	assert.Equal(t, "Tokyo", unlimited[0])
+
+	// Kasımda iklim verisine göre Tokyo uygun, Lisbon değil
+	november := refine(&pb.RefineRequest{TravelMonth: month(11)})
+	assert.Equal(t, "Tokyo", november[0])
+	assert.NotContains(t, november, "Lisbon")
+
+	require.NoError(t, session.CloseSend())
+	_, err = session.Recv()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestRefineRecommendations_Errors(t *testing.T) {
+	_, client := startStreamServer(t, streamTrips())
+
+	session, err := client.RefineRecommendations(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, session.Send(&pb.RefineRequest{}))
+	_, err = session.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	session, err = client.RefineRecommendations(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, session.Send(&pb.RefineRequest{UserId: 1}))
+	_, err = session.Recv()
+	require.NoError(t, err)
+	require.NoError(t, session.Send(&pb.RefineRequest{UserId: 2}))
+	_, err = session.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStreamingRPCs_PrivateHistoryOnlyForOwner(t *testing.T) {
+	_, tripService := setupTrashService(t)
+	createTrashTrip(t, tripService, 1) // Gizli Rome gezisi
+	for _, destination := range []string{"Rome", "Milan"} {
+		trip := &models.Trip{UserID: 2, Title: destination, Destination: destination, IsPublic: true,
+			StartDate: day("2025-07-01"), EndDate: day("2025-07-05")}
+		require.NoError(t, tripService.CreateTrip(trip))
+	}
+	_, client := serveRecommendations(t, tripService)
+
+	streamed := func(ctx context.Context) []*pb.Recommendation {
+		stream, err := client.StreamRecommendations(ctx, &pb.RecommendationRequest{UserId: 1})
+		require.NoError(t, err)
+		var recs []*pb.Recommendation
+		for {
+			rec, err := stream.Recv()
+			if err == io.EOF {
+				return recs
+			}
+			require.NoError(t, err)
+			recs = append(recs, rec)
+		}
+	}
+	refined := func(ctx context.Context, userID uint32) []*pb.Recommendation {
+		session, err := client.RefineRecommendations(ctx)
+		require.NoError(t, err)
+		require.NoError(t, session.Send(&pb.RefineRequest{UserId: userID}))
+		resp, err := session.Recv()
+		require.NoError(t, err)
+		require.NoError(t, session.CloseSend())
+		return resp.Recommendations
+	}
+
+	// Başkaları kullanıcı 1'in gizli gezisini göremez: Rome önerilir, gerekçelerde geçmez
+	for _, recs := range [][]*pb.Recommendation{streamed(context.Background()), streamed(bearer(2)), refined(context.Background(), 1), refined(bearer(2), 1)} {
+		assert.NotNil(t, recommendationFor(recs, "Rome"))
+		assert.Empty(t, recommendationFor(recs, "Milan").Reasons)
+	}
+
+	// Kullanıcının kendisi token'ıyla ister; refine oturumunda user_id token'dan alınır
+	for _, recs := range [][]*pb.Recommendation{streamed(bearer(1)), refined(bearer(1), 0)} {
+		assert.Nil(t, recommendationFor(recs, "Rome"))
+		assert.Contains(t, recommendationFor(recs, "Milan").Reasons, "In Italy, like your trip to Rome")
+	}
+}
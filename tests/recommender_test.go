@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/grpc"
+	"travel-platform/internal/models"
+	"travel-platform/internal/recommender"
+	pb "travel-platform/proto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// visit - Değerlendirme için tek destinasyonlu gezi etkileşimi
+func visit(userID, tripID uint, city, date string) recommender.Interaction {
+	return recommender.Interaction{UserID: userID, TripID: tripID, Item: recommender.DestinationItem(city), Label: city, Date: day(date)}
+}
+
+func TestCollaborativeModel(t *testing.T) {
+	model := recommender.Build([]recommender.Interaction{
+		visit(1, 1, "Rome", "2024-01-01"), visit(1, 2, "Florence", "2024-02-01"),
+		visit(2, 3, "Rome", "2024-01-01"), visit(2, 4, "Florence", "2024-02-01"), visit(2, 5, "Rome", "2024-03-01"),
+		visit(3, 6, "Rome", "2024-01-01"), visit(3, 7, "Tokyo", "2024-02-01"),
+		{UserID: 3, TripID: 7, Item: recommender.ActivityItem("sushi"), Label: "sushi"},
+	})
+
+	rome, florence, tokyo := recommender.DestinationItem("Rome"), recommender.DestinationItem("Florence"), recommender.DestinationItem("Tokyo")
+	assert.Equal(t, 3, model.Users())
+	assert.Equal(t, 3, model.Destinations())
+	assert.InDelta(t, 2/math.Sqrt(6), model.Similarity(florence, rome), 1e-9) // Tekrar eden Roma gezisi bir kez sayılır
+	assert.InDelta(t, 1/math.Sqrt(3), model.Similarity(tokyo, rome), 1e-9)
+	assert.Equal(t, 1.0, model.Similarity(tokyo, recommender.ActivityItem("sushi")))
+	assert.Zero(t, model.Similarity(florence, tokyo))
+
+	assert.Equal(t, []string{florence, tokyo}, model.Recommend([]string{rome}, 3))
+	assert.Equal(t, []string{tokyo, rome}, model.Recommend([]string{recommender.ActivityItem("sushi")}, 3))
+
+	match := model.Score([]string{rome, recommender.ActivityItem("sushi")}, tokyo)
+	assert.InDelta(t, (1/math.Sqrt(3)+1)/2, match.Score, 1e-9)
+	assert.Equal(t, "sushi", match.Because)
+	assert.Empty(t, model.Recommend(nil, 3))
+}
+
+func TestCollaborativeEvaluation(t *testing.T) {
+	interactions := []recommender.Interaction{
+		// Roma'ya gidenler sonra Floransa'ya gidiyor
+		visit(1, 1, "Rome", "2024-01-01"), visit(1, 2, "Florence", "2024-02-01"),
+		visit(2, 3, "Rome", "2024-01-01"), visit(2, 4, "Florence", "2024-02-01"),
+		// Kullanıcı 3'ün son gezisi tahmin edilemez
+		visit(3, 5, "Rome", "2024-01-01"), visit(3, 6, "Tokyo", "2024-02-01"),
+		// Tek gezisi olan kullanıcı değerlendirilmez
+		visit(4, 7, "Rome", "2024-01-01"),
+		// Tekrar ziyaret değerlendirilmez
+		visit(5, 8, "Paris", "2024-01-01"), visit(5, 9, "Paris", "2024-02-01"),
+	}
+
+	evaluation := recommender.Evaluate(interactions, 1)
+	assert.Equal(t, 3, evaluation.Users)
+	assert.Equal(t, 2, evaluation.Hits)
+	assert.InDelta(t, 2.0/3, evaluation.PrecisionAtK, 1e-9)
+	assert.InDelta(t, 2.0/3, evaluation.HitRate, 1e-9)
+
+	evaluation = recommender.Evaluate(interactions, 2)
+	assert.InDelta(t, 2.0/6, evaluation.PrecisionAtK, 1e-9)
+	assert.Zero(t, recommender.Evaluate(nil, 3).Users)
+}
+
+func TestGetRecommendations_BlendsCollaborativeScore(t *testing.T) {
+	mockService := new(MockTripService)
+	server := grpc.NewRecommendationServer(mockService, nil, setupCategoryService(t), setupDestinationService(t), currency.DefaultTable())
+	publicTrip := func(id, userID uint, destination, start string) models.Trip {
+		trip := models.Trip{UserID: userID, Destination: destination, Budget: 1000, IsPublic: true, StartDate: day(start)}
+		trip.ID = id
+		return trip
+	}
+	mockService.On("GetPublicTrips").Return([]models.Trip{
+		publicTrip(1, 2, "Rome", "2024-01-01"), publicTrip(2, 2, "Vienna", "2024-02-01"),
+		publicTrip(3, 3, "Roma", "2024-01-01"), publicTrip(4, 3, "Vienna", "2024-03-01"),
+		publicTrip(5, 4, "Rome, Italy", "2024-01-01"), publicTrip(6, 4, "Kyoto", "2024-02-01"),
+		publicTrip(7, 5, "Oslo", "2024-01-01"),
+	}, nil)
+	req := &pb.RecommendationRequest{
+		UserId:  1,
+		History: &pb.UserTripHistory{PastTrips: []*pb.TripInfo{{Destination: "Rome", StartDate: "2023-06-01"}}},
+	}
+
+	// Model yenilenmeden önce sadece içerik kuralları
+	resp, err := server.GetRecommendations(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, 50.0, recommendationFor(resp.Recommendations, "Vienna").MatchScore)
+	assert.Zero(t, server.Evaluation().Users)
+
+	assert.NoError(t, server.RefreshModel())
+	evaluation := server.Evaluation()
+	assert.Equal(t, grpc.EvaluationK, evaluation.K)
+	assert.Equal(t, 3, evaluation.Users)
+	assert.Equal(t, 2, evaluation.Hits) // Vienna iki kez doğru tahmin, Kyoto tahmin edilemez
+
+	resp, err = server.GetRecommendations(context.Background(), req)
+	assert.NoError(t, err)
+	vienna := recommendationFor(resp.Recommendations, "Vienna")
+	kyoto := recommendationFor(resp.Recommendations, "Kyoto")
+	oslo := recommendationFor(resp.Recommendations, "Oslo")
+	assert.Equal(t, "Vienna", resp.Recommendations[0].Destination)
+	assert.InDelta(t, 50*0.7+100*0.3*(2/math.Sqrt(6)), vienna.MatchScore, 1e-9)
+	assert.Contains(t, vienna.Reasons, "Travelers who went to Rome also went here")
+	assert.Greater(t, vienna.MatchScore, kyoto.MatchScore)
+	assert.Equal(t, 35.0, oslo.MatchScore) // Ortak gezgini olmayan yer geriye düşer
+	assert.Nil(t, recommendationFor(resp.Recommendations, "Roma"))
+}
@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"travel-platform/internal/models"
+	"travel-platform/internal/report"
+	"travel-platform/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// setupReportTrip - Roma gezisine iki gün ve iki kategoriye yayılan harcamalar ekler
+func setupReportTrip(t *testing.T) (services.TripService, *models.Trip) {
+	_, tripService := setupTrashService(t)
+	trip := createTrashTrip(t, tripService, 1)
+	for _, expense := range []models.Expense{
+		{TripID: trip.ID, Category: "transport", Amount: 30, Currency: "EUR", ExpenseDate: day("2025-06-02"), Description: "Taxi (airport)"},
+		{TripID: trip.ID, Category: "food", Amount: 10, Currency: "EUR", ExpenseDate: day("2025-06-02")},
+	} {
+		assert.NoError(t, tripService.AddExpense(&expense, 1))
+	}
+	trip, _ = tripService.GetTripByID(trip.ID)
+	return tripService, trip
+}
+
+func TestReportService_TripReport(t *testing.T) {
+	tripService, trip := setupReportTrip(t)
+	service := services.NewReportService(tripService, setupCurrencyService(t, &stubRates{rate: 1.1}))
+
+	expenseReport, err := service.TripReport(trip, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, "EUR", expenseReport.Currency)
+	assert.Len(t, expenseReport.Lines, 3)
+	assert.Equal(t, 60.0, expenseReport.Total)
+	assert.Equal(t, []report.Total{{Key: "food", Count: 2, Amount: 30}, {Key: "transport", Count: 1, Amount: 30}}, expenseReport.ByCategory)
+	assert.Equal(t, []report.Total{{Key: "2025-06-01", Count: 1, Amount: 20}, {Key: "2025-06-02", Count: 2, Amount: 40}}, expenseReport.ByDay)
+	assert.Equal(t, "All dates", expenseReport.Period())
+
+	ranged, err := service.TripReport(trip, day("2025-06-02"), day("2025-06-02"))
+	assert.NoError(t, err)
+	assert.Equal(t, 40.0, ranged.Total)
+	assert.Equal(t, "2025-06-02 to 2025-06-02", ranged.Period())
+
+	_, err = service.TripReport(trip, day("2025-06-03"), day("2025-06-01"))
+	assert.Error(t, err)
+}
+
+func TestReportService_UserReportConvertsCurrency(t *testing.T) {
+	tripService, _ := setupReportTrip(t)
+	createTrashTrip(t, tripService, 2) // başka kullanıcının gezisi rapora girmez
+	service := services.NewReportService(tripService, setupCurrencyService(t, &stubRates{rate: 1.1}))
+
+	expenseReport, err := service.UserReport(1, day("2025-06-01"), time.Time{}, "usd")
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", expenseReport.Currency)
+	assert.Len(t, expenseReport.Lines, 3)
+	assert.InDelta(t, 66.0, expenseReport.Total, 0.001)
+	assert.Equal(t, "EUR", expenseReport.Lines[0].Currency)
+	assert.Equal(t, 20.0, expenseReport.Lines[0].Amount)
+
+	_, err = service.UserReport(1, time.Time{}, time.Time{}, "dollars")
+	assert.Error(t, err)
+}
+
+func TestReport_Writers(t *testing.T) {
+	tripService, trip := setupReportTrip(t)
+	service := services.NewReportService(tripService, setupCurrencyService(t, &stubRates{rate: 1}))
+	expenseReport, err := service.TripReport(trip, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+
+	t.Run("CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, report.WriteCSV(&buf, expenseReport))
+
+		reader := csv.NewReader(&buf)
+		reader.FieldsPerRecord = -1
+		rows, err := reader.ReadAll()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Date", "Trip", "Category", "Description", "Amount", "Currency", "Amount (EUR)"}, rows[2]) // boş satırlar okunurken atlanır
+		assert.Equal(t, []string{"2025-06-02", "Rome", "transport", "Taxi (airport)", "30.00", "EUR", "30.00"}, rows[4])
+		assert.Equal(t, []string{"Total", "3", "60.00"}, rows[len(rows)-1])
+	})
+
+	t.Run("CSV escapes formulas", func(t *testing.T) {
+		injected := *expenseReport
+		injected.Title = "=HYPERLINK(\"http://evil\")"
+		injected.Lines = []report.Line{{Date: day("2025-06-02"), Trip: "+Rome", Category: "@food", Description: "-1+2", Amount: 5, Currency: "EUR", HomeAmount: 5}}
+		injected.ByCategory = []report.Total{{Key: "\tfood", Count: 1, Amount: 5}}
+
+		var buf bytes.Buffer
+		assert.NoError(t, report.WriteCSV(&buf, &injected))
+
+		reader := csv.NewReader(&buf)
+		reader.FieldsPerRecord = -1
+		rows, err := reader.ReadAll()
+		assert.NoError(t, err)
+		assert.Equal(t, "'=HYPERLINK(\"http://evil\")", rows[0][0])
+		assert.Equal(t, []string{"2025-06-02", "'+Rome", "'@food", "'-1+2", "5.00", "EUR", "5.00"}, rows[3])
+		assert.Equal(t, "'\tfood", rows[5][0])
+	})
+
+	t.Run("XLSX", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, report.WriteXLSX(&buf, expenseReport))
+
+		zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		assert.NoError(t, err)
+		parts := make(map[string]string)
+		for _, f := range zr.File {
+			rc, err := f.Open()
+			assert.NoError(t, err)
+			content, _ := io.ReadAll(rc)
+			rc.Close()
+			parts[f.Name] = string(content)
+			assertWellFormedXML(t, f.Name, content)
+		}
+		for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/styles.xml", "xl/worksheets/sheet4.xml"} {
+			assert.Contains(t, parts, name)
+		}
+		assert.Contains(t, parts["xl/workbook.xml"], `name="By category"`)
+
+		expenses := parts["xl/worksheets/sheet2.xml"]
+		assert.Contains(t, expenses, "Taxi (airport)")
+		// 2025-06-01 Excel seri tarihi olarak yazılır
+		assert.Contains(t, expenses, `<c r="A2" s="1"><v>45809</v></c>`)
+		assert.Contains(t, expenses, `<c r="G5" s="2"><v>60</v></c>`)
+	})
+
+	t.Run("PDF", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, report.WritePDF(&buf, expenseReport))
+		assertValidPDF(t, buf.Bytes(), 1)
+		assert.Contains(t, buf.String(), `(Taxi \(airport\)) Tj`)
+		assert.Contains(t, buf.String(), "(60.00) Tj")
+
+		// Uzun raporlar sayfalara bölünür
+		lines := make([]report.Line, 120)
+		for i := range lines {
+			lines[i] = report.Line{Date: day("2025-06-01").AddDate(0, 0, i%5), Trip: "Rome", Category: "food", Amount: 1, Currency: "EUR", HomeAmount: 1}
+		}
+		buf.Reset()
+		assert.NoError(t, report.WritePDF(&buf, report.New("Long", "EUR", time.Time{}, time.Time{}, lines)))
+		assertValidPDF(t, buf.Bytes(), 3)
+	})
+}
+
+func assertWellFormedXML(t *testing.T, name string, content []byte) {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	for {
+		_, err := decoder.Token()
+		if err == io.EOF {
+			return
+		}
+		if !assert.NoError(t, err, name) {
+			return
+		}
+	}
+}
+
+// assertValidPDF - Başlık, sayfa sayısı ve xref tablosunun gösterdiği nesne konumlarını doğrular
+func assertValidPDF(t *testing.T, data []byte, pages int) {
+	text := string(data)
+	assert.True(t, strings.HasPrefix(text, "%PDF-1.4"))
+	assert.True(t, strings.HasSuffix(text, "%%EOF\n"))
+	assert.Contains(t, text, fmt.Sprintf("/Count %d >>", pages))
+
+	startxref := strings.LastIndex(text, "startxref\n")
+	offset, err := strconv.Atoi(strings.Fields(text[startxref+len("startxref\n"):])[0])
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(text[offset:], "xref\n"))
+
+	entries := strings.Split(text[offset:], "\n")[3:]
+	for i := 1; i <= 4+2*pages; i++ {
+		objectOffset, err := strconv.Atoi(entries[i-1][:10])
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(text[objectOffset:], fmt.Sprintf("%d 0 obj", i)), "object %d", i)
+	}
+}
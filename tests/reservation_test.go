@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"bytes"
+	"testing"
+	"time"
+	"travel-platform/internal/importer"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func setupReservationService(t *testing.T) (*gorm.DB, services.TripService, services.ReservationService) {
+	db, tripService := setupTrashService(t)
+	return db, tripService, services.NewReservationService(repository.NewReservationRepository(db), tripService)
+}
+
+func testFlight(tripStart time.Time) *models.Reservation {
+	return &models.Reservation{
+		Type:               models.ReservationFlight,
+		Provider:           "Turkish Airlines",
+		FlightNumber:       "TK1861",
+		DepartureAirport:   "ist",
+		ArrivalAirport:     "fco",
+		ConfirmationNumber: "ABC123",
+		StartAt:            tripStart.Add(8 * time.Hour),
+		Cost:               180,
+	}
+}
+
+func TestReservations_LinkedExpense(t *testing.T) {
+	_, tripService, service := setupReservationService(t)
+	trip := createTrashTrip(t, tripService, 1)
+
+	flight := testFlight(trip.StartDate)
+	assert.NoError(t, service.CreateReservation(trip, flight, 1))
+	assert.Equal(t, "IST", flight.DepartureAirport)
+	assert.Equal(t, "EUR", flight.Currency)
+	assert.NotNil(t, flight.ExpenseID)
+
+	trip, _ = tripService.GetTripByID(trip.ID)
+	assert.Len(t, trip.Expenses, 2)
+	expense := trip.Expenses[1]
+	assert.Equal(t, *flight.ExpenseID, expense.ID)
+	assert.Equal(t, "transport", expense.Category)
+	assert.Equal(t, 180.0, expense.Amount)
+
+	// Ücret değişince bağlı harcama güncellenir
+	flight.Cost = 210
+	assert.NoError(t, service.UpdateReservation(trip, flight, 1))
+	trip, _ = tripService.GetTripByID(trip.ID)
+	assert.Equal(t, 210.0, trip.Expenses[1].Amount)
+
+	// Ücret kaldırılınca harcama silinir
+	flight.Cost = 0
+	assert.NoError(t, service.UpdateReservation(trip, flight, 1))
+	assert.Nil(t, flight.ExpenseID)
+	trip, _ = tripService.GetTripByID(trip.ID)
+	assert.Len(t, trip.Expenses, 1)
+
+	hotel := &models.Reservation{Type: models.ReservationLodging, Provider: "Hotel Roma", Cost: 300,
+		StartAt: trip.StartDate.Add(15 * time.Hour), EndAt: timePtr(trip.EndDate.Add(11 * time.Hour))}
+	assert.NoError(t, service.CreateReservation(trip, hotel, 1))
+	trip, _ = tripService.GetTripByID(trip.ID)
+	assert.Equal(t, "accommodation", trip.Expenses[1].Category)
+
+	assert.NoError(t, service.DeleteReservation(trip, hotel, 1))
+	trip, _ = tripService.GetTripByID(trip.ID)
+	assert.Len(t, trip.Expenses, 1)
+	reservations, _ := service.GetReservations(trip.ID)
+	assert.Len(t, reservations, 1)
+}
+
+func TestReservations_Validation(t *testing.T) {
+	_, tripService, service := setupReservationService(t)
+	trip := createTrashTrip(t, tripService, 1)
+	start := trip.StartDate.Add(10 * time.Hour)
+
+	invalid := []*models.Reservation{
+		{Type: "boat", StartAt: start},
+		{Type: models.ReservationFlight, FlightNumber: "TK1", StartAt: start},
+		{Type: models.ReservationLodging, Provider: "Hotel", StartAt: start},
+		{Type: models.ReservationRail, Origin: "Roma Termini", StartAt: start},
+		{Type: models.ReservationCar, Provider: "Hertz", StartAt: start, EndAt: timePtr(start.Add(-time.Hour))},
+		{Type: models.ReservationRail, Origin: "Roma", Destination: "Firenze"},
+		{Type: models.ReservationRail, Origin: "Roma", Destination: "Firenze", StartAt: start, Cost: -5},
+	}
+	for _, reservation := range invalid {
+		assert.Error(t, service.CreateReservation(trip, reservation, 1), reservation.Type)
+	}
+}
+
+func TestBuildItinerary(t *testing.T) {
+	day := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	trip := &models.Trip{ID: 1, Title: "Rome", Destination: "Rome", StartDate: day, EndDate: day.AddDate(0, 0, 2),
+		Activities: []models.Activity{
+			{ID: 1, Name: "Colosseum", Date: day.Add(14 * time.Hour)},
+			{ID: 2, Name: "Vatican", Date: day.AddDate(0, 0, 1)},
+		}}
+	reservations := []models.Reservation{
+		*testFlight(day),
+		{ID: 2, Type: models.ReservationLodging, Provider: "Hotel Roma", Address: "Via Roma 1",
+			StartAt: day.Add(15 * time.Hour), EndAt: timePtr(day.AddDate(0, 0, 2).Add(11 * time.Hour))},
+	}
+	reservations[0].ID = 1
+	reservations[0].DepartureAirport, reservations[0].ArrivalAirport = "IST", "FCO"
+
+	days := services.BuildItinerary(trip, reservations)
+	assert.Len(t, days, 3)
+
+	assert.Equal(t, day, days[0].Date)
+	var kinds []string
+	for _, entry := range days[0].Entries {
+		kinds = append(kinds, entry.Kind)
+	}
+	assert.Equal(t, []string{"flight", "activity", "check_in"}, kinds)
+	assert.Equal(t, "Flight TK1861 IST → FCO", days[0].Entries[0].Title)
+	assert.Equal(t, "ABC123", days[0].Entries[0].Confirmation)
+
+	assert.True(t, days[1].Entries[0].AllDay)
+	assert.Equal(t, "Vatican", days[1].Entries[0].Title)
+	assert.Equal(t, "check_out", days[2].Entries[0].Kind)
+}
+
+func TestCalendarExport_RoundTrip(t *testing.T) {
+	day := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	trip := &models.Trip{ID: 7, Title: "Rome; spring, 2025", Destination: "Rome", StartDate: day, EndDate: day.AddDate(0, 0, 2),
+		Activities: []models.Activity{{ID: 1, Name: "Vatican", Date: day.AddDate(0, 0, 1)}}}
+	flight := testFlight(day)
+	flight.ID = 3
+	flight.EndAt = timePtr(day.Add(10 * time.Hour))
+	flight.Notes = "Seat 12A, window seat with a long note that needs to be folded across several lines in the file"
+
+	var buf bytes.Buffer
+	assert.NoError(t, importer.WriteICS(&buf, trip.Title, services.CalendarEvents(trip, []models.Reservation{*flight})))
+
+	events, itemErrors, err := importer.ParseICS(&buf)
+	assert.NoError(t, err)
+	assert.Empty(t, itemErrors)
+	assert.Len(t, events, 3)
+
+	assert.Equal(t, "Rome; spring, 2025", events[0].Summary)
+	assert.True(t, events[0].AllDay)
+	assert.Equal(t, trip.EndDate, events[0].End)
+
+	assert.True(t, events[1].AllDay)
+	assert.Equal(t, "reservation-3@travelmate", events[2].UID)
+	assert.Equal(t, day.Add(8*time.Hour), events[2].Start)
+	assert.Equal(t, day.Add(10*time.Hour), events[2].End)
+	assert.Contains(t, events[2].Description, "Confirmation: ABC123")
+	assert.Contains(t, events[2].Description, flight.Notes)
+}
+
+func TestReservations_RemovedWithPurgedTrip(t *testing.T) {
+	db, tripService, service := setupReservationService(t)
+	trip := createTrashTrip(t, tripService, 1)
+	assert.NoError(t, service.CreateReservation(trip, testFlight(trip.StartDate), 1))
+
+	assert.NoError(t, tripService.DeleteTrip(trip.ID, 1))
+	assert.NoError(t, tripService.PurgeTrip(trip.ID))
+
+	var count int64
+	db.Model(&models.Reservation{}).Count(&count)
+	assert.Zero(t, count)
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/grpc"
+	"travel-platform/internal/models"
+	"travel-platform/internal/recommender"
+	pb "travel-platform/proto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInferSeason(t *testing.T) {
+	t.Run("Most popular seasons from trip start months", func(t *testing.T) {
+		info, ok := recommender.InferSeason([]recommender.SeasonSample{
+			{Month: time.October}, {Month: time.October}, {Month: time.October}, {Month: time.November},
+			{Month: time.April}, {Month: time.April}, {Month: time.May},
+			{Month: time.July},
+		})
+		assert.True(t, ok)
+		assert.Equal(t, "Fall/Spring", info.Season)
+		assert.Equal(t, recommender.SeasonFromTrips, info.Source)
+		assert.Equal(t, 8, info.Trips)
+		assert.True(t, info.Fits(time.March))
+		assert.False(t, info.Fits(time.July))
+	})
+
+	t.Run("Cheaper season wins a tie", func(t *testing.T) {
+		info, ok := recommender.InferSeason([]recommender.SeasonSample{
+			{Month: time.July, Budget: 2000}, {Month: time.August, Budget: 1800},
+			{Month: time.January, Budget: 800}, {Month: time.February},
+		})
+		assert.True(t, ok)
+		assert.Equal(t, "Winter/Summer", info.Season)
+	})
+
+	t.Run("Too few trips fall back to the climate data", func(t *testing.T) {
+		_, ok := recommender.InferSeason([]recommender.SeasonSample{{Month: time.July}, {Month: time.July}})
+		assert.False(t, ok)
+
+		info := recommender.BestSeason("Rome", []recommender.SeasonSample{{Month: time.July}})
+		assert.Equal(t, "Spring/Fall", info.Season)
+		assert.Equal(t, recommender.SeasonFromClimate, info.Source)
+		assert.False(t, info.Fits(time.July))
+
+		unknown := recommender.BestSeason("Atlantis", nil)
+		assert.Equal(t, "All Year", unknown.Season)
+		assert.True(t, unknown.Fits(time.July))
+	})
+
+	t.Run("Climate months are labelled by season", func(t *testing.T) {
+		months, ok := recommender.ClimateMonths("barcelona")
+		assert.True(t, ok)
+		assert.Equal(t, "Summer", recommender.SeasonLabel(months))
+		months, _ = recommender.ClimateMonths("Bangkok")
+		assert.Equal(t, "Winter", recommender.SeasonLabel(months))
+		assert.Equal(t, "All Year", recommender.SeasonLabel(nil))
+	})
+}
+
+func TestGetRecommendations_TravelMonth(t *testing.T) {
+	mockService := new(MockTripService)
+	server := grpc.NewRecommendationServer(mockService, nil, setupCategoryService(t), setupDestinationService(t), currency.DefaultTable())
+
+	trips := []models.Trip{
+		{Destination: "Rome", IsPublic: true, StartDate: day("2024-05-01")},
+		{Destination: "Reykjavik", IsPublic: true, StartDate: day("2024-03-01")},
+	}
+	for _, start := range []string{"2023-07-01", "2024-07-10", "2024-08-03"} {
+		trips = append(trips, models.Trip{Destination: "Lisbon", IsPublic: true, StartDate: day(start)})
+	}
+	mockService.On("GetPublicTrips").Return(trips, nil)
+
+	_, err := server.GetRecommendations(context.Background(), &pb.RecommendationRequest{UserId: 1, TravelMonth: 13})
+	assert.Error(t, err)
+
+	resp, err := server.GetRecommendations(context.Background(), &pb.RecommendationRequest{UserId: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, "Spring/Fall", recommendationFor(resp.Recommendations, "Rome").BestSeason)
+	assert.Equal(t, "Summer", recommendationFor(resp.Recommendations, "Lisbon").BestSeason) // İklim verisi Spring/Fall der
+
+	resp, err = server.GetRecommendations(context.Background(), &pb.RecommendationRequest{UserId: 1, TravelMonth: 7})
+	assert.NoError(t, err)
+	assert.Nil(t, recommendationFor(resp.Recommendations, "Rome"))
+	assert.Nil(t, recommendationFor(resp.Recommendations, "Paris, France"))
+	assert.Equal(t, []string{"Good time to visit in July"}, recommendationFor(resp.Recommendations, "Reykjavik").Reasons)
+	assert.Equal(t, []string{"Good time to visit in July (based on 3 trips)"}, recommendationFor(resp.Recommendations, "Lisbon").Reasons)
+	assert.NotNil(t, recommendationFor(resp.Recommendations, "Barcelona, Spain"))
+}
@@ -0,0 +1,192 @@
+package tests
+
+import (
+	"testing"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// setupSplitTrip - Sahip ve iki üyeli, 20 EUR'luk paylaşılmamış harcaması olan gezi
+func setupSplitTrip(t *testing.T) (*gorm.DB, services.TripService, services.SplitService, *models.Trip, []*models.User) {
+	db, tripService, memberService, _ := setupChecklistService(t)
+	splitService := services.NewSplitService(repository.NewSettlementRepository(db), tripService, memberService,
+		setupCurrencyService(t, currency.DefaultTable()))
+
+	owner := createChecklistUser(t, db, "owner@test.com")
+	ana := createChecklistUser(t, db, "ana@test.com")
+	ben := createChecklistUser(t, db, "ben@test.com")
+	trip := createTrashTrip(t, tripService, owner.ID)
+	_, err := memberService.AddMember(trip, ana.Email)
+	assert.NoError(t, err)
+	_, err = memberService.AddMember(trip, ben.Email)
+	assert.NoError(t, err)
+
+	trip, _ = tripService.GetTripByID(trip.ID)
+	return db, tripService, splitService, trip, []*models.User{owner, ana, ben}
+}
+
+func TestComputeSplit(t *testing.T) {
+	people := func(values ...float64) []models.ExpenseSplit {
+		var splits []models.ExpenseSplit
+		for i, value := range values {
+			splits = append(splits, models.ExpenseSplit{UserID: uint(i + 1), Value: value})
+		}
+		return splits
+	}
+	amounts := func(splits []models.ExpenseSplit) []float64 {
+		var result []float64
+		for _, split := range splits {
+			result = append(result, split.Amount)
+		}
+		return result
+	}
+
+	// Kuruş farkı ilk kişiye yazılır
+	splits, err := services.ComputeSplit(100, models.SplitEqual, people(0, 0, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{33.34, 33.33, 33.33}, amounts(splits))
+
+	splits, err = services.ComputeSplit(90, models.SplitShares, people(2, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{60, 30}, amounts(splits))
+
+	splits, err = services.ComputeSplit(50, models.SplitExact, people(20, 30))
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{20, 30}, amounts(splits))
+
+	splits, err = services.ComputeSplit(10, models.SplitPercentage, people(70, 30))
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{7, 3}, amounts(splits))
+
+	t.Run("Invalid splits", func(t *testing.T) {
+		_, err := services.ComputeSplit(50, models.SplitExact, people(20, 20))
+		assert.Error(t, err)
+		_, err = services.ComputeSplit(10, models.SplitPercentage, people(60, 30))
+		assert.Error(t, err)
+		_, err = services.ComputeSplit(10, models.SplitShares, people(1, 0))
+		assert.Error(t, err)
+		_, err = services.ComputeSplit(10, models.SplitEqual, nil)
+		assert.Error(t, err)
+		_, err = services.ComputeSplit(10, "half", people(1))
+		assert.Error(t, err)
+		_, err = services.ComputeSplit(10, models.SplitEqual, []models.ExpenseSplit{{UserID: 1}, {UserID: 1}})
+		assert.Error(t, err)
+	})
+}
+
+func TestSettleUp_MinimalTransfers(t *testing.T) {
+	transfers := services.SettleUp([]services.Balance{
+		{UserID: 1, Name: "A", Net: 60},
+		{UserID: 2, Name: "B", Net: -10},
+		{UserID: 3, Name: "C", Net: -50},
+		{UserID: 4, Name: "D", Net: 0},
+	})
+	assert.Equal(t, []services.Transfer{
+		{FromUserID: 3, FromName: "C", ToUserID: 1, ToName: "A", Amount: 50},
+		{FromUserID: 2, FromName: "B", ToUserID: 1, ToName: "A", Amount: 10},
+	}, transfers)
+
+	assert.Empty(t, services.SettleUp([]services.Balance{{UserID: 1, Net: 0.001}}))
+}
+
+func TestSplits_BalancesAndSettlements(t *testing.T) {
+	_, tripService, splitService, trip, users := setupSplitTrip(t)
+	owner, ana, ben := users[0], users[1], users[2]
+
+	// 90 EUR sahip ödedi, üç kişiye eşit bölündü (kişi verilmezse tüm katılımcılar)
+	dinner := &models.Expense{TripID: trip.ID, Category: "food", Amount: 90, ExpenseDate: trip.StartDate}
+	assert.NoError(t, tripService.AddExpense(dinner, owner.ID))
+	assert.NoError(t, splitService.SetSplit(trip, dinner, &owner.ID, models.SplitEqual, nil, owner.ID))
+	assert.Len(t, dinner.Splits, 3)
+
+	// 30 EUR Ana ödedi, tamamı Ben'in
+	taxi := &models.Expense{TripID: trip.ID, Category: "transport", Amount: 30, ExpenseDate: trip.StartDate}
+	assert.NoError(t, tripService.AddExpense(taxi, ana.ID))
+	assert.NoError(t, splitService.SetSplit(trip, taxi, &ana.ID, models.SplitExact,
+		[]models.ExpenseSplit{{UserID: ana.ID, Value: 0}, {UserID: ben.ID, Value: 30}}, owner.ID))
+
+	trip, _ = tripService.GetTripByID(trip.ID)
+	balances, err := splitService.GetBalances(trip)
+	assert.NoError(t, err)
+	assert.Equal(t, "EUR", balances.Currency)
+	assert.Equal(t, 20.0, balances.UnsplitTotal)
+	net := map[uint]float64{}
+	for _, balance := range balances.Balances {
+		net[balance.UserID] = balance.Net
+	}
+	assert.Equal(t, map[uint]float64{owner.ID: 60, ana.ID: 0, ben.ID: -60}, net)
+	assert.Equal(t, []services.Transfer{
+		{FromUserID: ben.ID, FromName: "Test User", ToUserID: owner.ID, ToName: "Test User", Amount: 60},
+	}, balances.Transfers)
+
+	// Dövizle ödeme, ödeme tarihindeki kurla gezi para birimine çevrilir (20.642 USD = 20 EUR)
+	assert.NoError(t, splitService.RecordSettlement(trip, &models.Settlement{
+		FromUserID: ben.ID, ToUserID: owner.ID, Amount: 20.642, Currency: "USD", PaidAt: day("2025-01-02"),
+	}, ben.ID))
+	balances, _ = splitService.GetBalances(trip)
+	assert.Equal(t, 40.0, balances.Transfers[0].Amount)
+
+	rest := &models.Settlement{FromUserID: ben.ID, ToUserID: owner.ID, Amount: 40}
+	assert.NoError(t, splitService.RecordSettlement(trip, rest, ben.ID))
+	assert.Equal(t, "EUR", rest.Currency)
+	balances, _ = splitService.GetBalances(trip)
+	assert.Empty(t, balances.Transfers)
+
+	// Ödeme silinince borç geri gelir
+	assert.NoError(t, splitService.DeleteSettlement(rest))
+	balances, _ = splitService.GetBalances(trip)
+	assert.Equal(t, 40.0, balances.Transfers[0].Amount)
+
+	t.Run("Invalid settlements", func(t *testing.T) {
+		assert.Error(t, splitService.RecordSettlement(trip, &models.Settlement{FromUserID: ben.ID, ToUserID: ben.ID, Amount: 5}, ben.ID))
+		assert.Error(t, splitService.RecordSettlement(trip, &models.Settlement{FromUserID: ben.ID, ToUserID: owner.ID}, ben.ID))
+		assert.Error(t, splitService.RecordSettlement(trip, &models.Settlement{FromUserID: 999, ToUserID: owner.ID, Amount: 5}, owner.ID))
+		assert.Error(t, splitService.RecordSettlement(trip, &models.Settlement{FromUserID: ben.ID, ToUserID: owner.ID, Amount: 5, Currency: "XYZ"}, ben.ID))
+	})
+}
+
+func TestSplits_UpdatesAndHistory(t *testing.T) {
+	_, tripService, splitService, trip, users := setupSplitTrip(t)
+	owner, ana := users[0], users[1]
+	expense := &trip.Expenses[0]
+
+	// Katılımcı olmayan ödeyen veya kişi reddedilir
+	stranger := uint(999)
+	assert.Error(t, splitService.SetSplit(trip, expense, &stranger, models.SplitEqual, nil, owner.ID))
+	assert.Error(t, splitService.SetSplit(trip, expense, &owner.ID, models.SplitEqual,
+		[]models.ExpenseSplit{{UserID: stranger}}, owner.ID))
+	// Ödeyensiz paylaşım olmaz
+	assert.Error(t, splitService.SetSplit(trip, expense, nil, models.SplitEqual, nil, owner.ID))
+
+	assert.NoError(t, splitService.SetSplit(trip, expense, &owner.ID, models.SplitShares,
+		[]models.ExpenseSplit{{UserID: owner.ID, Value: 1}, {UserID: ana.ID, Value: 3}}, owner.ID))
+
+	// Tutar değişince kayıtlı paylar yeniden hesaplanır
+	saved, _ := tripService.GetTripByID(trip.ID)
+	changed := saved.Expenses[0]
+	changed.Splits = nil
+	changed.Amount = 40
+	assert.NoError(t, tripService.UpdateExpense(&changed, owner.ID))
+	saved, _ = tripService.GetTripByID(trip.ID)
+	assert.Equal(t, 10.0, saved.Expenses[0].Splits[0].Amount)
+	assert.Equal(t, 30.0, saved.Expenses[0].Splits[1].Amount)
+
+	history, _ := tripService.GetTripHistory(trip.ID)
+	assert.Contains(t, history[0].Changes, models.FieldChange{Field: "amount", Old: 20.0, New: 40.0})
+
+	// Paylaşım kaldırılır, sonra geçmişten geri getirilir
+	assert.NoError(t, splitService.SetSplit(trip, &saved.Expenses[0], &owner.ID, "", nil, owner.ID))
+	saved, _ = tripService.GetTripByID(trip.ID)
+	assert.Empty(t, saved.Expenses[0].Splits)
+
+	history, _ = tripService.GetTripHistory(trip.ID)
+	reverted, err := tripService.RevertTrip(trip.ID, history[1].ID, owner.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, models.SplitShares, reverted.Expenses[0].SplitType)
+	assert.Len(t, reverted.Expenses[0].Splits, 2)
+}
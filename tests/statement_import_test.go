@@ -0,0 +1,179 @@
+package tests
+
+import (
+	"testing"
+	"time"
+	"travel-platform/internal/importer"
+	"travel-platform/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const sampleStatementCSV = "Buchungstag;Empfänger;Betrag;Währung\n" +
+	"01.06.2025;Trattoria Da Enzo;-20,00;EUR\n" + // elle girilmiş harcamayla aynı
+	"02.06.2025;Hotel Artemide Roma;-1.240,50;EUR\n" +
+	"02.06.2025;Salary;2.500,00;EUR\n" + // gelen para, alınmaz
+	"03.06.2025;Musei Vaticani tickets;-34,00;EUR\n" +
+	"03.06.2025;Musei Vaticani tickets;-34,00;EUR\n" + // dosyada tekrar
+	"12.06.2025;Uber Trip;-18,20;EUR\n" + // gezi bittikten sonra
+	"not a date;Broken;-1,00;EUR\n"
+
+const sampleOFX = `OFXHEADER:100
+DATA:OFXSGML
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>EUR
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20250602120000[+2:CEST]
+<TRNAMT>-45.80
+<FITID>TX-1001
+<NAME>TRENITALIA
+<MEMO>Roma Termini - Firenze
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20250602
+<TRNAMT>100.00
+<FITID>TX-1002
+<NAME>Refund
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20250603
+<TRNAMT>-12.00
+<FITID>TX-1003
+<NAME>Cafe Greco
+<CURRENCY><CURSYM>USD</CURRENCY>
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`
+
+func TestParseAmount(t *testing.T) {
+	cases := map[string]float64{
+		"12.50":     12.50,
+		"-1.240,50": -1240.50,
+		"1,234.56":  1234.56,
+		"(12.00)":   -12,
+		"20,5 €":    20.5,
+		"1,000":     1000,
+		"15.00-":    -15,
+	}
+	for input, want := range cases {
+		got, err := importer.ParseAmount(input)
+		assert.NoError(t, err, input)
+		assert.InDelta(t, want, got, 0.001, input)
+	}
+	_, err := importer.ParseAmount("n/a")
+	assert.Error(t, err)
+}
+
+func TestParseCSVStatement(t *testing.T) {
+	transactions, itemErrors, err := importer.ParseCSVStatement([]byte(sampleStatementCSV), importer.CSVMapping{
+		Date:        "buchungstag",
+		Amount:      "Betrag",
+		Description: "2",
+		Currency:    "Währung",
+		DateFormat:  "DD.MM.YYYY",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, itemErrors, 1)
+	assert.Len(t, transactions, 5)
+	assert.Equal(t, "Hotel Artemide Roma", transactions[1].Description)
+	assert.Equal(t, 1240.50, transactions[1].Amount)
+	assert.Equal(t, time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC), transactions[1].Date)
+	assert.Equal(t, transactions[2].Ref, transactions[3].Ref)
+
+	t.Run("Debit column and positive amounts", func(t *testing.T) {
+		data := "date,details,out,in\n2025-06-01,Taxi,15.00,\n2025-06-01,Cashback,,3.00\n"
+		transactions, _, err := importer.ParseCSVStatement([]byte(data), importer.CSVMapping{Description: "details", Debit: "out"})
+		assert.NoError(t, err)
+		assert.Len(t, transactions, 1)
+		assert.Equal(t, 15.0, transactions[0].Amount)
+	})
+
+	t.Run("Unknown column", func(t *testing.T) {
+		_, _, err := importer.ParseCSVStatement([]byte(sampleStatementCSV), importer.CSVMapping{Date: "Datum"})
+		assert.Error(t, err)
+	})
+}
+
+func TestParseOFX(t *testing.T) {
+	transactions, itemErrors, err := importer.ParseOFX([]byte(sampleOFX))
+	assert.NoError(t, err)
+	assert.Empty(t, itemErrors)
+	assert.Len(t, transactions, 2)
+	assert.Equal(t, "TX-1001", transactions[0].Ref)
+	assert.Equal(t, 45.80, transactions[0].Amount)
+	assert.Equal(t, "EUR", transactions[0].Currency)
+	assert.Equal(t, "TRENITALIA Roma Termini - Firenze", transactions[0].Description)
+	assert.Equal(t, "USD", transactions[1].Currency)
+
+	_, _, err = importer.ParseOFX([]byte("date,amount"))
+	assert.Error(t, err)
+}
+
+func TestSuggestCategory(t *testing.T) {
+	assert.Equal(t, "accommodation", importer.SuggestCategory("HOTEL ARTEMIDE ROMA"))
+	assert.Equal(t, "transport", importer.SuggestCategory("Uber *Trip"))
+	assert.Equal(t, "food", importer.SuggestCategory("Trattoria Da Enzo"))
+	assert.Equal(t, "activities", importer.SuggestCategory("Musei Vaticani tickets"))
+	assert.Equal(t, "other", importer.SuggestCategory("ATM withdrawal"))
+}
+
+func TestStatementImport_PreviewAndConfirm(t *testing.T) {
+	_, tripService := setupTrashService(t)
+	service := services.NewImportService(tripService, nil)
+	trip := createTrashTrip(t, tripService, 1)
+	trip, _ = tripService.GetTripByID(trip.ID)
+
+	preview, err := service.PreviewStatement(trip, []byte(sampleStatementCSV), "csv", importer.CSVMapping{
+		Date: "1", Description: "2", Amount: "3", Currency: "4",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, preview.Errors, 1)
+	assert.Equal(t, 2, preview.New)
+	assert.Equal(t, 2, preview.Duplicates)
+	assert.Equal(t, 1, preview.OutOfRange)
+
+	assert.Equal(t, services.StatementDuplicate, preview.Lines[0].Status)
+	assert.Equal(t, trip.Expenses[0].ID, preview.Lines[0].DuplicateOf)
+	assert.Equal(t, "accommodation", preview.Lines[1].Category)
+	assert.Equal(t, services.StatementNew, preview.Lines[2].Status)
+	assert.Equal(t, services.StatementDuplicate, preview.Lines[3].Status)
+	assert.Equal(t, services.StatementOutOfRange, preview.Lines[4].Status)
+
+	// Önizleme hiçbir şey kaydetmez
+	saved, _ := tripService.GetTripByID(trip.ID)
+	assert.Len(t, saved.Expenses, 1)
+
+	// Kullanıcı kategoriyi değiştirip yeni hareketleri onaylar; aralık dışı olan reddedilir
+	hotel, museum := preview.Lines[1], preview.Lines[2]
+	museum.Category = "other"
+	result, err := service.ConfirmStatement(trip, 1, []services.StatementLine{hotel, museum, preview.Lines[4]})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Imported)
+	assert.Len(t, result.Errors, 1)
+
+	saved, _ = tripService.GetTripByID(trip.ID)
+	assert.Len(t, saved.Expenses, 3)
+	assert.Equal(t, "Hotel Artemide Roma", saved.Expenses[1].Description)
+	assert.Equal(t, hotel.Ref, saved.Expenses[1].ImportRef)
+	assert.Equal(t, "other", saved.Expenses[2].Category)
+
+	// Aynı ekstre tekrar yüklenince aktarılanlar tekrar olarak görünür
+	again, err := service.ConfirmStatement(saved, 1, []services.StatementLine{hotel})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, again.Imported)
+
+	preview, err = service.PreviewStatement(saved, []byte(sampleStatementCSV), "csv", importer.CSVMapping{
+		Date: "1", Description: "2", Amount: "3", Currency: "4",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, preview.New)
+	assert.Equal(t, 4, preview.Duplicates)
+
+	_, err = service.ConfirmStatement(saved, 1, nil)
+	assert.Error(t, err)
+}
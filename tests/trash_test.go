@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+	"travel-platform/internal/currency"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/scheduler"
+	"travel-platform/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func setupTrashService(t *testing.T) (*gorm.DB, services.TripService) {
+	db := setupTestDB(t)
+	assert.NoError(t, db.AutoMigrate(&models.Trip{}, &models.Activity{}, &models.Expense{}, &models.TripAuditEntry{},
+		&models.TripMember{}, &models.Checklist{}, &models.ChecklistItem{}, &models.Reservation{}, &models.TravelDocument{},
+		&models.ExpenseSplit{}, &models.Settlement{}, &models.Receipt{}, &models.CategoryBudget{}, &models.ExpenseCategory{}, &models.BudgetAlert{}))
+	return db, services.NewTripService(repository.NewTripRepository(db), repository.NewAuditRepository(db), currency.DefaultTable())
+}
+
+func createTrashTrip(t *testing.T, service services.TripService, userID uint) *models.Trip {
+	day := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	trip := &models.Trip{
+		UserID:      userID,
+		Title:       "Rome",
+		Destination: "Rome",
+		StartDate:   day,
+		EndDate:     day.AddDate(0, 0, 3),
+		Activities:  []models.Activity{{Name: "Colosseum", Date: day}, {Name: "Vatican", Date: day}},
+		Expenses:    []models.Expense{{Category: "food", Amount: 20, Currency: "EUR", ExpenseDate: day}},
+	}
+	assert.NoError(t, service.CreateTrip(trip))
+	return trip
+}
+
+func TestTrash_DeleteAndRestore(t *testing.T) {
+	db, service := setupTrashService(t)
+	trip := createTrashTrip(t, service, 1)
+
+	// Gezi silinmeden önce ayrıca silinmiş aktivite geri gelmemeli
+	removed := trip.Activities[1]
+	assert.NoError(t, db.Model(&models.Activity{}).Where("id = ?", removed.ID).
+		Update("deleted_at", time.Now().Add(-time.Hour)).Error)
+	// Geziden hemen önce silinen harcama da silinmiş kalmalı
+	removedExpense := models.Expense{TripID: trip.ID, Category: "food", Amount: 5, Currency: "EUR", ExpenseDate: trip.StartDate}
+	assert.NoError(t, db.Create(&removedExpense).Error)
+	assert.NoError(t, service.DeleteExpense(removedExpense.ID, 1))
+
+	assert.NoError(t, service.DeleteTrip(trip.ID, 1))
+
+	_, err := service.GetTripByID(trip.ID)
+	assert.Error(t, err)
+
+	trash, err := service.GetTrash(1)
+	assert.NoError(t, err)
+	assert.Len(t, trash, 1)
+
+	other, err := service.GetTrash(2)
+	assert.NoError(t, err)
+	assert.Empty(t, other)
+
+	assert.NoError(t, service.RestoreTrip(trip.ID, 1))
+
+	restored, err := service.GetTripByID(trip.ID)
+	assert.NoError(t, err)
+	assert.Len(t, restored.Activities, 1)
+	assert.Equal(t, "Colosseum", restored.Activities[0].Name)
+	assert.Len(t, restored.Expenses, 1)
+
+	trash, _ = service.GetTrash(1)
+	assert.Empty(t, trash)
+
+	t.Run("Restore a trip that is not in trash", func(t *testing.T) {
+		assert.Error(t, service.RestoreTrip(trip.ID, 1))
+	})
+}
+
+func TestTrash_Purge(t *testing.T) {
+	db, service := setupTrashService(t)
+	trip := createTrashTrip(t, service, 1)
+
+	t.Run("Live trip cannot be purged", func(t *testing.T) {
+		assert.Error(t, service.PurgeTrip(trip.ID))
+	})
+
+	assert.NoError(t, service.DeleteTrip(trip.ID, 1))
+	assert.NoError(t, service.PurgeTrip(trip.ID))
+
+	var count int64
+	db.Unscoped().Model(&models.Trip{}).Count(&count)
+	assert.Zero(t, count)
+	db.Unscoped().Model(&models.Activity{}).Count(&count)
+	assert.Zero(t, count)
+	db.Unscoped().Model(&models.Expense{}).Count(&count)
+	assert.Zero(t, count)
+}
+
+func TestTrash_PurgeExpired(t *testing.T) {
+	db, service := setupTrashService(t)
+	oldTrip := createTrashTrip(t, service, 1)
+	recentTrip := createTrashTrip(t, service, 1)
+	liveTrip := createTrashTrip(t, service, 1)
+
+	assert.NoError(t, service.DeleteTrip(oldTrip.ID, 1))
+	assert.NoError(t, service.DeleteTrip(recentTrip.ID, 1))
+	assert.NoError(t, db.Unscoped().Model(&models.Trip{}).Where("id = ?", oldTrip.ID).
+		Update("deleted_at", time.Now().Add(-31*24*time.Hour)).Error)
+
+	purged, err := service.PurgeExpiredTrash(services.TrashRetention)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, purged)
+
+	trash, _ := service.GetTrash(1)
+	assert.Len(t, trash, 1)
+	assert.Equal(t, recentTrip.ID, trash[0].ID)
+
+	_, err = service.GetTripByID(liveTrip.ID)
+	assert.NoError(t, err)
+}
+
+func TestScheduler_RunsJobs(t *testing.T) {
+	var runs, failures int32
+	jobs := scheduler.New()
+	jobs.Every("count", 10*time.Millisecond, func() error {
+		atomic.AddInt32(&runs, 1)
+		return nil
+	})
+	jobs.Every("fail", time.Hour, func() error {
+		atomic.AddInt32(&failures, 1)
+		return errors.New("boom")
+	})
+
+	jobs.Start()
+	time.Sleep(50 * time.Millisecond)
+	jobs.Stop()
+
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&runs), int32(2))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&failures)) // hemen bir kez çalışır
+}
@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"travel-platform/internal/handlers"
+	"travel-platform/internal/middleware"
+	"travel-platform/internal/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Handler testleri rota sırasını yakalayamaz; istekler gerçek router'dan geçer
+func TestTripRoutes_FixedPathsBeforeID(t *testing.T) {
+	_, service := setupTrashService(t)
+	today := time.Now().Truncate(24 * time.Hour)
+	upcoming := createStatusTrip(t, service, today.AddDate(0, 1, 0), 3)
+	createStatusTrip(t, service, today.AddDate(0, -1, 0), 3)
+
+	r := mux.NewRouter()
+	handlers.RegisterTripRoutes(r.PathPrefix("/api").Subrouter(), handlers.NewTripHandler(service, nil))
+	token := middleware.CreateSession(1, "user@test.com")
+
+	get := func(target string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: token})
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("/api/trips/my?status=upcoming")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var trips []models.Trip
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &trips))
+	require.Len(t, trips, 1)
+	assert.Equal(t, upcoming.ID, trips[0].ID)
+
+	w = get("/api/trips/my")
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &trips))
+	assert.Len(t, trips, 2)
+
+	assert.Equal(t, http.StatusOK, get("/api/trips/public").Code)
+	assert.Equal(t, http.StatusOK, get("/api/trips/search?destination=Rome").Code)
+	assert.Equal(t, http.StatusOK, get(fmt.Sprintf("/api/trips/%d", upcoming.ID)).Code)
+}
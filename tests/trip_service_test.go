@@ -3,6 +3,7 @@ package tests
 import (
 	"testing"
 	"time"
+	"travel-platform/internal/currency"
 	"travel-platform/internal/models"
 	"travel-platform/internal/services"
 
@@ -52,9 +53,126 @@ func (m *MockTripRepository) DeleteTrip(id uint) error {
 	return args.Error(0)
 }
 
+func (m *MockTripRepository) UpdateActivity(activity *models.Activity) error {
+	args := m.Called(activity)
+	return args.Error(0)
+}
+
+func (m *MockTripRepository) GetDeletedTripsByUserID(userID uint) ([]models.Trip, error) {
+	args := m.Called(userID)
+	return args.Get(0).([]models.Trip), args.Error(1)
+}
+
+func (m *MockTripRepository) GetDeletedTripByID(id uint) (*models.Trip, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Trip), args.Error(1)
+}
+
+func (m *MockTripRepository) RestoreTrip(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockTripRepository) PurgeTrip(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockTripRepository) PurgeDeletedBefore(cutoff time.Time) (int, error) {
+	args := m.Called(cutoff)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTripRepository) ReplaceTripState(trip *models.Trip) error {
+	args := m.Called(trip)
+	return args.Error(0)
+}
+
+func (m *MockTripRepository) CreateActivity(activity *models.Activity) error {
+	args := m.Called(activity)
+	return args.Error(0)
+}
+
+func (m *MockTripRepository) GetActivityByID(id uint) (*models.Activity, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Activity), args.Error(1)
+}
+
+func (m *MockTripRepository) DeleteActivity(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockTripRepository) CreateExpense(expense *models.Expense) error {
+	args := m.Called(expense)
+	return args.Error(0)
+}
+
+func (m *MockTripRepository) GetExpenseByID(id uint) (*models.Expense, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Expense), args.Error(1)
+}
+
+func (m *MockTripRepository) UpdateExpense(expense *models.Expense) error {
+	args := m.Called(expense)
+	return args.Error(0)
+}
+
+func (m *MockTripRepository) DeleteExpense(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockTripRepository) GetTripsByUserIDAndStatus(userID uint, statuses []string) ([]models.Trip, error) {
+	args := m.Called(userID, statuses)
+	return args.Get(0).([]models.Trip), args.Error(1)
+}
+
+func (m *MockTripRepository) GetTripsByStatus(statuses []string) ([]models.Trip, error) {
+	args := m.Called(statuses)
+	return args.Get(0).([]models.Trip), args.Error(1)
+}
+
+func (m *MockTripRepository) UpdateTripStatus(id uint, status string) error {
+	args := m.Called(id, status)
+	return args.Error(0)
+}
+
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditRepository) CreateEntry(entry *models.TripAuditEntry) error {
+	args := m.Called(entry)
+	return args.Error(0)
+}
+
+func (m *MockAuditRepository) GetEntriesByTripID(tripID uint) ([]models.TripAuditEntry, error) {
+	args := m.Called(tripID)
+	return args.Get(0).([]models.TripAuditEntry), args.Error(1)
+}
+
+func (m *MockAuditRepository) GetEntryByID(id uint) (*models.TripAuditEntry, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TripAuditEntry), args.Error(1)
+}
+
 func TestCreateTrip_Validation(t *testing.T) {
 	mockRepo := new(MockTripRepository)
-	service := services.NewTripService(mockRepo)
+	mockAudit := new(MockAuditRepository)
+	service := services.NewTripService(mockRepo, mockAudit, currency.DefaultTable())
 
 	t.Run("Empty Title", func(t *testing.T) {
 		trip := &models.Trip{Destination: "Paris"}
@@ -83,8 +201,10 @@ func TestCreateTrip_Validation(t *testing.T) {
 			EndDate:     time.Now().Add(24 * time.Hour),
 		}
 		mockRepo.On("CreateTrip", trip).Return(nil)
+		mockAudit.On("CreateEntry", mock.AnythingOfType("*models.TripAuditEntry")).Return(nil)
 		err := service.CreateTrip(trip)
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
+		mockAudit.AssertExpectations(t)
 	})
 }
@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"testing"
+	"time"
+	"travel-platform/internal/models"
+	"travel-platform/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusForDates(t *testing.T) {
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, models.TripStatusUpcoming, services.StatusForDates(start, end, start.Add(-time.Minute)))
+	assert.Equal(t, models.TripStatusInProgress, services.StatusForDates(start, end, start))
+	// Bitiş günü boyunca gezi devam ediyor
+	assert.Equal(t, models.TripStatusInProgress, services.StatusForDates(start, end, end.Add(23*time.Hour)))
+	assert.Equal(t, models.TripStatusCompleted, services.StatusForDates(start, end, end.AddDate(0, 0, 1)))
+}
+
+func TestCanTransition(t *testing.T) {
+	assert.True(t, services.CanTransition(models.TripStatusUpcoming, models.TripStatusCancelled))
+	assert.True(t, services.CanTransition(models.TripStatusInProgress, models.TripStatusCompleted))
+	assert.True(t, services.CanTransition(models.TripStatusCancelled, models.TripStatusUpcoming))
+	assert.False(t, services.CanTransition(models.TripStatusCompleted, models.TripStatusUpcoming))
+	assert.False(t, services.CanTransition(models.TripStatusCancelled, models.TripStatusCompleted))
+	assert.False(t, services.CanTransition(models.TripStatusUpcoming, "archived"))
+}
+
+func createStatusTrip(t *testing.T, service services.TripService, start time.Time, days int) *models.Trip {
+	trip := &models.Trip{UserID: 1, Title: "Trip", Destination: "Rome", StartDate: start, EndDate: start.AddDate(0, 0, days)}
+	assert.NoError(t, service.CreateTrip(trip))
+	return trip
+}
+
+func TestTripStatus_Lifecycle(t *testing.T) {
+	_, service := setupTrashService(t)
+	today := time.Now().Truncate(24 * time.Hour)
+
+	future := createStatusTrip(t, service, today.AddDate(0, 1, 0), 3)
+	past := createStatusTrip(t, service, today.AddDate(0, -1, 0), 3)
+	assert.Equal(t, models.TripStatusUpcoming, future.Status)
+	assert.Equal(t, models.TripStatusCompleted, past.Status)
+
+	t.Run("Manual transitions", func(t *testing.T) {
+		cancelled, err := service.ChangeTripStatus(future.ID, models.TripStatusCancelled, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, models.TripStatusCancelled, cancelled.Status)
+
+		_, err = service.ChangeTripStatus(future.ID, models.TripStatusCompleted, 1)
+		assert.Error(t, err)
+
+		_, err = service.ChangeTripStatus(past.ID, models.TripStatusUpcoming, 1)
+		assert.Error(t, err)
+
+		_, err = service.ChangeTripStatus(future.ID, models.TripStatusUpcoming, 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("UpdateTrip enforces transitions", func(t *testing.T) {
+		trip, _ := service.GetTripByID(past.ID)
+		trip.Status = models.TripStatusInProgress
+		assert.Error(t, service.UpdateTrip(trip, 1))
+	})
+
+	t.Run("Filter by status", func(t *testing.T) {
+		trips, err := service.GetTripsByStatus(1, []string{models.TripStatusCompleted})
+		assert.NoError(t, err)
+		assert.Len(t, trips, 1)
+		assert.Equal(t, past.ID, trips[0].ID)
+
+		_, err = service.GetTripsByStatus(1, []string{"archived"})
+		assert.Error(t, err)
+	})
+}
+
+func TestTripStatus_AdvanceTripStatuses(t *testing.T) {
+	_, service := setupTrashService(t)
+	now := time.Now()
+	today := now.Truncate(24 * time.Hour)
+
+	starting := createStatusTrip(t, service, today.AddDate(0, 0, 1), 2)
+	ending := createStatusTrip(t, service, today.AddDate(0, 0, 1), 0)
+	cancelled := createStatusTrip(t, service, today.AddDate(0, 0, 1), 2)
+	_, err := service.ChangeTripStatus(cancelled.ID, models.TripStatusCancelled, 1)
+	assert.NoError(t, err)
+
+	// İki gün sonrası: starting devam ediyor, ending (tek günlük) bitti
+	changed, err := service.AdvanceTripStatuses(today.AddDate(0, 0, 2).Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, changed)
+
+	reloaded, _ := service.GetTripByID(starting.ID)
+	assert.Equal(t, models.TripStatusInProgress, reloaded.Status)
+	reloaded, _ = service.GetTripByID(ending.ID)
+	assert.Equal(t, models.TripStatusCompleted, reloaded.Status)
+	reloaded, _ = service.GetTripByID(cancelled.ID)
+	assert.Equal(t, models.TripStatusCancelled, reloaded.Status)
+
+	// Otomatik geçiş sistem (actor 0) olarak geçmişe yazılır
+	history, _ := service.GetTripHistory(starting.ID)
+	assert.Equal(t, uint(0), history[0].ActorID)
+	assert.Equal(t, []models.FieldChange{{Field: "status", Old: "upcoming", New: "in_progress"}}, history[0].Changes)
+
+	changed, err = service.AdvanceTripStatuses(today.AddDate(0, 0, 2).Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Zero(t, changed)
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"testing"
+	"time"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTemplateService(t *testing.T) (services.TripService, services.TripTemplateService) {
+	db, tripService := setupTrashService(t)
+	assert.NoError(t, db.AutoMigrate(&models.TripTemplate{}, &models.TemplateActivity{}))
+	return tripService, services.NewTripTemplateService(repository.NewTripTemplateRepository(db), tripService)
+}
+
+func TestTripTemplates_Duplicate(t *testing.T) {
+	tripService, service := setupTemplateService(t)
+	trip := createTrashTrip(t, tripService, 1)
+	trip.Activities[1].Date = trip.StartDate.AddDate(0, 0, 2).Add(9 * time.Hour)
+
+	newStart := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	duplicate, err := service.DuplicateTrip(trip, 1, newStart, "")
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, trip.ID, duplicate.ID)
+	assert.Equal(t, "Rome (copy)", duplicate.Title)
+	assert.Equal(t, newStart, duplicate.StartDate)
+	assert.Equal(t, newStart.AddDate(0, 0, 3), duplicate.EndDate)
+	assert.Equal(t, newStart.AddDate(0, 0, 2).Add(9*time.Hour), duplicate.Activities[1].Date)
+	assert.Len(t, duplicate.Expenses, 1)
+	assert.Equal(t, newStart, duplicate.Expenses[0].ExpenseDate)
+
+	// Orijinal gezi değişmedi
+	original, _ := tripService.GetTripByID(trip.ID)
+	assert.Equal(t, "Rome", original.Title)
+	assert.Len(t, original.Activities, 2)
+}
+
+func TestTripTemplates_Clone(t *testing.T) {
+	tripService, service := setupTemplateService(t)
+	trip := createTrashTrip(t, tripService, 1)
+	trip.IsPublic = true
+
+	clone, err := service.CloneTrip(trip, 2, trip.StartDate.AddDate(0, 1, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, uint(2), clone.UserID)
+	assert.False(t, clone.IsPublic)
+	assert.Len(t, clone.Activities, 2)
+	assert.Empty(t, clone.Expenses)
+
+	t.Run("Private trip", func(t *testing.T) {
+		trip.IsPublic = false
+		_, err := service.CloneTrip(trip, 2, trip.StartDate)
+		assert.Error(t, err)
+	})
+}
+
+func TestTripTemplates_PublishAndUse(t *testing.T) {
+	tripService, service := setupTemplateService(t)
+	trip := createTrashTrip(t, tripService, 1)
+	trip.Activities[1].Date = trip.StartDate.AddDate(0, 0, 2)
+
+	template, err := service.PublishTemplate(trip, "Rome in 4 days", false)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, template.DurationDays)
+	assert.Equal(t, 2, template.Activities[1].DayOffset)
+
+	t.Run("Private template is visible only to its owner", func(t *testing.T) {
+		own, _ := service.GetTemplates(1)
+		assert.Len(t, own, 1)
+		others, _ := service.GetTemplates(2)
+		assert.Empty(t, others)
+
+		loaded, _ := service.GetTemplateByID(template.ID)
+		_, err := service.CreateTripFromTemplate(loaded, 2, time.Now())
+		assert.Error(t, err)
+	})
+
+	start := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	loaded, err := service.GetTemplateByID(template.ID)
+	assert.NoError(t, err)
+
+	created, err := service.CreateTripFromTemplate(loaded, 1, start)
+	assert.NoError(t, err)
+	assert.Equal(t, "Rome in 4 days", created.Title)
+	assert.Equal(t, start.AddDate(0, 0, 3), created.EndDate)
+	assert.Equal(t, start.AddDate(0, 0, 2), created.Activities[1].Date)
+	assert.Empty(t, created.Expenses)
+
+	assert.NoError(t, service.DeleteTemplate(template.ID))
+	_, err = service.GetTemplateByID(template.ID)
+	assert.Error(t, err)
+}
@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"testing"
+	"travel-platform/internal/geo"
+	"travel-platform/internal/models"
+	"travel-platform/internal/repository"
+	"travel-platform/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTripWriteService(t *testing.T) (services.TripWriteService, services.DestinationService) {
+	db, tripService := setupTrashService(t)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Notification{}, &models.NotificationSettings{}, &models.ChatRoom{}, &models.ChatMessage{}))
+	catalog := seedDestinations(t, db)
+
+	userRepo := repository.NewUserRepository(db)
+	require.NoError(t, userRepo.CreateUser(&models.User{Email: "owner@test.com", Password: "x"}))
+	notificationService := services.NewNotificationService(repository.NewNotificationRepository(db), tripService,
+		services.NewBudgetService(repository.NewCategoryBudgetRepository(db)), services.NewMemberService(repository.NewMemberRepository(db), userRepo),
+		userRepo, repository.NewChatRepository(db), &fakeBroadcaster{}, &fakeMailer{})
+
+	return services.NewTripWriteService(tripService, services.NewGeoService(geo.DefaultGazetteer(), catalog),
+		services.NewCategoryService(repository.NewExpenseCategoryRepository(db)), notificationService), catalog
+}
+
+func TestTripWriteService_CreateTrip(t *testing.T) {
+	service, catalog := setupTripWriteService(t)
+	input := services.TripInput{
+		Title: "Paris", Destination: "paris", StartDate: "2025-05-01", EndDate: "2025-05-03", Budget: 100,
+		Activities: []services.ActivityInput{{Name: "Louvre", Location: "Paris", Date: "2025-05-02"}},
+		Expenses:   []services.ExpenseInput{{Category: "Food", Amount: 80, ExpenseDate: "2025-05-02"}},
+	}
+
+	trip, alerts, err := service.CreateTrip(1, input)
+	require.NoError(t, err)
+	paris, _ := catalog.Match("Paris")
+	assert.Equal(t, paris.ID, *trip.DestinationID)
+	assert.NotNil(t, trip.Activities[0].Latitude)
+	assert.Equal(t, "food", trip.Expenses[0].Category)
+	assert.Equal(t, []services.BudgetAlertEvent{{Threshold: 75, Budget: 100, Spent: 80, Currency: "EUR"}}, alerts)
+
+	// Geçersiz aktivite veya harcama atlanmaz, gezi oluşturulmaz
+	invalid := map[string]func(*services.TripInput){
+		"start_date": func(in *services.TripInput) { in.StartDate = "May 1" },
+		"activities[0]": func(in *services.TripInput) {
+			in.Activities = []services.ActivityInput{{Name: "Louvre", Date: "May 2"}}
+		},
+		"activities[1]": func(in *services.TripInput) {
+			in.Activities = append(in.Activities, services.ActivityInput{Date: "2025-05-02"})
+		},
+		"expenses[0]": func(in *services.TripInput) { in.Expenses[0].Category = "unknown" },
+	}
+	for field, change := range invalid {
+		broken := input
+		broken.Activities = append([]services.ActivityInput(nil), input.Activities...)
+		broken.Expenses = append([]services.ExpenseInput(nil), input.Expenses...)
+		change(&broken)
+
+		_, _, err := service.CreateTrip(1, broken)
+		assert.ErrorContains(t, err, field)
+	}
+}
+
+func TestTripWriteService_UpdateTripAndItems(t *testing.T) {
+	service, catalog := setupTripWriteService(t)
+	trip, _, err := service.CreateTrip(1, services.TripInput{Title: "Paris", Destination: "Paris", StartDate: "2025-05-01", EndDate: "2025-05-03"})
+	require.NoError(t, err)
+	paris, _ := catalog.Match("Paris")
+
+	// Destinasyon değişince koordinatlar ve katalog bağlantısı yenilenir; boş tarih korunur
+	_, err = service.UpdateTrip(trip, 1, services.TripInput{Title: "Vienna", Destination: "Vienna, Austria", Budget: 50})
+	require.NoError(t, err)
+	vienna, _ := catalog.Match("Vienna")
+	assert.Equal(t, vienna.ID, *trip.DestinationID)
+	assert.Equal(t, vienna.Latitude, *trip.Latitude)
+	assert.NotEqual(t, paris.ID, *trip.DestinationID)
+	assert.Equal(t, day("2025-05-03"), trip.EndDate.UTC())
+
+	_, err = service.UpdateTrip(trip, 1, services.TripInput{Title: "Vienna", Destination: "Vienna", EndDate: "May 3"})
+	assert.ErrorContains(t, err, "end_date")
+
+	activity, err := service.AddActivity(trip, 1, services.ActivityInput{Name: "Opera", Location: "Vienna", Date: "2025-05-02"})
+	require.NoError(t, err)
+	assert.NotNil(t, activity.Latitude)
+	require.NoError(t, service.UpdateActivity(activity, 1, services.ActivityInput{Name: "State Opera", Location: "Vienna"}))
+	assert.Equal(t, day("2025-05-02"), activity.Date.UTC())
+
+	expense, alerts, err := service.AddExpense(trip, 1, services.ExpenseInput{Category: "food", Amount: 30, ExpenseDate: "2025-05-02"})
+	require.NoError(t, err)
+	assert.Empty(t, alerts)
+	alerts, err = service.UpdateExpense(trip, expense, 1, services.ExpenseInput{Category: "food", Amount: 50})
+	require.NoError(t, err)
+	assert.Equal(t, 100, alerts[0].Threshold)
+	assert.Equal(t, day("2025-05-02"), expense.ExpenseDate.UTC())
+
+	_, err = service.DeleteExpense(trip, expense, 1)
+	require.NoError(t, err)
+	_, _, err = service.AddExpense(trip, 1, services.ExpenseInput{Category: "unknown", Amount: 5, ExpenseDate: "2025-05-02"})
+	assert.Error(t, err)
+}
@@ -66,15 +66,11 @@ function addExpense() {
                     <label>Category *</label>
                     <select name="expenses[${index}][category]" required>
                         <option value="">Select category</option>
-                        <option value="food">Food</option>
-                        <option value="transport">Transport</option>
-                        <option value="accommodation">Accommodation</option>
-                        <option value="entertainment">Entertainment</option>
-                        <option value="other">Other</option>
+                        ${document.getElementById('categoryOptions').innerHTML}
                     </select>
                 </div>
                 <div class="form-group">
-                    <label>Amount (EUR) *</label>
+                    <label>Amount *</label>
                     <input type="number" name="expenses[${index}][amount]" step="0.01" min="0" required>
                 </div>
                 <div class="form-group">
@@ -106,6 +102,7 @@ document.getElementById('editTripForm').addEventListener('submit', async (e) =>
         end_date: document.getElementById('end_date').value,
         description: document.getElementById('description').value,
         budget: parseFloat(document.getElementById('budget').value) || 0,
+        currency: document.getElementById('currency').value,
         is_public: document.getElementById('is_public').checked
     };
 
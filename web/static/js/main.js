@@ -198,18 +198,17 @@ function addExpense() {
                 <label>Category *</label>
                 <select class="expense-category" required>
                     <option value="">Select category</option>
-                    <option value="Food">🍽️ Food & Dining</option>
-                    <option value="Transport">🚗 Transportation</option>
-                    <option value="Accommodation">🏨 Accommodation</option>
-                    <option value="Entertainment">🎭 Entertainment</option>
-                    <option value="Shopping">🛍️ Shopping</option>
-                    <option value="Other">📌 Other</option>
+                    ${document.getElementById('categoryOptions').innerHTML}
                 </select>
             </div>
             <div class="form-group">
-                <label>Amount (EUR) *</label>
+                <label>Amount *</label>
                 <input type="number" class="expense-amount" step="0.01" min="0" placeholder="0.00" required>
             </div>
+            <div class="form-group">
+                <label>Currency</label>
+                <input type="text" class="expense-currency" maxlength="3" placeholder="Trip currency">
+            </div>
             <div class="form-group">
                 <label>Date *</label>
                 <input type="date" class="expense-date" required>
@@ -240,6 +239,7 @@ document.getElementById('createTripForm').addEventListener('submit', async funct
         end_date: document.getElementById('end_date').value,
         description: document.getElementById('description').value,
         budget: parseFloat(document.getElementById('budget').value) || 0,
+        currency: document.getElementById('currency').value,
         is_public: document.getElementById('is_public').checked
     };
 
@@ -267,11 +267,13 @@ document.getElementById('createTripForm').addEventListener('submit', async funct
         const category = field.querySelector('.expense-category').value;
         const amount = parseFloat(field.querySelector('.expense-amount').value);
         const date = field.querySelector('.expense-date').value;
+        const currency = field.querySelector('.expense-currency').value.trim().toUpperCase();
 
         if (category && amount && date) {
             expenses.push({
                 category: category,
                 amount: amount,
+                currency: currency,
                 expense_date: date
             });
         }
@@ -311,3 +313,34 @@ document.getElementById('createTripForm').addEventListener('submit', async funct
 
 
 
+
+// Destination autocomplete - Katalogdan öneriler (create/edit trip formları)
+const destinationInput = document.querySelector('input[list="destinations"]');
+if (destinationInput) {
+    let destinationTimer;
+    destinationInput.addEventListener('input', () => {
+        clearTimeout(destinationTimer);
+        const query = destinationInput.value.trim();
+        if (query.length < 2) {
+            return;
+        }
+        destinationTimer = setTimeout(async () => {
+            try {
+                const response = await fetch(`/api/destinations?q=${encodeURIComponent(query)}&limit=8`);
+                if (!response.ok) {
+                    return;
+                }
+                const destinations = await response.json();
+                const list = document.getElementById('destinations');
+                list.innerHTML = '';
+                (destinations || []).forEach(d => {
+                    const option = document.createElement('option');
+                    option.value = `${d.name}, ${d.country}`;
+                    list.appendChild(option);
+                });
+            } catch (error) {
+                console.error('Autocomplete error:', error);
+            }
+        }, 200);
+    });
+}